@@ -107,6 +107,12 @@ var eventsCmd = &cobra.Command{
 							),
 						),
 					}
+				case *machine.StaticPodConfigRenderEvent:
+					if msg.GetSuccess() {
+						args = []any{msg.GetVersion(), fmt.Sprintf("changed files: %d", msg.GetChangedFiles())}
+					} else {
+						args = []any{"error", msg.GetError()}
+					}
 				}
 
 				args = append([]any{event.Node, event.ID, event.TypeURL, event.ActorID}, args...)
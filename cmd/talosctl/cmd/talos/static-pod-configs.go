@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var staticPodConfigsCmd = &cobra.Command{
+	Use:   "static-pod-configs",
+	Short: "Manage the configs of the control plane static pods",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+}
+
+var staticPodConfigsSnapshotCmd = &cobra.Command{
+	Use:   "snapshot <path>",
+	Short: "Stream snapshot of the control plane static pod configs of the node to the path.",
+	Long:  `The snapshot might contain secrets, so it should be stored encrypted.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "static-pod-configs snapshot"); err != nil {
+				return err
+			}
+
+			snapshotPath := args[0]
+			partPath := snapshotPath + ".part"
+
+			defer os.RemoveAll(partPath) //nolint:errcheck
+
+			dest, err := os.OpenFile(partPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+			if err != nil {
+				return fmt.Errorf("error creating temporary file: %w", err)
+			}
+
+			defer dest.Close() //nolint:errcheck
+
+			r, err := c.StaticPodConfigsSnapshot(ctx)
+			if err != nil {
+				return fmt.Errorf("error reading snapshot: %w", err)
+			}
+
+			defer r.Close() //nolint:errcheck
+
+			size, err := io.Copy(dest, r)
+			if err != nil {
+				return fmt.Errorf("error reading: %w", err)
+			}
+
+			if err = dest.Sync(); err != nil {
+				return fmt.Errorf("failed to fsync: %w", err)
+			}
+
+			if err = dest.Close(); err != nil {
+				return fmt.Errorf("failed to close: %w", err)
+			}
+
+			if err = os.Rename(partPath, snapshotPath); err != nil {
+				return fmt.Errorf("error renaming to final location: %w", err)
+			}
+
+			fmt.Printf("static pod configs snapshot saved to %q (%d bytes)\n", snapshotPath, size)
+
+			return nil
+		})
+	},
+}
+
+var staticPodConfigsRestoreCmd = &cobra.Command{
+	Use:   "restore <path>",
+	Short: "Restore the control plane static pod configs of the node from the snapshot.",
+	Long:  ``,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "static-pod-configs restore"); err != nil {
+				return err
+			}
+
+			snapshot, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("error opening snapshot: %w", err)
+			}
+
+			defer snapshot.Close() //nolint:errcheck
+
+			return c.StaticPodConfigsRestore(ctx, snapshot)
+		})
+	},
+}
+
+func init() {
+	staticPodConfigsCmd.AddCommand(
+		staticPodConfigsRestoreCmd,
+		staticPodConfigsSnapshotCmd,
+	)
+
+	addCommand(staticPodConfigsCmd)
+}
@@ -1988,6 +1988,94 @@ func (s *Server) EtcdRecover(srv machine.MachineService_EtcdRecoverServer) error
 	})
 }
 
+// StaticPodConfigsSnapshot implements the machine.MachineServer interface.
+func (s *Server) StaticPodConfigsSnapshot(_ *emptypb.Empty, srv machine.MachineService_StaticPodConfigsSnapshotServer) error {
+	if err := s.checkControlplane("static pod configs snapshot"); err != nil {
+		return err
+	}
+
+	// the configs are small, so the snapshot is taken in full before streaming it, which also surfaces the errors
+	var archive bytes.Buffer
+
+	if err := s.Controller.V1Alpha2().SnapshotStaticPodConfigs(srv.Context(), &archive); err != nil {
+		return fmt.Errorf("error creating static pod configs snapshot: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	chunker := stream.NewChunker(ctx, io.NopCloser(&archive))
+	chunkCh := chunker.Read()
+
+	for data := range chunkCh {
+		err := srv.SendMsg(&common.Data{Bytes: data})
+		if err != nil {
+			cancel()
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// maxStaticPodConfigsSnapshotSize is the limit of the uploaded static pod configs snapshot, which is buffered in memory.
+const maxStaticPodConfigsSnapshotSize = 16 * 1024 * 1024
+
+// StaticPodConfigsRestore implements the machine.MachineServer interface.
+func (s *Server) StaticPodConfigsRestore(srv machine.MachineService_StaticPodConfigsRestoreServer) error {
+	if err := s.checkControlplane("static pod configs restore"); err != nil {
+		return err
+	}
+
+	// the archive is restored only once it's fully uploaded, so that a broken upload doesn't leave the configs half-restored
+	var archive bytes.Buffer
+
+	for {
+		msg, err := srv.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return err
+		}
+
+		if archive.Len()+len(msg.Bytes) > maxStaticPodConfigsSnapshotSize {
+			return status.Errorf(codes.ResourceExhausted, "static pod configs snapshot exceeds %d bytes", maxStaticPodConfigsSnapshotSize)
+		}
+
+		archive.Write(msg.Bytes)
+	}
+
+	if err := s.Controller.V1Alpha2().RestoreStaticPodConfigs(srv.Context(), &archive); err != nil {
+		return status.Errorf(codes.InvalidArgument, "error restoring static pod configs: %s", err)
+	}
+
+	return srv.SendAndClose(&common.EmptyResponse{
+		Messages: []*common.Empty{
+			{},
+		},
+	})
+}
+
+// StaticPodConfigsRollback implements the machine.MachineServer interface.
+func (s *Server) StaticPodConfigsRollback(ctx context.Context, in *machine.StaticPodConfigsRollbackRequest) (*common.EmptyResponse, error) {
+	if err := s.checkControlplane("static pod configs rollback"); err != nil {
+		return nil, err
+	}
+
+	if err := s.Controller.V1Alpha2().RollbackStaticPodConfigs(ctx, in.GetGeneration()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error rolling back static pod configs: %s", err)
+	}
+
+	return &common.EmptyResponse{
+		Messages: []*common.Empty{
+			{},
+		},
+	}, nil
+}
+
 func mapAlarms(alarms []*etcdserverpb.AlarmMember) []*machine.EtcdMemberAlarm {
 	mapAlarmType := func(alarmType etcdserverpb.AlarmType) machine.EtcdMemberAlarm_AlarmType {
 		switch alarmType {
@@ -5,8 +5,11 @@
 package k8s
 
 import (
+	"bytes"
+	"cmp"
 	"context"
 	"fmt"
+	"maps"
 	"slices"
 	"strconv"
 	"strings"
@@ -15,6 +18,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/controller/generic"
 	"github.com/cosi-project/runtime/pkg/controller/generic/transform"
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
@@ -72,8 +76,9 @@ func NewControlPlaneAdmissionControlController() *ControlPlaneAdmissionControlCo
 				for _, cfg := range cfgProvider.Cluster().APIServer().AdmissionControl() {
 					res.TypedSpec().Config = append(res.TypedSpec().Config,
 						k8s.AdmissionPluginSpec{
-							Name:          cfg.Name(),
-							Configuration: cfg.Configuration(),
+							Name:             cfg.Name(),
+							Configuration:    cfg.Configuration(),
+							RawConfiguration: cfg.ConfigurationYAML(),
 						},
 					)
 				}
@@ -166,6 +171,297 @@ func NewControlPlaneAuthorizationController() *ControlPlaneAuthorizationControll
 	)
 }
 
+// ControlPlaneAuthenticationController manages k8s.AuthenticationConfig based on configuration.
+type ControlPlaneAuthenticationController = transform.Controller[*config.MachineConfig, *k8s.AuthenticationConfig]
+
+// NewControlPlaneAuthenticationController instanciates the controller.
+func NewControlPlaneAuthenticationController() *ControlPlaneAuthenticationController {
+	mapFunc := controlplaneMapFunc(k8s.NewAuthenticationConfig(k8s.AuthenticationConfigID))
+
+	return transform.NewController(
+		transform.Settings[*config.MachineConfig, *k8s.AuthenticationConfig]{
+			Name: "k8s.ControlPlaneAuthenticationController",
+			MapMetadataOptionalFunc: func(cfg *config.MachineConfig) optional.Optional[*k8s.AuthenticationConfig] {
+				res := mapFunc(cfg)
+
+				// structured authentication is opt-in, kube-apiserver falls back to the authentication flags without it
+				if !res.IsPresent() || len(cfg.Config().Cluster().APIServer().AuthenticationConfig()) == 0 {
+					return optional.None[*k8s.AuthenticationConfig]()
+				}
+
+				return res
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, machineConfig *config.MachineConfig, res *k8s.AuthenticationConfig) error {
+				res.TypedSpec().Config = machineConfig.Config().Cluster().APIServer().AuthenticationConfig()
+
+				return nil
+			},
+		},
+	)
+}
+
+// ControlPlaneKonnectivityServerController manages k8s.KonnectivityServerConfig based on configuration.
+type ControlPlaneKonnectivityServerController = transform.Controller[*config.MachineConfig, *k8s.KonnectivityServerConfig]
+
+// NewControlPlaneKonnectivityServerController instanciates the controller.
+func NewControlPlaneKonnectivityServerController() *ControlPlaneKonnectivityServerController {
+	mapFunc := controlplaneMapFunc(k8s.NewKonnectivityServerConfig())
+
+	return transform.NewController(
+		transform.Settings[*config.MachineConfig, *k8s.KonnectivityServerConfig]{
+			Name: "k8s.ControlPlaneKonnectivityServerController",
+			MapMetadataOptionalFunc: func(cfg *config.MachineConfig) optional.Optional[*k8s.KonnectivityServerConfig] {
+				res := mapFunc(cfg)
+
+				// the egress selector config is only rendered if the konnectivity server is configured
+				if !res.IsPresent() || cfg.Config().Cluster().APIServer().KonnectivityServer() == nil {
+					return optional.None[*k8s.KonnectivityServerConfig]()
+				}
+
+				return res
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, machineConfig *config.MachineConfig, res *k8s.KonnectivityServerConfig) error {
+				konnectivity := machineConfig.Config().Cluster().APIServer().KonnectivityServer()
+
+				res.TypedSpec().ListenAddress = konnectivity.ListenAddress()
+				res.TypedSpec().AgentNamespace = konnectivity.AgentNamespace()
+				res.TypedSpec().AgentServiceAccount = konnectivity.AgentServiceAccount()
+				res.TypedSpec().EgressSelections = xslices.Map(konnectivity.EgressSelections(),
+					func(selection talosconfig.KonnectivityEgressSelection) k8s.EgressSelectionSpec {
+						return k8s.EgressSelectionSpec{
+							Name:          selection.Name(),
+							ProxyProtocol: selection.ProxyProtocol(),
+							Transport:     selection.Transport(),
+							Address:       selection.Address(),
+						}
+					})
+
+				return nil
+			},
+		},
+	)
+}
+
+// ControlPlaneServiceAccountIssuerDiscoveryController manages k8s.ServiceAccountIssuerDiscovery based on configuration.
+type ControlPlaneServiceAccountIssuerDiscoveryController = transform.Controller[*config.MachineConfig, *k8s.ServiceAccountIssuerDiscovery]
+
+// NewControlPlaneServiceAccountIssuerDiscoveryController instanciates the controller.
+func NewControlPlaneServiceAccountIssuerDiscoveryController() *ControlPlaneServiceAccountIssuerDiscoveryController {
+	mapFunc := controlplaneMapFunc(k8s.NewServiceAccountIssuerDiscovery())
+
+	return transform.NewController(
+		transform.Settings[*config.MachineConfig, *k8s.ServiceAccountIssuerDiscovery]{
+			Name: "k8s.ControlPlaneServiceAccountIssuerDiscoveryController",
+			MapMetadataOptionalFunc: func(cfg *config.MachineConfig) optional.Optional[*k8s.ServiceAccountIssuerDiscovery] {
+				res := mapFunc(cfg)
+
+				// the discovery documents are only rendered if they were cached in the machine config
+				if !res.IsPresent() || cfg.Config().Cluster().APIServer().ServiceAccountIssuerDiscovery() == nil {
+					return optional.None[*k8s.ServiceAccountIssuerDiscovery]()
+				}
+
+				return res
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, machineConfig *config.MachineConfig, res *k8s.ServiceAccountIssuerDiscovery) error {
+				cfgProvider := machineConfig.Config()
+				discovery := cfgProvider.Cluster().APIServer().ServiceAccountIssuerDiscovery()
+
+				// kube-apiserver is configured with the control plane endpoint as the issuer, unless it's overridden with the extra args
+				res.TypedSpec().Issuer = cmp.Or(cfgProvider.Cluster().APIServer().ExtraArgs()["service-account-issuer"], cfgProvider.Cluster().Endpoint().String())
+				res.TypedSpec().DiscoveryDocument = discovery.DiscoveryDocument()
+				res.TypedSpec().JWKS = discovery.JWKS()
+
+				return nil
+			},
+		},
+	)
+}
+
+// ControlPlaneAirGappedController manages k8s.AirGappedConfig based on configuration.
+type ControlPlaneAirGappedController = transform.Controller[*config.MachineConfig, *k8s.AirGappedConfig]
+
+// NewControlPlaneAirGappedController instanciates the controller.
+func NewControlPlaneAirGappedController() *ControlPlaneAirGappedController {
+	mapFunc := controlplaneMapFunc(k8s.NewAirGappedConfig())
+
+	return transform.NewController(
+		transform.Settings[*config.MachineConfig, *k8s.AirGappedConfig]{
+			Name: "k8s.ControlPlaneAirGappedController",
+			MapMetadataOptionalFunc: func(cfg *config.MachineConfig) optional.Optional[*k8s.AirGappedConfig] {
+				res := mapFunc(cfg)
+
+				// the cluster is only air-gapped if it's marked so in the machine config
+				if !res.IsPresent() || cfg.Config().Cluster().APIServer().AirGapped() == nil {
+					return optional.None[*k8s.AirGappedConfig]()
+				}
+
+				return res
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, machineConfig *config.MachineConfig, res *k8s.AirGappedConfig) error {
+				res.TypedSpec().AllowedHosts = slices.Clone(machineConfig.Config().Cluster().APIServer().AirGapped().AllowedHosts())
+
+				return nil
+			},
+		},
+	)
+}
+
+// ControlPlaneAPIServerTracingController manages k8s.APIServerTracingConfig based on configuration.
+type ControlPlaneAPIServerTracingController = transform.Controller[*config.MachineConfig, *k8s.APIServerTracingConfig]
+
+// NewControlPlaneAPIServerTracingController instanciates the controller.
+func NewControlPlaneAPIServerTracingController() *ControlPlaneAPIServerTracingController {
+	mapFunc := controlplaneMapFunc(k8s.NewAPIServerTracingConfig())
+
+	return transform.NewController(
+		transform.Settings[*config.MachineConfig, *k8s.APIServerTracingConfig]{
+			Name: "k8s.ControlPlaneAPIServerTracingController",
+			MapMetadataOptionalFunc: func(cfg *config.MachineConfig) optional.Optional[*k8s.APIServerTracingConfig] {
+				res := mapFunc(cfg)
+
+				// the tracing config is only rendered if the tracing is configured
+				if !res.IsPresent() || cfg.Config().Cluster().APIServer().Tracing() == nil {
+					return optional.None[*k8s.APIServerTracingConfig]()
+				}
+
+				return res
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, machineConfig *config.MachineConfig, res *k8s.APIServerTracingConfig) error {
+				tracing := machineConfig.Config().Cluster().APIServer().Tracing()
+
+				res.TypedSpec().Endpoint = tracing.Endpoint()
+				res.TypedSpec().SamplingRatePerMillion = tracing.SamplingRatePerMillion()
+
+				return nil
+			},
+		},
+	)
+}
+
+// ControlPlaneServiceAccountSignerController manages k8s.ServiceAccountSignerConfig based on configuration.
+type ControlPlaneServiceAccountSignerController = transform.Controller[*config.MachineConfig, *k8s.ServiceAccountSignerConfig]
+
+// NewControlPlaneServiceAccountSignerController instanciates the controller.
+func NewControlPlaneServiceAccountSignerController() *ControlPlaneServiceAccountSignerController {
+	mapFunc := controlplaneMapFunc(k8s.NewServiceAccountSignerConfig())
+
+	return transform.NewController(
+		transform.Settings[*config.MachineConfig, *k8s.ServiceAccountSignerConfig]{
+			Name: "k8s.ControlPlaneServiceAccountSignerController",
+			MapMetadataOptionalFunc: func(cfg *config.MachineConfig) optional.Optional[*k8s.ServiceAccountSignerConfig] {
+				res := mapFunc(cfg)
+
+				// the tokens are signed with the service account key unless the external signer is configured
+				if !res.IsPresent() || cfg.Config().Cluster().APIServer().ServiceAccountSigningEndpoint() == "" {
+					return optional.None[*k8s.ServiceAccountSignerConfig]()
+				}
+
+				return res
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, machineConfig *config.MachineConfig, res *k8s.ServiceAccountSignerConfig) error {
+				res.TypedSpec().Endpoint = machineConfig.Config().Cluster().APIServer().ServiceAccountSigningEndpoint()
+
+				return nil
+			},
+		},
+	)
+}
+
+// ControlPlaneAPIServerShutdownController manages k8s.APIServerShutdownConfig based on configuration.
+type ControlPlaneAPIServerShutdownController = transform.Controller[*config.MachineConfig, *k8s.APIServerShutdownConfig]
+
+// NewControlPlaneAPIServerShutdownController instanciates the controller.
+func NewControlPlaneAPIServerShutdownController() *ControlPlaneAPIServerShutdownController {
+	mapFunc := controlplaneMapFunc(k8s.NewAPIServerShutdownConfig())
+
+	return transform.NewController(
+		transform.Settings[*config.MachineConfig, *k8s.APIServerShutdownConfig]{
+			Name: "k8s.ControlPlaneAPIServerShutdownController",
+			MapMetadataOptionalFunc: func(cfg *config.MachineConfig) optional.Optional[*k8s.APIServerShutdownConfig] {
+				res := mapFunc(cfg)
+
+				// kube-apiserver and the pod keep their shutdown defaults unless the shutdown is configured
+				if !res.IsPresent() || cfg.Config().Cluster().APIServer().Shutdown() == nil {
+					return optional.None[*k8s.APIServerShutdownConfig]()
+				}
+
+				return res
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, machineConfig *config.MachineConfig, res *k8s.APIServerShutdownConfig) error {
+				shutdown := machineConfig.Config().Cluster().APIServer().Shutdown()
+
+				*res.TypedSpec() = k8s.APIServerShutdownConfigSpec{
+					ShutdownDelay:               shutdown.ShutdownDelay(),
+					WatchTerminationGracePeriod: shutdown.WatchTerminationGracePeriod(),
+					TerminationGracePeriod:      shutdown.TerminationGracePeriod(),
+				}
+
+				return nil
+			},
+		},
+	)
+}
+
+// ControlPlaneAPIServerClientCAController manages k8s.APIServerClientCAConfig based on configuration.
+type ControlPlaneAPIServerClientCAController = transform.Controller[*config.MachineConfig, *k8s.APIServerClientCAConfig]
+
+// NewControlPlaneAPIServerClientCAController instanciates the controller.
+func NewControlPlaneAPIServerClientCAController() *ControlPlaneAPIServerClientCAController {
+	mapFunc := controlplaneMapFunc(k8s.NewAPIServerClientCAConfig())
+
+	return transform.NewController(
+		transform.Settings[*config.MachineConfig, *k8s.APIServerClientCAConfig]{
+			Name: "k8s.ControlPlaneAPIServerClientCAController",
+			MapMetadataOptionalFunc: func(cfg *config.MachineConfig) optional.Optional[*k8s.APIServerClientCAConfig] {
+				res := mapFunc(cfg)
+
+				// without the additional CAs, the cluster CAs from the secrets are used
+				if !res.IsPresent() ||
+					(len(cfg.Config().Cluster().APIServer().ClientCAs()) == 0 && len(cfg.Config().Cluster().APIServer().RequestHeaderClientCAs()) == 0) {
+					return optional.None[*k8s.APIServerClientCAConfig]()
+				}
+
+				return res
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, machineConfig *config.MachineConfig, res *k8s.APIServerClientCAConfig) error {
+				cluster := machineConfig.Config().Cluster()
+
+				// the rendered bundles replace the cluster CAs, so they are still trusted in addition to the configured ones
+				res.TypedSpec().ClientCA = ""
+
+				if clientCAs := cluster.APIServer().ClientCAs(); len(clientCAs) > 0 {
+					clusterCAs := slices.Clone(cluster.AcceptedCAs())
+
+					if cluster.IssuingCA() != nil {
+						clusterCAs = append(clusterCAs, &x509.PEMEncodedCertificate{Crt: cluster.IssuingCA().Crt})
+					}
+
+					res.TypedSpec().ClientCA = caBundleOf(append(clusterCAs, clientCAs...))
+				}
+
+				res.TypedSpec().RequestHeaderClientCA = ""
+
+				if requestHeaderClientCAs := cluster.APIServer().RequestHeaderClientCAs(); len(requestHeaderClientCAs) > 0 {
+					var aggregatorCAs []*x509.PEMEncodedCertificate
+
+					if cluster.AggregatorCA() != nil {
+						aggregatorCAs = append(aggregatorCAs, &x509.PEMEncodedCertificate{Crt: cluster.AggregatorCA().Crt})
+					}
+
+					res.TypedSpec().RequestHeaderClientCA = caBundleOf(append(aggregatorCAs, requestHeaderClientCAs...))
+				}
+
+				return nil
+			},
+		},
+	)
+}
+
+// caBundleOf concatenates the PEM-encoded CAs into a bundle.
+func caBundleOf(cas []*x509.PEMEncodedCertificate) string {
+	return string(bytes.Join(xslices.Map(cas, func(ca *x509.PEMEncodedCertificate) []byte { return ca.Crt }), nil))
+}
+
 // ControlPlaneAPIServerController manages k8s.APIServerConfig based on configuration.
 type ControlPlaneAPIServerController = transform.Controller[*config.MachineConfig, *k8s.APIServerConfig]
 
@@ -188,6 +484,17 @@ func NewControlPlaneAPIServerController() *ControlPlaneAPIServerController {
 					advertisedAddress = ""
 				}
 
+				extraVolumes := convertVolumes(cfgProvider.Cluster().APIServer().ExtraVolumes())
+
+				// kube-apiserver connects to the KMS plugin over the unix socket, so the socket directory is mounted into the pod
+				if kms := cfgProvider.Cluster().APIServer().KMSEncryption(); kms != nil {
+					extraVolumes = append(extraVolumes, k8s.ExtraVolume{
+						Name:      "kms",
+						HostPath:  kms.SocketDir(),
+						MountPath: kms.SocketDir(),
+					})
+				}
+
 				*res.TypedSpec() = k8s.APIServerConfigSpec{
 					Image:                    cfgProvider.Cluster().APIServer().Image(),
 					CloudProvider:            cloudProvider,
@@ -196,7 +503,7 @@ func NewControlPlaneAPIServerController() *ControlPlaneAPIServerController {
 					LocalPort:                cfgProvider.Cluster().LocalAPIServerPort(),
 					ServiceCIDRs:             cfgProvider.Cluster().Network().ServiceCIDRs(),
 					ExtraArgs:                cfgProvider.Cluster().APIServer().ExtraArgs(),
-					ExtraVolumes:             convertVolumes(cfgProvider.Cluster().APIServer().ExtraVolumes()),
+					ExtraVolumes:             extraVolumes,
 					EnvironmentVariables:     cfgProvider.Cluster().APIServer().Env(),
 					PodSecurityPolicyEnabled: !cfgProvider.Cluster().APIServer().DisablePodSecurityPolicy(),
 					AdvertisedAddress:        advertisedAddress,
@@ -273,6 +580,57 @@ func NewControlPlaneSchedulerController() *ControlPlaneSchedulerController {
 	)
 }
 
+// ControlPlaneConfigRenderPolicyController manages k8s.ConfigRenderPolicy based on configuration.
+type ControlPlaneConfigRenderPolicyController = transform.Controller[*config.MachineConfig, *k8s.ConfigRenderPolicy]
+
+// NewControlPlaneConfigRenderPolicyController instanciates the controller.
+func NewControlPlaneConfigRenderPolicyController() *ControlPlaneConfigRenderPolicyController {
+	return transform.NewController(
+		transform.Settings[*config.MachineConfig, *k8s.ConfigRenderPolicy]{
+			Name:                    "k8s.ControlPlaneConfigRenderPolicyController",
+			MapMetadataOptionalFunc: controlplaneMapFunc(k8s.NewConfigRenderPolicy()),
+			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, machineConfig *config.MachineConfig, res *k8s.ConfigRenderPolicy) error {
+				staticPodConfigs := machineConfig.Config().Cluster().StaticPodConfigs()
+
+				res.TypedSpec().ValidateOnly = staticPodConfigs.ValidateOnly()
+				res.TypedSpec().StrictAdmissionPlugins = staticPodConfigs.StrictAdmissionPlugins()
+				res.TypedSpec().StrictStructuredAuth = staticPodConfigs.StrictStructuredAuth()
+
+				return nil
+			},
+		},
+	)
+}
+
+// ControlPlaneConfigNamingPolicyController manages k8s.ConfigNamingPolicy based on configuration.
+type ControlPlaneConfigNamingPolicyController = transform.Controller[*config.MachineConfig, *k8s.ConfigNamingPolicy]
+
+// NewControlPlaneConfigNamingPolicyController instanciates the controller.
+func NewControlPlaneConfigNamingPolicyController() *ControlPlaneConfigNamingPolicyController {
+	mapFunc := controlplaneMapFunc(k8s.NewConfigNamingPolicy())
+
+	return transform.NewController(
+		transform.Settings[*config.MachineConfig, *k8s.ConfigNamingPolicy]{
+			Name: "k8s.ControlPlaneConfigNamingPolicyController",
+			MapMetadataOptionalFunc: func(cfg *config.MachineConfig) optional.Optional[*k8s.ConfigNamingPolicy] {
+				res := mapFunc(cfg)
+
+				// without the policy, the configs are rendered to the default filenames
+				if !res.IsPresent() || len(cfg.Config().Cluster().StaticPodConfigs().Filenames()) == 0 {
+					return optional.None[*k8s.ConfigNamingPolicy]()
+				}
+
+				return res
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, machineConfig *config.MachineConfig, res *k8s.ConfigNamingPolicy) error {
+				res.TypedSpec().Filenames = maps.Clone(machineConfig.Config().Cluster().StaticPodConfigs().Filenames())
+
+				return nil
+			},
+		},
+	)
+}
+
 // ControlPlaneBootstrapManifestsController manages k8s.BootstrapManifestsConfig based on configuration.
 type ControlPlaneBootstrapManifestsController = transform.Controller[*config.MachineConfig, *k8s.BootstrapManifestsConfig]
 
@@ -5,13 +5,16 @@
 package k8s
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"path/filepath"
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/blang/semver/v4"
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
@@ -41,7 +44,17 @@ const systemCriticalPriority int32 = 2000000000
 const GoGCMemLimitPercentage = 95
 
 // ControlPlaneStaticPodController manages k8s.StaticPod based on control plane configuration.
-type ControlPlaneStaticPodController struct{}
+type ControlPlaneStaticPodController struct {
+	// APIServerSecretConfigDir is the directory the kube-apiserver configs which might contain secrets are rendered to.
+	//
+	// It should be the same as RenderConfigsStaticPodController.APIServerSecretConfigDir, if not set the configs are referenced
+	// in the kube-apiserver config directory.
+	APIServerSecretConfigDir string
+	// SchedulerSecretConfigDir is the directory the kube-scheduler configs which might contain secrets are rendered to.
+	//
+	// It should be the same as RenderConfigsStaticPodController.SchedulerSecretConfigDir, if set it's mounted into the kube-scheduler pod.
+	SchedulerSecretConfigDir string
+}
 
 // Name implements controller.Controller interface.
 func (ctrl *ControlPlaneStaticPodController) Name() string {
@@ -66,6 +79,52 @@ func (ctrl *ControlPlaneStaticPodController) Inputs() []controller.Input {
 			Type:      k8s.SchedulerConfigType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.ConfigNamingPolicyType,
+			ID:        optional.Some(k8s.ConfigNamingPolicyID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.APIServerShutdownConfigType,
+			ID:        optional.Some(k8s.APIServerShutdownConfigID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.ServiceAccountSignerConfigType,
+			ID:        optional.Some(k8s.ServiceAccountSignerConfigID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.AuthenticationConfigType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.KonnectivityServerConfigType,
+			ID:        optional.Some(k8s.KonnectivityServerConfigID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.APIServerTracingConfigType,
+			ID:        optional.Some(k8s.APIServerTracingConfigID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.RequiredFeatureGatesType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.ControlPlaneNamespaceName,
+			Type:      k8s.APIServerClientCAConfigType,
+			ID:        optional.Some(k8s.APIServerClientCAConfigID),
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: k8s.ControlPlaneNamespaceName,
 			Type:      k8s.SecretsStatusType,
@@ -154,27 +213,40 @@ func (ctrl *ControlPlaneStaticPodController) Run(ctx context.Context, r controll
 			return err
 		}
 
-		configVersion := configStatusResource.TypedSpec().Version
-
 		touchedIDs := map[string]struct{}{}
 
 		for _, pod := range []struct {
 			f  func(context.Context, controller.Runtime, *zap.Logger, resource.Resource, string, string) (string, error)
 			md *resource.Metadata
+			// configsID is the pod in the ConfigStatus pod versions, if the pod is started with the rendered configs
+			configsID string
 		}{
 			{
-				f:  ctrl.manageAPIServer,
-				md: k8s.NewAPIServerConfig().Metadata(),
+				f:         ctrl.manageAPIServer,
+				md:        k8s.NewAPIServerConfig().Metadata(),
+				configsID: k8s.APIServerID,
 			},
 			{
 				f:  ctrl.manageControllerManager,
 				md: k8s.NewControllerManagerConfig().Metadata(),
 			},
 			{
-				f:  ctrl.manageScheduler,
-				md: k8s.NewSchedulerConfig().Metadata(),
+				f:         ctrl.manageScheduler,
+				md:        k8s.NewSchedulerConfig().Metadata(),
+				configsID: k8s.SchedulerID,
 			},
 		} {
+			var configVersion string
+
+			if pod.configsID != "" {
+				var ok bool
+
+				// the pod is not started until its configs are rendered
+				if configVersion, ok = configStatusResource.TypedSpec().PodVersions[pod.configsID]; !ok {
+					continue
+				}
+			}
+
 			res, err := r.Get(ctx, pod.md)
 			if err != nil {
 				if state.IsNotFoundError(err) {
@@ -346,6 +418,41 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 ) (string, error) {
 	cfg := configResource.(*k8s.APIServerConfig).TypedSpec()
 
+	konnectivity, err := konnectivityServerConfig(ctx, r)
+	if err != nil {
+		return "", err
+	}
+
+	authentication, err := hasAuthenticationConfig(ctx, r)
+	if err != nil {
+		return "", err
+	}
+
+	clientCA, err := apiServerClientCAConfig(ctx, r)
+	if err != nil {
+		return "", err
+	}
+
+	tracing, err := hasAPIServerTracingConfig(ctx, r)
+	if err != nil {
+		return "", err
+	}
+
+	naming, err := configNamingPolicy(ctx, r)
+	if err != nil {
+		return "", err
+	}
+
+	signer, err := serviceAccountSignerConfig(ctx, r)
+	if err != nil {
+		return "", err
+	}
+
+	shutdown, err := apiServerShutdownConfig(ctx, r)
+	if err != nil {
+		return "", err
+	}
+
 	enabledAdmissionPlugins := []string{"NodeRestriction"}
 
 	if cfg.PodSecurityPolicyEnabled {
@@ -357,7 +464,7 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 	}
 
 	builder := argsbuilder.Args{
-		"admission-control-config-file": filepath.Join(constants.KubernetesAPIServerConfigDir, "admission-control-config.yaml"),
+		"admission-control-config-file": filepath.Join(constants.KubernetesAPIServerConfigDir, naming.Filename("admission-control-config.yaml")),
 		"allow-privileged":              "true",
 		// Do not accept anonymous requests by default. Otherwise the kube-apiserver will set the request's group to system:unauthenticated exposing endpoints like /version etc.
 		"anonymous-auth":                     "false",
@@ -377,7 +484,7 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 		"tls-min-version":                  "VersionTLS12",
 		"tls-cipher-suites":                "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,TLS_RSA_WITH_AES_256_GCM_SHA384,TLS_RSA_WITH_AES_128_GCM_SHA256", //nolint:lll
 		"encryption-provider-config":       filepath.Join(constants.KubernetesAPIServerSecretsDir, "encryptionconfig.yaml"),
-		"audit-policy-file":                filepath.Join(constants.KubernetesAPIServerConfigDir, "auditpolicy.yaml"),
+		"audit-policy-file":                filepath.Join(constants.KubernetesAPIServerConfigDir, naming.Filename("auditpolicy.yaml")),
 		"audit-log-path":                   filepath.Join(constants.KubernetesAuditLogDir, "kube-apiserver.log"),
 		"audit-log-maxage":                 "30",
 		"audit-log-maxbackup":              "10",
@@ -409,7 +516,105 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 		builder.Set("cloud-provider", cfg.CloudProvider)
 	}
 
-	handleKubeAPIServerAuthorizationFlags(k8sVersion, builder, cfg.ExtraArgs)
+	// the rendered bundles replace the cluster CAs, e.g. to trust the clients of the aggregated API servers
+	if clientCA != nil {
+		if clientCA.ClientCA != "" {
+			builder.Set("client-ca-file", filepath.Join(constants.KubernetesAPIServerConfigDir, naming.Filename("client-ca.crt")))
+		}
+
+		if clientCA.RequestHeaderClientCA != "" {
+			builder.Set("requestheader-client-ca-file", filepath.Join(constants.KubernetesAPIServerConfigDir, naming.Filename("requestheader-client-ca.crt")))
+		}
+	}
+
+	handleKubeAPIServerAuthorizationFlags(k8sVersion, builder, cfg.ExtraArgs,
+		filepath.Join(cmp.Or(ctrl.APIServerSecretConfigDir, constants.KubernetesAPIServerConfigDir), naming.Filename("authorization-config.yaml")))
+
+	// older kube-apiserver versions can't honor the structured authentication config, so it's not rendered for them
+	if authentication && semver.Version(k8sVersion).GTE(structuredAuthMinVersions["authentication-config.yaml"]) {
+		builder.Set("authentication-config",
+			filepath.Join(cmp.Or(ctrl.APIServerSecretConfigDir, constants.KubernetesAPIServerConfigDir), naming.Filename("authentication-config.yaml")))
+	}
+
+	if konnectivity != nil {
+		builder.Set("egress-selector-config-file", filepath.Join(constants.KubernetesAPIServerConfigDir, naming.Filename("egress-selector-config.yaml")))
+	}
+
+	if tracing {
+		builder.Set("tracing-config-file", filepath.Join(constants.KubernetesAPIServerConfigDir, naming.Filename("tracing-config.yaml")))
+	}
+
+	featureGates, err := requiredFeatureGates(ctx, r, k8s.APIServerID)
+	if err != nil {
+		return "", err
+	}
+
+	enableFeatureGates(builder, k8sVersion, featureGates...)
+
+	var (
+		optionalVolumes      []v1.Volume
+		optionalVolumeMounts []v1.VolumeMount
+	)
+
+	if ctrl.APIServerSecretConfigDir != "" {
+		optionalVolumes = append(optionalVolumes, v1.Volume{
+			Name: "secret-config",
+			VolumeSource: v1.VolumeSource{
+				HostPath: &v1.HostPathVolumeSource{
+					Path: ctrl.APIServerSecretConfigDir,
+				},
+			},
+		})
+
+		optionalVolumeMounts = append(optionalVolumeMounts, v1.VolumeMount{
+			Name:      "secret-config",
+			MountPath: ctrl.APIServerSecretConfigDir,
+			ReadOnly:  true,
+		})
+	}
+
+	if signer != nil {
+		// the external signer provides the public keys as well, and the key flags are mutually exclusive with it
+		delete(builder, "service-account-key-file")
+		delete(builder, "service-account-signing-key-file")
+
+		builder.Set("service-account-signing-endpoint", signer.Endpoint)
+
+		enableFeatureGates(builder, k8sVersion, serviceAccountSignerFeatureGate)
+
+		optionalVolumes = append(optionalVolumes, v1.Volume{
+			Name: "service-account-signer",
+			VolumeSource: v1.VolumeSource{
+				HostPath: &v1.HostPathVolumeSource{
+					Path: filepath.Dir(signer.Endpoint),
+				},
+			},
+		})
+
+		optionalVolumeMounts = append(optionalVolumeMounts, v1.VolumeMount{
+			Name:      "service-account-signer",
+			MountPath: filepath.Dir(signer.Endpoint),
+			ReadOnly:  false,
+		})
+	}
+
+	// the cluster egress traffic goes through the konnectivity server unix socket, the TCP listen address doesn't need a mount
+	if konnectivity != nil && filepath.IsAbs(konnectivity.ListenAddress) {
+		optionalVolumes = append(optionalVolumes, v1.Volume{
+			Name: "konnectivity-server",
+			VolumeSource: v1.VolumeSource{
+				HostPath: &v1.HostPathVolumeSource{
+					Path: filepath.Dir(konnectivity.ListenAddress),
+				},
+			},
+		})
+
+		optionalVolumeMounts = append(optionalVolumeMounts, v1.VolumeMount{
+			Name:      "konnectivity-server",
+			MountPath: filepath.Dir(konnectivity.ListenAddress),
+			ReadOnly:  false,
+		})
+	}
 
 	mergePolicies := argsbuilder.MergePolicies{
 		"enable-admission-plugins": argsbuilder.MergeAdditive,
@@ -430,12 +635,35 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 		"kubelet-client-key":               argsbuilder.MergeDenied,
 		"service-account-key-file":         argsbuilder.MergeDenied,
 		"service-account-signing-key-file": argsbuilder.MergeDenied,
+		"service-account-signing-endpoint": argsbuilder.MergeDenied,
 		"tls-cert-file":                    argsbuilder.MergeDenied,
 		"tls-private-key-file":             argsbuilder.MergeDenied,
 		"authorization-config":             argsbuilder.MergeDenied,
+		"authentication-config":            argsbuilder.MergeDenied,
+		"egress-selector-config-file":      argsbuilder.MergeDenied,
+		"tracing-config-file":              argsbuilder.MergeDenied,
 	}
 
-	if err := builder.Merge(cfg.ExtraArgs, argsbuilder.WithMergePolicies(mergePolicies)); err != nil {
+	var terminationGracePeriodSeconds *int64
+
+	// the extra args might already configure the graceful shutdown, they only conflict with the shutdown config if it's set
+	if shutdown != nil {
+		for flag, value := range map[string]time.Duration{
+			"shutdown-delay-duration":                 shutdown.ShutdownDelay,
+			"shutdown-watch-termination-grace-period": shutdown.WatchTerminationGracePeriod,
+		} {
+			if value != 0 {
+				builder.Set(flag, value.String())
+				mergePolicies[flag] = argsbuilder.MergeDenied
+			}
+		}
+
+		if shutdown.TerminationGracePeriod != 0 {
+			terminationGracePeriodSeconds = pointer.To(int64(shutdown.TerminationGracePeriod / time.Second))
+		}
+	}
+
+	if err = builder.Merge(cfg.ExtraArgs, argsbuilder.WithMergePolicies(mergePolicies)); err != nil {
 		return "", err
 	}
 
@@ -511,7 +739,7 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 								MountPath: constants.KubernetesAuditLogDir,
 								ReadOnly:  false,
 							},
-						}, volumeMounts(cfg.ExtraVolumes)...),
+						}, append(optionalVolumeMounts, volumeMounts(cfg.ExtraVolumes)...)...),
 						Resources: resources,
 						SecurityContext: &v1.SecurityContext{
 							AllowPrivilegeEscalation: pointer.To(false),
@@ -528,7 +756,8 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 						},
 					},
 				},
-				HostNetwork: true,
+				HostNetwork:                   true,
+				TerminationGracePeriodSeconds: terminationGracePeriodSeconds,
 				SecurityContext: &v1.PodSecurityContext{
 					RunAsNonRoot: pointer.To(true),
 					RunAsUser:    pointer.To[int64](constants.KubernetesAPIServerRunUser),
@@ -559,7 +788,7 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 							},
 						},
 					},
-				}, volumes(cfg.ExtraVolumes)...),
+				}, append(optionalVolumes, volumes(cfg.ExtraVolumes)...)...),
 			},
 		})
 	})
@@ -741,7 +970,7 @@ func (ctrl *ControlPlaneStaticPodController) manageControllerManager(ctx context
 }
 
 func (ctrl *ControlPlaneStaticPodController) manageScheduler(ctx context.Context, r controller.Runtime,
-	_ *zap.Logger, configResource resource.Resource, secretsVersion, _ string,
+	_ *zap.Logger, configResource resource.Resource, secretsVersion, configVersion string,
 ) (string, error) {
 	cfg := configResource.(*k8s.SchedulerConfig).TypedSpec()
 
@@ -749,12 +978,17 @@ func (ctrl *ControlPlaneStaticPodController) manageScheduler(ctx context.Context
 		return "", nil
 	}
 
+	naming, err := configNamingPolicy(ctx, r)
+	if err != nil {
+		return "", err
+	}
+
 	args := []string{
 		"/usr/local/bin/kube-scheduler",
 	}
 
 	builder := argsbuilder.Args{
-		"config":                                 filepath.Join(constants.KubernetesSchedulerConfigDir, "scheduler-config.yaml"),
+		"config":                                 filepath.Join(constants.KubernetesSchedulerConfigDir, naming.Filename("scheduler-config.yaml")),
 		"authentication-tolerate-lookup-failure": "false",
 		"authentication-kubeconfig":              filepath.Join(constants.KubernetesSchedulerSecretsDir, "kubeconfig"),
 		"authorization-kubeconfig":               filepath.Join(constants.KubernetesSchedulerSecretsDir, "kubeconfig"),
@@ -764,7 +998,18 @@ func (ctrl *ControlPlaneStaticPodController) manageScheduler(ctx context.Context
 		"tls-min-version":                        "VersionTLS13",
 	}
 
+	kubeSchedulerVersion := compatibility.VersionFromImageRef(cfg.Image)
+
+	featureGates, err := requiredFeatureGates(ctx, r, k8s.SchedulerID)
+	if err != nil {
+		return "", err
+	}
+
+	enableFeatureGates(builder, kubeSchedulerVersion, featureGates...)
+
 	mergePolicies := argsbuilder.MergePolicies{
+		"feature-gates": argsbuilder.MergeAdditive,
+
 		"kubeconfig":                argsbuilder.MergeDenied,
 		"authentication-kubeconfig": argsbuilder.MergeDenied,
 		"authorization-kubeconfig":  argsbuilder.MergeDenied,
@@ -787,8 +1032,6 @@ func (ctrl *ControlPlaneStaticPodController) manageScheduler(ctx context.Context
 		env = append(env, goGCEnv)
 	}
 
-	kubeSchedulerVersion := compatibility.VersionFromImageRef(cfg.Image)
-
 	livenessProbe := &v1.Probe{
 		ProbeHandler: v1.ProbeHandler{
 			HTTPGet: &v1.HTTPGetAction{
@@ -811,6 +1054,28 @@ func (ctrl *ControlPlaneStaticPodController) manageScheduler(ctx context.Context
 		},
 	}
 
+	var (
+		optionalVolumes      []v1.Volume
+		optionalVolumeMounts []v1.VolumeMount
+	)
+
+	if ctrl.SchedulerSecretConfigDir != "" {
+		optionalVolumes = append(optionalVolumes, v1.Volume{
+			Name: "secret-config",
+			VolumeSource: v1.VolumeSource{
+				HostPath: &v1.HostPathVolumeSource{
+					Path: ctrl.SchedulerSecretConfigDir,
+				},
+			},
+		})
+
+		optionalVolumeMounts = append(optionalVolumeMounts, v1.VolumeMount{
+			Name:      "secret-config",
+			MountPath: ctrl.SchedulerSecretConfigDir,
+			ReadOnly:  true,
+		})
+	}
+
 	startupProbe := &v1.Probe{
 		ProbeHandler: v1.ProbeHandler{
 			HTTPGet: &v1.HTTPGetAction{
@@ -832,8 +1097,9 @@ func (ctrl *ControlPlaneStaticPodController) manageScheduler(ctx context.Context
 				Name:      k8s.SchedulerID,
 				Namespace: "kube-system",
 				Annotations: map[string]string{
-					constants.AnnotationStaticPodSecretsVersion: secretsVersion,
-					constants.AnnotationStaticPodConfigVersion:  configResource.Metadata().Version().String(),
+					constants.AnnotationStaticPodSecretsVersion:    secretsVersion,
+					constants.AnnotationStaticPodConfigFileVersion: configVersion,
+					constants.AnnotationStaticPodConfigVersion:     configResource.Metadata().Version().String(),
 				},
 				Labels: map[string]string{
 					"tier":                         "control-plane",
@@ -876,7 +1142,7 @@ func (ctrl *ControlPlaneStaticPodController) manageScheduler(ctx context.Context
 								MountPath: constants.KubernetesSchedulerConfigDir,
 								ReadOnly:  true,
 							},
-						}, volumeMounts(cfg.ExtraVolumes)...),
+						}, append(optionalVolumeMounts, volumeMounts(cfg.ExtraVolumes)...)...),
 						StartupProbe:   startupProbe,
 						LivenessProbe:  livenessProbe,
 						ReadinessProbe: readinessProbe,
@@ -915,7 +1181,7 @@ func (ctrl *ControlPlaneStaticPodController) manageScheduler(ctx context.Context
 							},
 						},
 					},
-				}, volumes(cfg.ExtraVolumes)...),
+				}, append(optionalVolumes, volumes(cfg.ExtraVolumes)...)...),
 			},
 		})
 	})
@@ -933,7 +1199,7 @@ func kubeAPIServerExtraArgsHasAuthorizationModeFlag(extraArgs map[string]string)
 	return ok
 }
 
-func handleKubeAPIServerAuthorizationFlags(kubeVersion compatibility.Version, argBuilder argsbuilder.Args, extraArgs map[string]string) {
+func handleKubeAPIServerAuthorizationFlags(kubeVersion compatibility.Version, argBuilder argsbuilder.Args, extraArgs map[string]string, authorizationConfigPath string) {
 	// this handle multiple cases:
 	// 1. user already has set `authorization-mode` flag, we'll just merge our default `authorization-mode` flag
 	if kubeAPIServerExtraArgsHasAuthorizationModeFlag(extraArgs) {
@@ -963,5 +1229,156 @@ func handleKubeAPIServerAuthorizationFlags(kubeVersion compatibility.Version, ar
 		argBuilder.Set("feature-gates", "StructuredAuthorizationConfiguration=true")
 	}
 
-	argBuilder.Set("authorization-config", filepath.Join(constants.KubernetesAPIServerConfigDir, "authorization-config.yaml"))
+	argBuilder.Set("authorization-config", authorizationConfigPath)
+}
+
+// configNamingPolicy returns the naming policy of the configs rendered by the RenderConfigsStaticPodController.
+//
+// Nil policy is returned if there is no policy, so that the configs are referenced by their default filenames.
+func configNamingPolicy(ctx context.Context, r controller.Reader) (*k8s.ConfigNamingPolicySpec, error) {
+	naming, err := safe.ReaderGetByID[*k8s.ConfigNamingPolicy](ctx, r, k8s.ConfigNamingPolicyID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting config naming policy resource: %w", err)
+	}
+
+	return naming.TypedSpec(), nil
+}
+
+// apiServerShutdownConfig returns the config of the kube-apiserver graceful shutdown, or nil if the defaults are used.
+//
+// The durations are validated by the RenderConfigsStaticPodController, which reports the invalid ones in the ConfigStatus.
+func apiServerShutdownConfig(ctx context.Context, r controller.Reader) (*k8s.APIServerShutdownConfigSpec, error) {
+	shutdown, err := safe.ReaderGetByID[*k8s.APIServerShutdownConfig](ctx, r, k8s.APIServerShutdownConfigID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting kube-apiserver shutdown config resource: %w", err)
+	}
+
+	return shutdown.TypedSpec(), nil
+}
+
+// serviceAccountSignerConfig returns the config of the external service account signer, or nil if the tokens are signed with the service account key.
+//
+// The endpoint is validated by the RenderConfigsStaticPodController, which reports the invalid one in the ConfigStatus.
+func serviceAccountSignerConfig(ctx context.Context, r controller.Reader) (*k8s.ServiceAccountSignerConfigSpec, error) {
+	signer, err := safe.ReaderGetByID[*k8s.ServiceAccountSignerConfig](ctx, r, k8s.ServiceAccountSignerConfigID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting service account signer config resource: %w", err)
+	}
+
+	return signer.TypedSpec(), nil
+}
+
+// apiServerClientCAConfig returns the client CA bundles rendered by the RenderConfigsStaticPodController, or nil if the cluster CAs are used.
+//
+// The bundles are validated by the RenderConfigsStaticPodController, which reports the invalid ones in the ConfigStatus.
+func apiServerClientCAConfig(ctx context.Context, r controller.Reader) (*k8s.APIServerClientCAConfigSpec, error) {
+	clientCA, err := safe.ReaderGetByID[*k8s.APIServerClientCAConfig](ctx, r, k8s.APIServerClientCAConfigID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting kube-apiserver client CA config resource: %w", err)
+	}
+
+	return clientCA.TypedSpec(), nil
+}
+
+// konnectivityServerConfig returns the konnectivity server config, or nil if the egress selector config isn't rendered.
+func konnectivityServerConfig(ctx context.Context, r controller.Reader) (*k8s.KonnectivityServerConfigSpec, error) {
+	konnectivity, err := safe.ReaderGetByID[*k8s.KonnectivityServerConfig](ctx, r, k8s.KonnectivityServerConfigID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting konnectivity server config resource: %w", err)
+	}
+
+	return konnectivity.TypedSpec(), nil
+}
+
+// hasAPIServerTracingConfig returns true if the tracing config is rendered for kube-apiserver.
+func hasAPIServerTracingConfig(ctx context.Context, r controller.Reader) (bool, error) {
+	_, err := safe.ReaderGetByID[*k8s.APIServerTracingConfig](ctx, r, k8s.APIServerTracingConfigID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("error getting kube-apiserver tracing config resource: %w", err)
+	}
+
+	return true, nil
+}
+
+// hasAuthenticationConfig returns true if the structured authentication config is rendered for kube-apiserver.
+//
+// The config might be split into multiple resources, which are merged into a single config.
+func hasAuthenticationConfig(ctx context.Context, r controller.Reader) (bool, error) {
+	authenticationConfigs, err := safe.ReaderListAll[*k8s.AuthenticationConfig](ctx, r)
+	if err != nil {
+		return false, fmt.Errorf("error listing authentication config resources: %w", err)
+	}
+
+	return authenticationConfigs.Len() > 0, nil
+}
+
+// featureGatesEnabledByDefaultSince are the minor versions of Kubernetes which enable the feature gates required by the rendered configs by default.
+//
+// The gates enabled by default are not set explicitly, as setting a gate fails once it's removed after the graduation.
+var featureGatesEnabledByDefaultSince = map[string]uint64{
+	"StructuredAuthenticationConfiguration": 30,
+	"StructuredAuthorizationConfiguration":  30,
+	serviceAccountSignerFeatureGate:         34,
+}
+
+// requiredFeatureGates returns the feature gates the configs rendered by the RenderConfigsStaticPodController depend on.
+func requiredFeatureGates(ctx context.Context, r controller.Reader, id resource.ID) ([]string, error) {
+	featureGates, err := safe.ReaderGetByID[*k8s.RequiredFeatureGates](ctx, r, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting required feature gates resource: %w", err)
+	}
+
+	return featureGates.TypedSpec().FeatureGates, nil
+}
+
+// enableFeatureGates adds the feature gates which are not enabled by default in the component version to the feature-gates flag,
+// keeping the ones which are already set.
+func enableFeatureGates(builder argsbuilder.Args, version compatibility.Version, featureGates ...string) {
+	var enabled []string
+
+	if builder.Contains("feature-gates") {
+		enabled = strings.Split(builder.Get("feature-gates"), ",")
+	}
+
+	for _, featureGate := range featureGates {
+		if since, ok := featureGatesEnabledByDefaultSince[featureGate]; ok && semver.Version(version).GTE(semver.Version{Major: 1, Minor: since}) {
+			continue
+		}
+
+		if !slices.ContainsFunc(enabled, func(gate string) bool { return strings.HasPrefix(gate, featureGate+"=") }) {
+			enabled = append(enabled, featureGate+"=true")
+		}
+	}
+
+	if len(enabled) > 0 {
+		builder.Set("feature-gates", strings.Join(enabled, ","))
+	}
 }
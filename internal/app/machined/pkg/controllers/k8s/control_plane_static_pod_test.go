@@ -33,7 +33,7 @@ type ControlPlaneStaticPodSuite struct {
 
 func (suite *ControlPlaneStaticPodSuite) TestReconcileDefaults() {
 	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
-	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	configStatus := newRenderedConfigStatus()
 	configAPIServer := k8s.NewAPIServerConfig()
 	configControllerManager := k8s.NewControllerManagerConfig()
 	configControllerManager.TypedSpec().Enabled = true
@@ -59,9 +59,71 @@ func (suite *ControlPlaneStaticPodSuite) TestReconcileDefaults() {
 	)
 }
 
-func (suite *ControlPlaneStaticPodSuite) TestReconcileExtraMounts() {
+// newRenderedConfigStatus returns the ConfigStatus with the configs of kube-apiserver and kube-scheduler rendered.
+func newRenderedConfigStatus() *k8s.ConfigStatus {
+	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	configStatus.TypedSpec().Ready = true
+	configStatus.TypedSpec().PodVersions = map[string]string{
+		k8s.APIServerID: "1",
+		k8s.SchedulerID: "1",
+	}
+
+	return configStatus
+}
+
+func (suite *ControlPlaneStaticPodSuite) TestReconcileConfigsNotRendered() {
 	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := k8s.NewAPIServerConfig()
+	configControllerManager := k8s.NewControllerManagerConfig()
+	configControllerManager.TypedSpec().Enabled = true
+	configScheduler := k8s.NewSchedulerConfig()
+	configScheduler.TypedSpec().Enabled = true
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), secretStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configAPIServer))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configControllerManager))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configScheduler))
+
+	// no static pods are started until the configs are rendered
+	rtestutils.AssertNoResource[*k8s.StaticPod](suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID)
+	rtestutils.AssertNoResource[*k8s.StaticPod](suite.Ctx(), suite.T(), suite.State(), k8s.ControllerManagerID)
+	rtestutils.AssertNoResource[*k8s.StaticPod](suite.Ctx(), suite.T(), suite.State(), k8s.SchedulerID)
+
+	// only kube-scheduler configs are rendered, e.g. while kube-apiserver configs are not rendered yet
 	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	configStatus.TypedSpec().Ready = true
+	configStatus.TypedSpec().PodVersions = map[string]string{
+		k8s.SchedulerID: "1",
+	}
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configStatus))
+
+	assertConfigFileVersion := func(id, version string) {
+		rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), id, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+			pod, err := k8sadapter.StaticPod(staticPod).Pod()
+			suite.Require().NoError(err)
+
+			assert.Equal(version, pod.Annotations[constants.AnnotationStaticPodConfigFileVersion])
+		})
+	}
+
+	assertConfigFileVersion(k8s.SchedulerID, "1")
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{k8s.ControllerManagerID}, func(*k8s.StaticPod, *assert.Assertions) {})
+	rtestutils.AssertNoResource[*k8s.StaticPod](suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID)
+
+	// kube-apiserver is started once its configs are rendered, and the pods are restarted on the changes of their configs only
+	configStatus.TypedSpec().PodVersions = map[string]string{
+		k8s.APIServerID: "1",
+		k8s.SchedulerID: "2",
+	}
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), configStatus))
+
+	assertConfigFileVersion(k8s.APIServerID, "1")
+	assertConfigFileVersion(k8s.SchedulerID, "2")
+}
+
+func (suite *ControlPlaneStaticPodSuite) TestReconcileExtraMounts() {
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configStatus := newRenderedConfigStatus()
 	configAPIServer := k8s.NewAPIServerConfig()
 	*configAPIServer.TypedSpec() = k8s.APIServerConfigSpec{
 		ExtraVolumes: []k8s.ExtraVolume{
@@ -168,6 +230,46 @@ func (suite *ControlPlaneStaticPodSuite) TestReconcileExtraMounts() {
 	})
 }
 
+func (suite *ControlPlaneStaticPodSuite) TestReconcileKMSSocketMount() {
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configStatus := newRenderedConfigStatus()
+	configAPIServer := k8s.NewAPIServerConfig()
+	*configAPIServer.TypedSpec() = k8s.APIServerConfigSpec{
+		// as produced from the KMS encryption config of the machine config
+		ExtraVolumes: []k8s.ExtraVolume{
+			{
+				Name:      "kms",
+				HostPath:  "/var/run/kms",
+				MountPath: "/var/run/kms",
+			},
+		},
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), secretStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configAPIServer))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		assert.NoError(err)
+
+		assert.Contains(apiServerPod.Spec.Volumes, v1.Volume{
+			Name: "kms",
+			VolumeSource: v1.VolumeSource{
+				HostPath: &v1.HostPathVolumeSource{
+					Path: "/var/run/kms",
+				},
+			},
+		})
+
+		// kube-apiserver has to connect to the KMS plugin socket, so the mount is writable
+		assert.Contains(apiServerPod.Spec.Containers[0].VolumeMounts, v1.VolumeMount{
+			Name:      "kms",
+			MountPath: "/var/run/kms",
+		})
+	})
+}
+
 func (suite *ControlPlaneStaticPodSuite) TestReconcileExtraArgsK8s() {
 	tests := []struct {
 		k8sVersion  string
@@ -283,7 +385,7 @@ func (suite *ControlPlaneStaticPodSuite) TestReconcileExtraArgsK8s() {
 		},
 	}
 
-	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	configStatus := newRenderedConfigStatus()
 	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
 	configAPIServer := k8s.NewAPIServerConfig()
 
@@ -340,7 +442,7 @@ func (suite *ControlPlaneStaticPodSuite) TestReconcileExtraArgsK8s() {
 }
 
 func (suite *ControlPlaneStaticPodSuite) TestReconcileEnvironmentVariables() {
-	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	configStatus := newRenderedConfigStatus()
 	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
 	configAPIServer := k8s.NewAPIServerConfig()
 
@@ -408,7 +510,7 @@ func (suite *ControlPlaneStaticPodSuite) TestReconcileEnvironmentVariables() {
 }
 
 func (suite *ControlPlaneStaticPodSuite) TestReconcileAdvertisedAddressArg() {
-	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	configStatus := newRenderedConfigStatus()
 	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
 
 	suite.Require().NoError(suite.State().Create(suite.Ctx(), configStatus))
@@ -443,8 +545,435 @@ func (suite *ControlPlaneStaticPodSuite) TestReconcileAdvertisedAddressArg() {
 	})
 }
 
+func (suite *ControlPlaneStaticPodSuite) TestReconcileConfigNamingPolicy() {
+	configStatus := newRenderedConfigStatus()
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := k8s.NewAPIServerConfig()
+	configAPIServer.TypedSpec().Image = "k8s.gcr.io/kube-apiserver:v1.30.0"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), secretStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configAPIServer))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.Contains(apiServerPod.Spec.Containers[0].Command, "--audit-policy-file="+filepath.Join(constants.KubernetesAPIServerConfigDir, "auditpolicy.yaml"))
+	})
+
+	naming := k8s.NewConfigNamingPolicy()
+	naming.TypedSpec().Filenames = map[string]string{
+		"auditpolicy.yaml":          "audit-policy-v2.yaml",
+		"authorization-config.yaml": "authz.yaml",
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), naming))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.Contains(apiServerPod.Spec.Containers[0].Command, "--audit-policy-file="+filepath.Join(constants.KubernetesAPIServerConfigDir, "audit-policy-v2.yaml"))
+		assert.Contains(apiServerPod.Spec.Containers[0].Command, "--authorization-config="+filepath.Join(constants.KubernetesAPIServerConfigDir, "authz.yaml"))
+		assert.Contains(apiServerPod.Spec.Containers[0].Command,
+			"--admission-control-config-file="+filepath.Join(constants.KubernetesAPIServerConfigDir, "admission-control-config.yaml"))
+	})
+}
+
+func (suite *ControlPlaneStaticPodSuite) TestReconcileServiceAccountSigner() {
+	configStatus := newRenderedConfigStatus()
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := k8s.NewAPIServerConfig()
+	configAPIServer.TypedSpec().Image = "k8s.gcr.io/kube-apiserver:v1.32.0"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), secretStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configAPIServer))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.Contains(apiServerPod.Spec.Containers[0].Command,
+			"--service-account-signing-key-file="+filepath.Join(constants.KubernetesAPIServerSecretsDir, "service-account.key"))
+	})
+
+	signerConfig := k8s.NewServiceAccountSignerConfig()
+	signerConfig.TypedSpec().Endpoint = "/var/run/signer/signer.sock"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), signerConfig))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.Contains(apiServerPod.Spec.Containers[0].Command, "--service-account-signing-endpoint=/var/run/signer/signer.sock")
+		assert.Contains(apiServerPod.Spec.Containers[0].Command, "--feature-gates=ExternalServiceAccountTokenSigner=true")
+		assert.NotContains(apiServerPod.Spec.Containers[0].Command,
+			"--service-account-signing-key-file="+filepath.Join(constants.KubernetesAPIServerSecretsDir, "service-account.key"))
+		assert.NotContains(apiServerPod.Spec.Containers[0].Command,
+			"--service-account-key-file="+filepath.Join(constants.KubernetesAPIServerSecretsDir, "service-account.pub"))
+
+		assert.Contains(apiServerPod.Spec.Containers[0].VolumeMounts, v1.VolumeMount{
+			Name:      "service-account-signer",
+			MountPath: "/var/run/signer",
+		})
+		assert.Contains(apiServerPod.Spec.Volumes, v1.Volume{
+			Name: "service-account-signer",
+			VolumeSource: v1.VolumeSource{
+				HostPath: &v1.HostPathVolumeSource{
+					Path: "/var/run/signer",
+				},
+			},
+		})
+	})
+}
+
+func (suite *ControlPlaneStaticPodSuite) TestReconcileServiceAccountSignerEnabledByDefault() {
+	configStatus := newRenderedConfigStatus()
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := k8s.NewAPIServerConfig()
+	configAPIServer.TypedSpec().Image = "k8s.gcr.io/kube-apiserver:v1.34.0"
+
+	signerConfig := k8s.NewServiceAccountSignerConfig()
+	signerConfig.TypedSpec().Endpoint = "/var/run/signer/signer.sock"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), secretStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), signerConfig))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configAPIServer))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.Contains(apiServerPod.Spec.Containers[0].Command, "--service-account-signing-endpoint=/var/run/signer/signer.sock")
+		assert.NotContains(strings.Join(apiServerPod.Spec.Containers[0].Command, " "), "ExternalServiceAccountTokenSigner")
+	})
+}
+
+func (suite *ControlPlaneStaticPodSuite) TestReconcileAPIServerShutdown() {
+	configStatus := newRenderedConfigStatus()
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := k8s.NewAPIServerConfig()
+	configAPIServer.TypedSpec().Image = "k8s.gcr.io/kube-apiserver:v1.32.0"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), secretStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configAPIServer))
+
+	shutdownConfig := k8s.NewAPIServerShutdownConfig()
+	shutdownConfig.TypedSpec().ShutdownDelay = 20 * time.Second
+	shutdownConfig.TypedSpec().TerminationGracePeriod = time.Minute
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), shutdownConfig))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.Contains(apiServerPod.Spec.Containers[0].Command, "--shutdown-delay-duration=20s")
+		assert.NotContains(strings.Join(apiServerPod.Spec.Containers[0].Command, " "), "--shutdown-watch-termination-grace-period")
+		if assert.NotNil(apiServerPod.Spec.TerminationGracePeriodSeconds) {
+			assert.EqualValues(60, *apiServerPod.Spec.TerminationGracePeriodSeconds)
+		}
+	})
+}
+
+func (suite *ControlPlaneStaticPodSuite) TestReconcileAuthenticationConfig() {
+	configStatus := newRenderedConfigStatus()
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := k8s.NewAPIServerConfig()
+	configAPIServer.TypedSpec().Image = "k8s.gcr.io/kube-apiserver:v1.29.0"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), secretStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), k8s.NewAuthenticationConfig(k8s.AuthenticationConfigID)))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configAPIServer))
+
+	// the structured authentication config isn't rendered for kube-apiserver < 1.30
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.NotContains(strings.Join(apiServerPod.Spec.Containers[0].Command, " "), "--authentication-config")
+	})
+
+	configAPIServer.TypedSpec().Image = "k8s.gcr.io/kube-apiserver:v1.30.0"
+
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), configAPIServer))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.Contains(apiServerPod.Spec.Containers[0].Command,
+			"--authentication-config="+filepath.Join(constants.KubernetesAPIServerConfigDir, "authentication-config.yaml"))
+	})
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), k8s.NewAuthenticationConfig(k8s.AuthenticationConfigID).Metadata()))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.NotContains(strings.Join(apiServerPod.Spec.Containers[0].Command, " "), "--authentication-config")
+	})
+}
+
+func (suite *ControlPlaneStaticPodSuite) TestReconcileKonnectivityServer() {
+	configStatus := newRenderedConfigStatus()
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := k8s.NewAPIServerConfig()
+	configAPIServer.TypedSpec().Image = "k8s.gcr.io/kube-apiserver:v1.32.0"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), secretStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configAPIServer))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.NotContains(strings.Join(apiServerPod.Spec.Containers[0].Command, " "), "--egress-selector-config-file")
+	})
+
+	konnectivityConfig := k8s.NewKonnectivityServerConfig()
+	konnectivityConfig.TypedSpec().ListenAddress = "/etc/kubernetes/konnectivity-server/konnectivity-server.socket"
+	konnectivityConfig.TypedSpec().AgentNamespace = "kube-system"
+	konnectivityConfig.TypedSpec().AgentServiceAccount = "konnectivity-agent"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), konnectivityConfig))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.Contains(apiServerPod.Spec.Containers[0].Command,
+			"--egress-selector-config-file="+filepath.Join(constants.KubernetesAPIServerConfigDir, "egress-selector-config.yaml"))
+
+		assert.Contains(apiServerPod.Spec.Containers[0].VolumeMounts, v1.VolumeMount{
+			Name:      "konnectivity-server",
+			MountPath: "/etc/kubernetes/konnectivity-server",
+		})
+		assert.Contains(apiServerPod.Spec.Volumes, v1.Volume{
+			Name: "konnectivity-server",
+			VolumeSource: v1.VolumeSource{
+				HostPath: &v1.HostPathVolumeSource{
+					Path: "/etc/kubernetes/konnectivity-server",
+				},
+			},
+		})
+	})
+
+	// the TCP listen address is reached over the host network
+	konnectivityConfig.TypedSpec().ListenAddress = "127.0.0.1:8131"
+
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), konnectivityConfig))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.Contains(apiServerPod.Spec.Containers[0].Command,
+			"--egress-selector-config-file="+filepath.Join(constants.KubernetesAPIServerConfigDir, "egress-selector-config.yaml"))
+
+		for _, volume := range apiServerPod.Spec.Volumes {
+			assert.NotEqual("konnectivity-server", volume.Name)
+		}
+	})
+}
+
+func (suite *ControlPlaneStaticPodSuite) TestReconcileAPIServerTracing() {
+	configStatus := newRenderedConfigStatus()
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := k8s.NewAPIServerConfig()
+	configAPIServer.TypedSpec().Image = "k8s.gcr.io/kube-apiserver:v1.32.0"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), secretStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configAPIServer))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.NotContains(strings.Join(apiServerPod.Spec.Containers[0].Command, " "), "--tracing-config-file")
+	})
+
+	tracingConfig := k8s.NewAPIServerTracingConfig()
+	tracingConfig.TypedSpec().Endpoint = "10.5.0.1:4317"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), tracingConfig))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.Contains(apiServerPod.Spec.Containers[0].Command,
+			"--tracing-config-file="+filepath.Join(constants.KubernetesAPIServerConfigDir, "tracing-config.yaml"))
+	})
+}
+
+func (suite *ControlPlaneStaticPodSuite) TestReconcileRequiredFeatureGates() {
+	configStatus := newRenderedConfigStatus()
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := k8s.NewAPIServerConfig()
+	configAPIServer.TypedSpec().Image = "k8s.gcr.io/kube-apiserver:v1.29.0"
+	configScheduler := k8s.NewSchedulerConfig()
+	configScheduler.TypedSpec().Enabled = true
+	configScheduler.TypedSpec().Image = "k8s.gcr.io/kube-scheduler:v1.29.0"
+
+	apiServerFeatureGates := k8s.NewRequiredFeatureGates(k8s.APIServerID)
+	apiServerFeatureGates.TypedSpec().FeatureGates = []string{"CustomGate", "StructuredAuthorizationConfiguration"}
+
+	schedulerFeatureGates := k8s.NewRequiredFeatureGates(k8s.SchedulerID)
+	schedulerFeatureGates.TypedSpec().FeatureGates = []string{"CustomGate"}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), secretStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), apiServerFeatureGates))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), schedulerFeatureGates))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configAPIServer))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configScheduler))
+
+	// the gate set for the authorization config is not duplicated
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.Contains(apiServerPod.Spec.Containers[0].Command, "--feature-gates=StructuredAuthorizationConfiguration=true,CustomGate=true")
+	})
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.SchedulerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		schedulerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(schedulerPod.Spec.Containers)
+
+		assert.Contains(schedulerPod.Spec.Containers[0].Command, "--feature-gates=CustomGate=true")
+	})
+
+	// the gates enabled by default are not set
+	configAPIServer.TypedSpec().Image = "k8s.gcr.io/kube-apiserver:v1.30.0"
+
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), configAPIServer))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.Contains(apiServerPod.Spec.Containers[0].Command, "--feature-gates=CustomGate=true")
+	})
+
+	apiServerFeatureGates.TypedSpec().FeatureGates = []string{"StructuredAuthorizationConfiguration"}
+
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), apiServerFeatureGates))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.NotContains(strings.Join(apiServerPod.Spec.Containers[0].Command, " "), "--feature-gates")
+	})
+}
+
+func (suite *ControlPlaneStaticPodSuite) TestReconcileClientCA() {
+	configStatus := newRenderedConfigStatus()
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := k8s.NewAPIServerConfig()
+	configAPIServer.TypedSpec().Image = "k8s.gcr.io/kube-apiserver:v1.32.0"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), secretStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configAPIServer))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.Contains(apiServerPod.Spec.Containers[0].Command, "--client-ca-file="+filepath.Join(constants.KubernetesAPIServerSecretsDir, "ca.crt"))
+		assert.Contains(apiServerPod.Spec.Containers[0].Command,
+			"--requestheader-client-ca-file="+filepath.Join(constants.KubernetesAPIServerSecretsDir, "aggregator-ca.crt"))
+	})
+
+	naming := k8s.NewConfigNamingPolicy()
+	naming.TypedSpec().Filenames = map[string]string{
+		"requestheader-client-ca.crt": "front-proxy-ca.crt",
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), naming))
+
+	// only the bundles which are set are rendered, the cluster CA is used for the other one
+	clientCAConfig := k8s.NewAPIServerClientCAConfig()
+	clientCAConfig.TypedSpec().RequestHeaderClientCA = "front-proxy CA bundle"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), clientCAConfig))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.Contains(apiServerPod.Spec.Containers[0].Command, "--client-ca-file="+filepath.Join(constants.KubernetesAPIServerSecretsDir, "ca.crt"))
+		assert.Contains(apiServerPod.Spec.Containers[0].Command,
+			"--requestheader-client-ca-file="+filepath.Join(constants.KubernetesAPIServerConfigDir, "front-proxy-ca.crt"))
+	})
+
+	clientCAConfig.TypedSpec().ClientCA = "client CA bundle"
+
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), clientCAConfig))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(apiServerPod.Spec.Containers)
+
+		assert.Contains(apiServerPod.Spec.Containers[0].Command, "--client-ca-file="+filepath.Join(constants.KubernetesAPIServerConfigDir, "client-ca.crt"))
+	})
+}
+
 func (suite *ControlPlaneStaticPodSuite) TestControlPlaneStaticPodsExceptScheduler() {
-	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	configStatus := newRenderedConfigStatus()
 	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
 	configAPIServer := k8s.NewAPIServerConfig()
 	configControllerManager := k8s.NewControllerManagerConfig()
@@ -486,7 +1015,7 @@ func (suite *ControlPlaneStaticPodSuite) TestControlPlaneStaticPodsExceptSchedul
 }
 
 func (suite *ControlPlaneStaticPodSuite) TestReconcileStaticPodResources() {
-	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	configStatus := newRenderedConfigStatus()
 	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
 
 	configAPIServer := k8s.NewAPIServerConfig()
@@ -602,3 +1131,98 @@ func TestControlPlaneStaticPodSuite(t *testing.T) {
 		},
 	})
 }
+
+type ControlPlaneStaticPodSecretDirSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestControlPlaneStaticPodSecretDirSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &ControlPlaneStaticPodSecretDirSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&k8sctrl.ControlPlaneStaticPodController{
+					APIServerSecretConfigDir: "/run/kube-apiserver",
+					SchedulerSecretConfigDir: "/run/kube-scheduler",
+				}))
+
+				etcdService := v1alpha1.NewService("etcd")
+				etcdService.TypedSpec().Running = true
+				etcdService.TypedSpec().Healthy = true
+
+				suite.Require().NoError(suite.State().Create(suite.Ctx(), etcdService))
+			},
+			AfterTearDown: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.State().Destroy(suite.Ctx(), v1alpha1.NewService("etcd").Metadata()))
+			},
+		},
+	})
+}
+
+func (suite *ControlPlaneStaticPodSecretDirSuite) TestReconcileAuthenticationConfig() {
+	configStatus := newRenderedConfigStatus()
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configAPIServer := k8s.NewAPIServerConfig()
+	configAPIServer.TypedSpec().Image = "k8s.gcr.io/kube-apiserver:v1.32.0"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), secretStatus))
+	// the config contributed by other resources is rendered without the machine config one
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), k8s.NewAuthenticationConfig("team-a")))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configAPIServer))
+
+	assertAuthenticationConfigFlag := func() {
+		rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+			apiServerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+			suite.Require().NoError(err)
+
+			assert.NotEmpty(apiServerPod.Spec.Containers)
+
+			// the merged config is rendered to a single file in the secret config dir
+			assert.Equal(1, strings.Count(strings.Join(apiServerPod.Spec.Containers[0].Command, " "), "--authentication-config="))
+			assert.Contains(apiServerPod.Spec.Containers[0].Command, "--authentication-config=/run/kube-apiserver/authentication-config.yaml")
+		})
+	}
+
+	assertAuthenticationConfigFlag()
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), k8s.NewAuthenticationConfig(k8s.AuthenticationConfigID)))
+
+	assertAuthenticationConfigFlag()
+}
+
+func (suite *ControlPlaneStaticPodSecretDirSuite) TestReconcileSchedulerSecretConfigDir() {
+	configStatus := newRenderedConfigStatus()
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+	configScheduler := k8s.NewSchedulerConfig()
+	configScheduler.TypedSpec().Enabled = true
+	configScheduler.TypedSpec().Image = "k8s.gcr.io/kube-scheduler:v1.32.0"
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), secretStatus))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), configScheduler))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.SchedulerID, func(staticPod *k8s.StaticPod, assert *assert.Assertions) {
+		schedulerPod, err := k8sadapter.StaticPod(staticPod).Pod()
+		suite.Require().NoError(err)
+
+		assert.NotEmpty(schedulerPod.Spec.Containers)
+
+		// the extracted private keys of the extenders are rendered to the secret config dir
+		assert.Contains(schedulerPod.Spec.Containers[0].VolumeMounts, v1.VolumeMount{
+			Name:      "secret-config",
+			MountPath: "/run/kube-scheduler",
+			ReadOnly:  true,
+		})
+		assert.Contains(schedulerPod.Spec.Volumes, v1.Volume{
+			Name: "secret-config",
+			VolumeSource: v1.VolumeSource{
+				HostPath: &v1.HostPathVolumeSource{
+					Path: "/run/kube-scheduler",
+				},
+			},
+		})
+	})
+}
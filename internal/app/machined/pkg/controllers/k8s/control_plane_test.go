@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/go-pointer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -392,6 +393,441 @@ func (suite *K8sControlPlaneSuite) TestReconcileAdditionalAuthorizationConfigAut
 	)
 }
 
+func (suite *K8sControlPlaneSuite) TestReconcileAuthenticationConfig() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.setupMachine(cfg)
+
+	rtestutils.AssertNoResource[*k8s.AuthenticationConfig](suite.Ctx(), suite.T(), suite.State(), k8s.AuthenticationConfigID)
+
+	authenticationConfig := map[string]any{
+		"apiVersion": "apiserver.config.k8s.io/v1beta1",
+		"kind":       "AuthenticationConfiguration",
+		"jwt": []any{
+			map[string]any{
+				"issuer": map[string]any{
+					"url":       "https://issuer.example.com",
+					"audiences": []any{"kubernetes"},
+				},
+			},
+		},
+	}
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = &v1alpha1.APIServerConfig{
+		AuthenticationConfigConfig: v1alpha1.Unstructured{
+			Object: authenticationConfig,
+		},
+	}
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.AuthenticationConfigID,
+		func(res *k8s.AuthenticationConfig, assert *assert.Assertions) {
+			assert.Equal(authenticationConfig, res.TypedSpec().Config)
+		},
+	)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = nil
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertNoResource[*k8s.AuthenticationConfig](suite.Ctx(), suite.T(), suite.State(), k8s.AuthenticationConfigID)
+}
+
+func (suite *K8sControlPlaneSuite) TestReconcileKonnectivityServer() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.setupMachine(cfg)
+
+	rtestutils.AssertNoResource[*k8s.KonnectivityServerConfig](suite.Ctx(), suite.T(), suite.State(), k8s.KonnectivityServerConfigID)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = &v1alpha1.APIServerConfig{
+		KonnectivityServerConfig: &v1alpha1.KonnectivityServerConfig{
+			ServerListenAddress: "/etc/kubernetes/konnectivity-server/konnectivity-server.socket",
+			ServerEgressSelections: []v1alpha1.KonnectivityEgressSelectionConfig{
+				{
+					SelectionName:          "etcd",
+					SelectionProxyProtocol: "HTTPConnect",
+					SelectionTransport:     "tcp",
+					SelectionAddress:       "127.0.0.1:8132",
+				},
+			},
+		},
+	}
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.KonnectivityServerConfigID,
+		func(res *k8s.KonnectivityServerConfig, assert *assert.Assertions) {
+			assert.Equal("/etc/kubernetes/konnectivity-server/konnectivity-server.socket", res.TypedSpec().ListenAddress)
+			assert.Equal("kube-system", res.TypedSpec().AgentNamespace)
+			assert.Equal("konnectivity-agent", res.TypedSpec().AgentServiceAccount)
+			assert.Equal([]k8s.EgressSelectionSpec{
+				{
+					Name:          "etcd",
+					ProxyProtocol: "HTTPConnect",
+					Transport:     "tcp",
+					Address:       "127.0.0.1:8132",
+				},
+			}, res.TypedSpec().EgressSelections)
+		},
+	)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = nil
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertNoResource[*k8s.KonnectivityServerConfig](suite.Ctx(), suite.T(), suite.State(), k8s.KonnectivityServerConfigID)
+}
+
+func (suite *K8sControlPlaneSuite) TestReconcileServiceAccountIssuerDiscovery() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.setupMachine(cfg)
+
+	rtestutils.AssertNoResource[*k8s.ServiceAccountIssuerDiscovery](suite.Ctx(), suite.T(), suite.State(), k8s.ServiceAccountIssuerDiscoveryID)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = &v1alpha1.APIServerConfig{
+		ServiceAccountIssuerDiscoveryConfig: &v1alpha1.ServiceAccountIssuerDiscoveryConfig{
+			IssuerDiscoveryDocument: `{"issuer":"https://foo:6443","jwks_uri":"https://foo:6443/openid/v1/jwks"}`,
+			IssuerJWKS:              `{"keys":[]}`,
+		},
+	}
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.ServiceAccountIssuerDiscoveryID,
+		func(res *k8s.ServiceAccountIssuerDiscovery, assert *assert.Assertions) {
+			assert.Equal("https://foo:6443", res.TypedSpec().Issuer)
+			assert.Equal(`{"issuer":"https://foo:6443","jwks_uri":"https://foo:6443/openid/v1/jwks"}`, res.TypedSpec().DiscoveryDocument)
+			assert.Equal(`{"keys":[]}`, res.TypedSpec().JWKS)
+		},
+	)
+
+	// the issuer follows the kube-apiserver flag override
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig.ExtraArgsConfig = map[string]string{
+		"service-account-issuer": "https://issuer.example.com",
+	}
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.ServiceAccountIssuerDiscoveryID,
+		func(res *k8s.ServiceAccountIssuerDiscovery, assert *assert.Assertions) {
+			assert.Equal("https://issuer.example.com", res.TypedSpec().Issuer)
+		},
+	)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = nil
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertNoResource[*k8s.ServiceAccountIssuerDiscovery](suite.Ctx(), suite.T(), suite.State(), k8s.ServiceAccountIssuerDiscoveryID)
+}
+
+func (suite *K8sControlPlaneSuite) TestReconcileAirGapped() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.setupMachine(cfg)
+
+	rtestutils.AssertNoResource[*k8s.AirGappedConfig](suite.Ctx(), suite.T(), suite.State(), k8s.AirGappedConfigID)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = &v1alpha1.APIServerConfig{
+		AirGappedConfig: &v1alpha1.AirGappedConfig{
+			AirGappedAllowedHosts: []string{"dex.corp.internal", ".idp.corp.internal"},
+		},
+	}
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.AirGappedConfigID,
+		func(res *k8s.AirGappedConfig, assert *assert.Assertions) {
+			assert.Equal([]string{"dex.corp.internal", ".idp.corp.internal"}, res.TypedSpec().AllowedHosts)
+		},
+	)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = nil
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertNoResource[*k8s.AirGappedConfig](suite.Ctx(), suite.T(), suite.State(), k8s.AirGappedConfigID)
+}
+
+func (suite *K8sControlPlaneSuite) TestReconcileAPIServerClientCA() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+					ClusterCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("cluster CA\n"),
+					},
+					ClusterAcceptedCAs: []*x509.PEMEncodedCertificate{
+						{Crt: []byte("accepted CA\n")},
+					},
+					ClusterAggregatorCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("aggregator CA\n"),
+					},
+				},
+			},
+		),
+	)
+
+	suite.setupMachine(cfg)
+
+	rtestutils.AssertNoResource[*k8s.APIServerClientCAConfig](suite.Ctx(), suite.T(), suite.State(), k8s.APIServerClientCAConfigID)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = &v1alpha1.APIServerConfig{
+		APIServerRequestHeaderClientCAs: []*x509.PEMEncodedCertificate{
+			{Crt: []byte("front-proxy CA\n")},
+		},
+	}
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	// only the bundles with the additional CAs are rendered, the cluster CAs are still trusted
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerClientCAConfigID,
+		func(res *k8s.APIServerClientCAConfig, assert *assert.Assertions) {
+			assert.Empty(res.TypedSpec().ClientCA)
+			assert.Equal("aggregator CA\nfront-proxy CA\n", res.TypedSpec().RequestHeaderClientCA)
+		},
+	)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig.APIServerClientCAs = []*x509.PEMEncodedCertificate{
+		{Crt: []byte("client CA\n")},
+	}
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerClientCAConfigID,
+		func(res *k8s.APIServerClientCAConfig, assert *assert.Assertions) {
+			assert.Equal("accepted CA\ncluster CA\nclient CA\n", res.TypedSpec().ClientCA)
+			assert.Equal("aggregator CA\nfront-proxy CA\n", res.TypedSpec().RequestHeaderClientCA)
+		},
+	)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = nil
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertNoResource[*k8s.APIServerClientCAConfig](suite.Ctx(), suite.T(), suite.State(), k8s.APIServerClientCAConfigID)
+}
+
+func (suite *K8sControlPlaneSuite) TestReconcileAPIServerTracing() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.setupMachine(cfg)
+
+	rtestutils.AssertNoResource[*k8s.APIServerTracingConfig](suite.Ctx(), suite.T(), suite.State(), k8s.APIServerTracingConfigID)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = &v1alpha1.APIServerConfig{
+		TracingConfig: &v1alpha1.APIServerTracingConfig{
+			TracingEndpoint:               "10.5.0.1:4317",
+			TracingSamplingRatePerMillion: 100,
+		},
+	}
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerTracingConfigID,
+		func(res *k8s.APIServerTracingConfig, assert *assert.Assertions) {
+			assert.Equal("10.5.0.1:4317", res.TypedSpec().Endpoint)
+			assert.EqualValues(100, res.TypedSpec().SamplingRatePerMillion)
+		},
+	)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = nil
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertNoResource[*k8s.APIServerTracingConfig](suite.Ctx(), suite.T(), suite.State(), k8s.APIServerTracingConfigID)
+}
+
+func (suite *K8sControlPlaneSuite) TestReconcileServiceAccountSigner() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.setupMachine(cfg)
+
+	rtestutils.AssertNoResource[*k8s.ServiceAccountSignerConfig](suite.Ctx(), suite.T(), suite.State(), k8s.ServiceAccountSignerConfigID)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = &v1alpha1.APIServerConfig{
+		APIServerServiceAccountSigningEndpoint: "/var/run/kube-apiserver-signer/signer.sock",
+	}
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.ServiceAccountSignerConfigID,
+		func(res *k8s.ServiceAccountSignerConfig, assert *assert.Assertions) {
+			assert.Equal("/var/run/kube-apiserver-signer/signer.sock", res.TypedSpec().Endpoint)
+		},
+	)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = nil
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertNoResource[*k8s.ServiceAccountSignerConfig](suite.Ctx(), suite.T(), suite.State(), k8s.ServiceAccountSignerConfigID)
+}
+
+func (suite *K8sControlPlaneSuite) TestReconcileAPIServerShutdown() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.setupMachine(cfg)
+
+	rtestutils.AssertNoResource[*k8s.APIServerShutdownConfig](suite.Ctx(), suite.T(), suite.State(), k8s.APIServerShutdownConfigID)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = &v1alpha1.APIServerConfig{
+		ShutdownConfig: &v1alpha1.APIServerShutdownConfig{
+			APIServerShutdownDelay:               10 * time.Second,
+			APIServerWatchTerminationGracePeriod: 5 * time.Second,
+			APIServerTerminationGracePeriod:      30 * time.Second,
+		},
+	}
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.APIServerShutdownConfigID,
+		func(res *k8s.APIServerShutdownConfig, assert *assert.Assertions) {
+			assert.Equal(k8s.APIServerShutdownConfigSpec{
+				ShutdownDelay:               10 * time.Second,
+				WatchTerminationGracePeriod: 5 * time.Second,
+				TerminationGracePeriod:      30 * time.Second,
+			}, *res.TypedSpec())
+		},
+	)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.APIServerConfig = nil
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertNoResource[*k8s.APIServerShutdownConfig](suite.Ctx(), suite.T(), suite.State(), k8s.APIServerShutdownConfigID)
+}
+
 func (suite *K8sControlPlaneSuite) TestReconcileTransitionWorker() {
 	u, err := url.Parse("https://foo:6443")
 	suite.Require().NoError(err)
@@ -561,6 +997,62 @@ func (suite *K8sControlPlaneSuite) TestReconcileExtraVolumes() {
 	)
 }
 
+func (suite *K8sControlPlaneSuite) TestReconcileKMSEncryption() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						ExtraVolumesConfig: []v1alpha1.VolumeMountConfig{
+							{
+								VolumeHostPath:  "/var/lib",
+								VolumeMountPath: "/var/foo/",
+							},
+						},
+						KMSEncryptionConfig: &v1alpha1.KMSEncryptionConfig{
+							KMSName:     "vault",
+							KMSEndpoint: "unix:///var/run/kms/kms.sock",
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.setupMachine(cfg)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{k8s.APIServerConfigID},
+		func(apiServer *k8s.APIServerConfig, assert *assert.Assertions) {
+			assert.Equal(
+				[]k8s.ExtraVolume{
+					{
+						Name:      "var-foo",
+						HostPath:  "/var/lib",
+						MountPath: "/var/foo/",
+					},
+					{
+						Name:      "kms",
+						HostPath:  "/var/run/kms",
+						MountPath: "/var/run/kms",
+					},
+				}, apiServer.TypedSpec().ExtraVolumes,
+			)
+		},
+	)
+}
+
 func (suite *K8sControlPlaneSuite) TestReconcileEnvironment() {
 	u, err := url.Parse("https://foo:6443")
 	suite.Require().NoError(err)
@@ -924,6 +1416,99 @@ func (suite *K8sControlPlaneSuite) TestReconcileKubeProxyModeLegacy() {
 	)
 }
 
+func (suite *K8sControlPlaneSuite) TestReconcileConfigRenderPolicy() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.setupMachine(cfg)
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.ConfigRenderPolicyID,
+		func(res *k8s.ConfigRenderPolicy, assert *assert.Assertions) {
+			assert.False(res.TypedSpec().ValidateOnly)
+			assert.False(res.TypedSpec().StrictAdmissionPlugins)
+			assert.False(res.TypedSpec().StrictStructuredAuth)
+		},
+	)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.StaticPodConfigsConfig = &v1alpha1.StaticPodConfigsConfig{
+		ConfigsValidateOnly:           pointer.To(true),
+		ConfigsStrictAdmissionPlugins: pointer.To(true),
+		ConfigsStrictStructuredAuth:   pointer.To(true),
+	}
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.ConfigRenderPolicyID,
+		func(res *k8s.ConfigRenderPolicy, assert *assert.Assertions) {
+			assert.True(res.TypedSpec().ValidateOnly)
+			assert.True(res.TypedSpec().StrictAdmissionPlugins)
+			assert.True(res.TypedSpec().StrictStructuredAuth)
+		},
+	)
+}
+
+func (suite *K8sControlPlaneSuite) TestReconcileConfigNamingPolicy() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.setupMachine(cfg)
+
+	rtestutils.AssertNoResource[*k8s.ConfigNamingPolicy](suite.Ctx(), suite.T(), suite.State(), k8s.ConfigNamingPolicyID)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.StaticPodConfigsConfig = &v1alpha1.StaticPodConfigsConfig{
+		ConfigsFilenames: map[string]string{
+			"auditpolicy.yaml": "audit-policy-v2.yaml",
+		},
+	}
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), k8s.ConfigNamingPolicyID,
+		func(res *k8s.ConfigNamingPolicy, assert *assert.Assertions) {
+			assert.Equal(map[string]string{"auditpolicy.yaml": "audit-policy-v2.yaml"}, res.TypedSpec().Filenames)
+		},
+	)
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.StaticPodConfigsConfig = nil
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertNoResource[*k8s.ConfigNamingPolicy](suite.Ctx(), suite.T(), suite.State(), k8s.ConfigNamingPolicyID)
+}
+
 func TestK8sControlPlaneSuite(t *testing.T) {
 	t.Parallel()
 
@@ -934,11 +1519,21 @@ func TestK8sControlPlaneSuite(t *testing.T) {
 				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneAPIServerController()))
 				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneAdmissionControlController()))
 				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneAuditPolicyController()))
+				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneAuthenticationController()))
 				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneAuthorizationController()))
 				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneBootstrapManifestsController()))
+				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneConfigRenderPolicyController()))
+				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneConfigNamingPolicyController()))
 				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneControllerManagerController()))
 				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneExtraManifestsController()))
+				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneKonnectivityServerController()))
+				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneServiceAccountIssuerDiscoveryController()))
+				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneAirGappedController()))
+				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneAPIServerClientCAController()))
+				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneAPIServerTracingController()))
 				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneSchedulerController()))
+				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneServiceAccountSignerController()))
+				suite.Require().NoError(suite.Runtime().RegisterController(k8sctrl.NewControlPlaneAPIServerShutdownController()))
 			},
 		},
 	})
@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+// AuthorizationConfig is exported for testing.
+var AuthorizationConfig = authorizationConfig
+
+// EgressSelectorConfig is exported for testing.
+var EgressSelectorConfig = egressSelectorConfig
+
+// APIServerTracingConfig is exported for testing.
+var APIServerTracingConfig = apiServerTracingConfig
+
+// ValidateServiceAccountSigner is exported for testing.
+var ValidateServiceAccountSigner = validateServiceAccountSigner
+
+// ValidateAPIServerShutdown is exported for testing.
+var ValidateAPIServerShutdown = validateAPIServerShutdown
+
+// WarnAuthConfigMismatches is exported for testing.
+var WarnAuthConfigMismatches = warnAuthConfigMismatches
+
+// ConfigWatcher is exported for testing.
+type ConfigWatcher = configWatcher
+
+// NewConfigWatcher is exported for testing.
+var NewConfigWatcher = newConfigWatcher
+
+// SortConfigUpdates sorts config filenames in the swap order.
+func SortConfigUpdates(filenames []string) []string {
+	updates := xslices.Map(filenames, func(filename string) configUpdate { return configUpdate{filename: filename} })
+
+	sortConfigUpdates(updates)
+
+	return xslices.Map(updates, func(update configUpdate) string { return update.filename })
+}
+
+// AuditPolicyConfig is exported for testing.
+var AuditPolicyConfig = auditPolicyConfig
+
+// VerifyConfigAttributes is exported for testing.
+var VerifyConfigAttributes = verifyConfigAttributes
+
+// CheckWritableMount is exported for testing.
+var CheckWritableMount = checkWritableMount
+
+// CheckSameFilesystem is exported for testing.
+var CheckSameFilesystem = checkSameFilesystem
+
+// AdmissionControlConfig is exported for testing.
+var AdmissionControlConfig = admissionControlConfig
+
+// AuthenticationConfig is exported for testing.
+var AuthenticationConfig = authenticationConfig
+
+// SchedulerConfig is exported for testing.
+var SchedulerConfig = schedulerConfig
+
+// ValidateConfigNaming checks the naming policy against the configs of a single pod.
+func ValidateConfigNaming(filenames []string, naming *k8s.ConfigNamingPolicySpec, logger *zap.Logger) error {
+	pod := staticPodConfigs{name: "kube-apiserver"}
+
+	for _, filename := range filenames {
+		pod.configs = append(pod.configs, configFile{filename: filename})
+	}
+
+	return validateConfigNaming([]staticPodConfigs{pod}, naming, logger)
+}
+
+// ValidateConfigDirMode is exported for testing.
+var ValidateConfigDirMode = validateConfigDirMode
+
+// AuditPolicyFieldPath is exported for testing.
+var AuditPolicyFieldPath = auditPolicyFieldPath
+
+// AuthorizationFieldPath is exported for testing.
+var AuthorizationFieldPath = authorizationFieldPath
+
+// ServiceAccountIssuerDiscoveryDocument is exported for testing.
+var ServiceAccountIssuerDiscoveryDocument = serviceAccountIssuerDiscoveryDocument
+
+// ServiceAccountIssuerJWKS is exported for testing.
+var ServiceAccountIssuerJWKS = serviceAccountIssuerJWKS
+
+// LockConfigDirs acquires the locks of the config directories.
+func LockConfigDirs(ctx context.Context, dirs ...string) (func(), error) {
+	return lockConfigDirs(ctx, xslices.Map(dirs, func(dir string) staticPodConfigs { return staticPodConfigs{name: filepath.Base(dir), directory: dir} }))
+}
+
+// CanonicalConfig encodes the rendered config, and rewrites it in the canonical form.
+func CanonicalConfig(obj runtime.Object) ([]byte, error) {
+	contents, err := encodeConfig(newConfigSerializer(), obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return canonicalConfig(obj, contents)
+}
+
+// ProductionConfig encodes the rendered config as machined writes it to disk, in the canonical form with the generated header.
+func ProductionConfig(obj runtime.Object) ([]byte, error) {
+	contents, err := CanonicalConfig(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return withGeneratedHeader(obj, contents), nil
+}
+
+// SecretResolver is exported for testing.
+type SecretResolver = secretResolver
+
+// EncodeConfig encodes the rendered config as it's written to disk.
+func EncodeConfig(obj runtime.Object) ([]byte, error) {
+	return encodeConfig(newConfigSerializer(), obj)
+}
+
+// ValidateEncryptionConfig is exported for testing.
+var ValidateEncryptionConfig = validateEncryptionConfig
+
+// ApplyNodeConfigOverrides applies the node-specific overrides to the configs.
+func ApplyNodeConfigOverrides(configs map[string]func() (runtime.Object, error), override *k8s.NodeConfigOverride) (map[string]func() (runtime.Object, error), error) {
+	pod := staticPodConfigs{name: "kube-apiserver"}
+
+	for filename, f := range configs {
+		pod.configs = append(pod.configs, configFile{filename: filename, f: f})
+	}
+
+	if err := applyNodeConfigOverrides([]staticPodConfigs{pod}, override); err != nil {
+		return nil, err
+	}
+
+	return xslices.ToMap(pod.configs, func(config configFile) (string, func() (runtime.Object, error)) { return config.filename, config.f }), nil
+}
+
+// VerifyRoundTrip encodes the config as it is written to disk, and verifies it decodes back to the same config.
+func VerifyRoundTrip(obj runtime.Object) error {
+	contents, err := encodeConfig(newConfigSerializer(), obj)
+	if err != nil {
+		return err
+	}
+
+	return verifyRoundTrip(obj, contents)
+}
+
+// ConfigAPIVersion is exported for testing.
+var ConfigAPIVersion = configAPIVersion
@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+// NodeConfigOverrideController manages k8s.NodeConfigOverride based on configuration.
+type NodeConfigOverrideController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *NodeConfigOverrideController) Name() string {
+	return "k8s.NodeConfigOverrideController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NodeConfigOverrideController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodenameType,
+			ID:        optional.Some(k8s.NodenameID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NodeConfigOverrideController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: k8s.NodeConfigOverrideType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NodeConfigOverrideController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		nodename, err := safe.ReaderGetByID[*k8s.Nodename](ctx, r, k8s.NodenameID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting nodename: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		// the overrides in the machine config apply to this node, so they are keyed by its nodename
+		if cfg != nil && nodename != nil && cfg.Config().Cluster() != nil && cfg.Config().Machine() != nil && cfg.Config().Machine().Type().IsControlPlane() {
+			if overrides := cfg.Config().Cluster().StaticPodConfigs().Overrides(); len(overrides) > 0 {
+				if err = safe.WriterModify(ctx, r, k8s.NewNodeConfigOverride(nodename.TypedSpec().Nodename), func(res *k8s.NodeConfigOverride) error {
+					res.TypedSpec().Overrides = xslices.Map(overrides, func(override talosconfig.StaticPodConfigOverride) k8s.NodeConfigFileOverrideSpec {
+						return k8s.NodeConfigFileOverrideSpec{
+							Filename: override.Filename(),
+							Config:   override.Config(),
+						}
+					})
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating node config override: %w", err)
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*k8s.NodeConfigOverride](ctx, r); err != nil {
+			return err
+		}
+	}
+}
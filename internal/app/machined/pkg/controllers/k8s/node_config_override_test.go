@@ -0,0 +1,108 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+type NodeConfigOverrideSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestNodeConfigOverrideSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &NodeConfigOverrideSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 5 * time.Second,
+			AfterSetup: func(s *ctest.DefaultSuite) {
+				s.Require().NoError(s.Runtime().RegisterController(&k8sctrl.NodeConfigOverrideController{}))
+			},
+		},
+	})
+}
+
+func (suite *NodeConfigOverrideSuite) TestReconcile() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+					StaticPodConfigsConfig: &v1alpha1.StaticPodConfigsConfig{
+						ConfigsOverrides: []v1alpha1.StaticPodConfigOverride{
+							{
+								OverrideFilename: "scheduler-config.yaml",
+								OverrideConfig: v1alpha1.Unstructured{
+									Object: map[string]any{
+										"parallelism": 32,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.Create(cfg)
+
+	// the overrides are keyed by the nodename, so nothing is produced without it
+	rtestutils.AssertNoResource[*k8s.NodeConfigOverride](suite.Ctx(), suite.T(), suite.State(), "cp-1")
+
+	nodename := k8s.NewNodename(k8s.NamespaceName, k8s.NodenameID)
+	nodename.TypedSpec().Nodename = "cp-1"
+	suite.Create(nodename)
+
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), "cp-1",
+		func(res *k8s.NodeConfigOverride, asrt *assert.Assertions) {
+			asrt.Equal([]k8s.NodeConfigFileOverrideSpec{
+				{
+					Filename: "scheduler-config.yaml",
+					Config: map[string]any{
+						"parallelism": 32,
+					},
+				},
+			}, res.TypedSpec().Overrides)
+		})
+
+	// the override follows the nodename changes
+	nodename.TypedSpec().Nodename = "cp-2"
+	suite.Update(nodename)
+
+	rtestutils.AssertNoResource[*k8s.NodeConfigOverride](suite.Ctx(), suite.T(), suite.State(), "cp-1")
+	rtestutils.AssertResource(suite.Ctx(), suite.T(), suite.State(), "cp-2",
+		func(*k8s.NodeConfigOverride, *assert.Assertions) {})
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.StaticPodConfigsConfig = nil
+	suite.Update(cfg)
+
+	rtestutils.AssertNoResource[*k8s.NodeConfigOverride](suite.Ctx(), suite.T(), suite.State(), "cp-2")
+}
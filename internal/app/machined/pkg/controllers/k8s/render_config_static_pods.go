@@ -6,30 +6,94 @@ package k8s
 
 import (
 	"bytes"
+	"cmp"
 	"context"
-	"encoding/json"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"maps"
+	"math"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/blang/semver/v4"
 	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"golang.org/x/sys/unix"
 	"k8s.io/apimachinery/pkg/runtime"
-	k8sjson "k8s.io/apimachinery/pkg/runtime/serializer/json"
 	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
-	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
-	schedulerv1 "k8s.io/kube-scheduler/config/v1"
 
+	talosruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/pkg/selinux"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 )
 
 // RenderConfigsStaticPodController manages k8s.ConfigsReady and renders configs for the control plane.
-type RenderConfigsStaticPodController struct{}
+type RenderConfigsStaticPodController struct {
+	// APIServerConfigDir is the directory to render kube-apiserver configs to.
+	APIServerConfigDir string
+	// SchedulerConfigDir is the directory to render kube-scheduler configs to.
+	SchedulerConfigDir string
+
+	// APIServerSecretConfigDir is the directory to render kube-apiserver configs with secrets to, APIServerConfigDir if not set.
+	APIServerSecretConfigDir string
+	// SchedulerSecretConfigDir is the directory to render kube-scheduler configs with secrets to, SchedulerConfigDir if not set.
+	SchedulerSecretConfigDir string
+
+	// APIServerConfigDirMode is the mode of the kube-apiserver config directory, 0o755 if not set.
+	APIServerConfigDirMode os.FileMode
+	// SchedulerConfigDirMode is the mode of the kube-scheduler config directory, 0o755 if not set.
+	SchedulerConfigDirMode os.FileMode
+
+	// GeneratedHeader enables prepending a comment to each rendered config marking it as managed by Talos.
+	GeneratedHeader bool
+	// CanonicalOutput enables writing the configs with sorted keys and normalized numbers.
+	CanonicalOutput bool
+	// VersionXattrs enables tagging each written config with the input version and render time extended attributes.
+	VersionXattrs bool
+	// BackupPreviousConfigs enables keeping the previous version of each changed config as <filename>.bak.
+	BackupPreviousConfigs bool
+	// CompressBackupsAbove is the config size in bytes above which the backups are gzip-compressed, never if not set.
+	CompressBackupsAbove int
+	// StagingDir is the directory on the same filesystem to stage the configs in, the config directory if not set.
+	StagingDir string
+	// GenerationsDir is the directory to retain the previous generations of the configs in for rollback, none if not set.
+	GenerationsDir string
+	// RetainGenerations is the number of the latest generations kept in GenerationsDir, 5 if not set.
+	RetainGenerations int
+
+	// StrictAdmissionPlugins makes admission plugins unknown to the kube-apiserver version an error instead of a warning.
+	StrictAdmissionPlugins bool
+	// StrictStructuredAuth makes structured auth configs the kube-apiserver version can't honor an error instead of a warning.
+	StrictStructuredAuth bool
+
+	// CorrectDrift enables watching the rendered configs to revert the manual edits right away.
+	CorrectDrift bool
+
+	// MinRenderInterval is the minimum interval between the config writes, not rate limited if not set.
+	MinRenderInterval time.Duration
+
+	// UnhealthyRenderFailures is the number of consecutive render failures marking the ConfigStatus unhealthy, 1 if not set.
+	UnhealthyRenderFailures int
+
+	// V1Alpha1Events receives a StaticPodConfigRenderEvent on each render, if set.
+	V1Alpha1Events talosruntime.Publisher
+}
 
 // Name implements controller.Controller interface.
 func (ctrl *RenderConfigsStaticPodController) Name() string {
@@ -38,28 +102,33 @@ func (ctrl *RenderConfigsStaticPodController) Name() string {
 
 // Inputs implements controller.Controller interface.
 func (ctrl *RenderConfigsStaticPodController) Inputs() []controller.Input {
-	return []controller.Input{
-		{
+	inputs := xslices.Map(k8s.StaticPodConfigInputTypes(), func(resourceType resource.Type) controller.Input {
+		return controller.Input{
 			Namespace: k8s.ControlPlaneNamespaceName,
-			Type:      k8s.AdmissionControlConfigType,
+			Type:      resourceType,
 			Kind:      controller.InputWeak,
-		},
-		{
-			Namespace: k8s.ControlPlaneNamespaceName,
-			Type:      k8s.AuditPolicyConfigType,
+		}
+	})
+
+	return append(inputs,
+		controller.Input{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.ConfigSecretType,
 			Kind:      controller.InputWeak,
 		},
-		{
-			Namespace: k8s.ControlPlaneNamespaceName,
-			Type:      k8s.AuthorizationConfigType,
+		controller.Input{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodenameType,
+			ID:        optional.Some(k8s.NodenameID),
 			Kind:      controller.InputWeak,
 		},
-		{
+		controller.Input{
 			Namespace: k8s.ControlPlaneNamespaceName,
-			Type:      k8s.SchedulerConfigType,
+			Type:      k8s.APIServerConfigType,
+			ID:        optional.Some(k8s.APIServerConfigID),
 			Kind:      controller.InputWeak,
 		},
-	}
+	)
 }
 
 // Outputs implements controller.Controller interface.
@@ -69,254 +138,1468 @@ func (ctrl *RenderConfigsStaticPodController) Outputs() []controller.Output {
 			Type: k8s.ConfigStatusType,
 			Kind: controller.OutputExclusive,
 		},
+		{
+			Type: k8s.RequiredFeatureGatesType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: k8s.EffectiveAdmissionPluginsType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: k8s.AppliedConfigFileType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: k8s.ConfigValidationWarningsType,
+			Kind: controller.OutputExclusive,
+		},
 	}
 }
 
 // Run implements controller.Controller interface.
 //
 //nolint:gocyclo,cyclop
-func (ctrl *RenderConfigsStaticPodController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+func (ctrl *RenderConfigsStaticPodController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) (err error) {
+	// any error aborts the render, and the controller is restarted
+	defer func() {
+		if err != nil {
+			ctrl.reportRenderFailure(ctx, r, logger, err)
+		}
+	}()
+
+	// rendered configs are watched to correct any manual edits, as the resources are the source of truth
+	var watcher *configWatcher
+
+	if ctrl.CorrectDrift {
+		watcher = newConfigWatcher(logger)
+
+		defer watcher.Close()
+	}
+
+	managedFiles := map[string]struct{}{}
+
+	// lastVersion is the version of inputs rendered last time
+	var lastVersion string
+
+	// lastInputVersions are the versions of the inputs rendered last time, by type and ID, to trace why the version changed
+	lastInputVersions := map[string]string{}
+
+	// each reconcile decision is traced at the debug level, e.g. which inputs are missing, why the version changed,
+	// and which configs are written, skipped or removed; only the resource metadata and the config paths are logged
+	trace := logger.With(zap.Bool("trace", true))
+
+	// lastWriteTime is the time the configs were written last time, deferredWrite fires once the deferred writes are due
+	var (
+		lastWriteTime time.Time
+		deferredWrite <-chan time.Time
+	)
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		case <-r.EventCh():
-		}
-
-		admissionRes, err := safe.ReaderGetByID[*k8s.AdmissionControlConfig](ctx, r, k8s.AdmissionControlConfigID)
-		if err != nil {
-			if state.IsNotFoundError(err) {
+			trace.Debug("reconcile triggered by inputs")
+		case event := <-watcher.Events():
+			if _, managed := managedFiles[event.Name]; !managed {
 				continue
 			}
 
-			return fmt.Errorf("error getting admission config resource: %w", err)
+			trace.Debug("reconcile triggered by config change", zap.String("path", event.Name), zap.Stringer("op", event.Op))
+		case err := <-watcher.Errors():
+			watcher.Fail(err)
+
+			continue
+		case <-deferredWrite:
+			deferredWrite = nil
+
+			trace.Debug("reconcile triggered by deferred write")
 		}
 
-		admissionConfig := admissionRes.TypedSpec()
+		r.StartTrackingOutputs()
 
-		auditRes, err := safe.ReaderGetByID[*k8s.AuditPolicyConfig](ctx, r, k8s.AuditPolicyConfigID)
+		inputs, err := readConfigInputs(ctx, r, trace)
 		if err != nil {
-			if state.IsNotFoundError(err) {
-				continue
-			}
+			return err
+		}
 
-			return fmt.Errorf("error getting audit config resource: %w", err)
+		if inputs == nil {
+			trace.Debug("render skipped, required inputs are missing")
+
+			continue
 		}
 
-		auditConfig := auditRes.TypedSpec()
+		traceConfigInputs(trace, inputs, lastInputVersions)
 
-		authorizerConfigRes, err := safe.ReaderGetByID[*k8s.AuthorizationConfig](ctx, r, k8s.AuthorizationConfigID)
-		if err != nil {
-			if state.IsNotFoundError(err) {
-				continue
-			}
+		// in the validate-only mode the configs are rendered and validated, but nothing is written to disk
+		validateOnly := inputs.validateOnly()
 
-			return fmt.Errorf("error getting authorization config resource: %w", err)
+		if inputs.authenticationSpec != nil {
+			warnAuthConfigMismatches(logger, inputs.authenticationSpec, inputs.authorization.TypedSpec())
 		}
 
-		authorizerConfig := authorizerConfigRes.TypedSpec()
+		serializer := newConfigSerializer()
 
-		kubeAPIServerVersion := compatibility.VersionFromImageRef(authorizerConfig.Image)
+		// extended attributes of the configs written by this render
+		var xattrs map[string]string
 
-		kubeSchedulerRes, err := safe.ReaderGetByID[*k8s.SchedulerConfig](ctx, r, k8s.SchedulerConfigID)
-		if err != nil {
-			if state.IsNotFoundError(err) {
-				continue
+		if ctrl.VersionXattrs {
+			xattrs = map[string]string{
+				ConfigVersionXattr:    ComputeConfigVersion(inputs.resources()...),
+				ConfigRenderTimeXattr: time.Now().UTC().Format(time.RFC3339Nano),
 			}
-
-			return fmt.Errorf("error getting scheduler config resource: %w", err)
 		}
 
-		kubeSchedulerConfig := kubeSchedulerRes.TypedSpec()
+		var updates []configUpdate
+
+		// changedFiles is the number of configs written or removed
+		var changedFiles int
 
-		type configFile struct {
-			filename string
-			f        func() (runtime.Object, error)
+		// feature gates required by the rendered configs, per control plane component
+		featureGates := map[string][]string{}
+
+		var effectivePlugins []k8s.EffectiveAdmissionPluginSpec
+
+		// configs as they are applied to disk, by filename
+		appliedConfigs := map[string]k8s.AppliedConfigFileSpec{}
+
+		// warnings logged while rendering are collected per file, in addition to being logged
+		warnings := newWarningCollector()
+		validationWarnings := map[string][]ValidationWarning{}
+
+		pods := ctrl.staticPodConfigs(inputs, logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return zapcore.NewTee(core, warnings)
+		})))
+
+		if err = applyNodeConfigOverrides(pods, inputs.nodeOverride); err != nil {
+			return err
 		}
 
-		serializer := k8sjson.NewSerializerWithOptions(
-			k8sjson.DefaultMetaFactory, nil, nil,
-			k8sjson.SerializerOptions{
-				Yaml:   true,
-				Pretty: true,
-				Strict: true,
-			},
-		)
+		naming := inputs.namingPolicy()
+
+		if err = validateConfigNaming(pods, naming, logger); err != nil {
+			return err
+		}
 
-		for _, pod := range []struct {
-			name         string
-			directory    string
-			selinuxLabel string
-			uid          int
-			gid          int
-			configs      []configFile
-		}{
-			{
-				name:         "kube-apiserver",
-				directory:    constants.KubernetesAPIServerConfigDir,
-				selinuxLabel: constants.KubernetesAPIServerConfigDirSELinuxLabel,
-				uid:          constants.KubernetesAPIServerRunUser,
-				gid:          constants.KubernetesAPIServerRunGroup,
-				configs: []configFile{
-					{
-						filename: "admission-control-config.yaml",
-						f:        admissionControlConfig(admissionConfig),
-					},
-					{
-						filename: "auditpolicy.yaml",
-						f:        auditPolicyConfig(auditConfig),
-					},
-					{
-						filename: "authorization-config.yaml",
-						f:        authorizationConfig(authorizerConfig, kubeAPIServerVersion),
-					},
-				},
-			},
-			{
-				name:         "kube-scheduler",
-				directory:    constants.KubernetesSchedulerConfigDir,
-				selinuxLabel: constants.KubernetesSchedulerConfigDirSELinuxLabel,
-				uid:          constants.KubernetesSchedulerRunUser,
-				gid:          constants.KubernetesSchedulerRunGroup,
-				configs: []configFile{
-					{
-						filename: "scheduler-config.yaml",
-						f:        schedulerConfig(kubeSchedulerConfig),
-					},
-				},
-			},
-		} {
-			if err = os.MkdirAll(pod.directory, 0o755); err != nil {
-				return fmt.Errorf("error creating config directory for %q: %w", pod.name, err)
+		if inputs.serviceAccountSigner != nil && inputs.apiServer != nil {
+			if err = validateServiceAccountSigner(inputs.serviceAccountSigner.TypedSpec(),
+				compatibility.VersionFromImageRef(inputs.apiServer.TypedSpec().Image)); err != nil {
+				return err
 			}
 
-			if err = selinux.SetLabel(pod.directory, pod.selinuxLabel); err != nil {
+			featureGates[k8s.APIServerID] = append(featureGates[k8s.APIServerID], serviceAccountSignerFeatureGate)
+		}
+
+		if inputs.apiServerShutdown != nil && inputs.apiServer != nil {
+			if err = validateAPIServerShutdown(inputs.apiServerShutdown.TypedSpec()); err != nil {
 				return err
 			}
+		}
+
+		// paths of the configs as they were applied last time, by filename, to remove the configs renamed since then
+		previousPaths := map[string]string{}
+
+		// configs as they were applied last time, by filename, kept if the writes are deferred
+		previousConfigs := map[string]k8s.AppliedConfigFileSpec{}
+
+		if !validateOnly {
+			appliedList, listErr := safe.ReaderListAll[*k8s.AppliedConfigFile](ctx, r)
+			if listErr != nil {
+				return fmt.Errorf("error listing applied configs: %w", listErr)
+			}
+
+			for previous := range appliedList.All() {
+				previousPaths[previous.Metadata().ID()] = previous.TypedSpec().Path
+				previousConfigs[previous.Metadata().ID()] = *previous.TypedSpec()
+			}
+		}
+
+		// currentPaths are the paths of all configs under the current names
+		currentPaths := map[string]struct{}{}
+
+		for _, pod := range pods {
+			if !validateOnly {
+				if err = ensureConfigDir(pod); err != nil {
+					return fmt.Errorf("error creating config directory for %q: %w", pod.name, err)
+				}
+
+				if err = ctrl.ensureStagingDir(pod); err != nil {
+					return fmt.Errorf("error creating staging directory for %q: %w", pod.name, err)
+				}
+
+				for _, dir := range pod.directories() {
+					if err = checkWritableMount(dir, unix.Statfs); err != nil {
+						return fmt.Errorf("error checking config directory for %q: %w", pod.name, err)
+					}
+
+					if ctrl.StagingDir != "" {
+						if err = checkSameFilesystem(ctrl.stagingDir(pod, dir), dir, unix.Stat); err != nil {
+							return fmt.Errorf("error checking staging directory for %q: %w", pod.name, err)
+						}
+					}
+
+					if err = selinux.SetLabel(dir, pod.selinuxLabel); err != nil {
+						return err
+					}
+
+					watcher.Watch(dir)
+				}
+			}
 
 			for _, configFile := range pod.configs {
+				diskFilename := naming.Filename(configFile.filename)
+				configDir := pod.configDir(configFile.secret)
+
+				update := configUpdate{
+					filename:     configFile.filename,
+					pod:          pod.name,
+					path:         filepath.Join(configDir, diskFilename),
+					stagingPath:  stagingConfigPath(ctrl.stagingDir(pod, configDir), diskFilename),
+					uid:          pod.uid,
+					gid:          pod.gid,
+					selinuxLabel: pod.fileSELinuxLabel,
+				}
+
+				managedFiles[update.path] = struct{}{}
+				currentPaths[update.path] = struct{}{}
+
+				traceFile := trace.With(zap.String("filename", configFile.filename), zap.String("pod", pod.name), zap.String("path", update.path))
+
+				if configFile.f == nil {
+					if !validateOnly && unmanagedConfig(update.path) {
+						traceFile.Debug("config is not enabled, but it's unmanaged, keeping")
+
+						continue
+					}
+
+					if _, statErr := os.Lstat(update.path); statErr == nil && !validateOnly {
+						changedFiles++
+
+						traceFile.Debug("config is not enabled, removing")
+					} else {
+						traceFile.Debug("config is not enabled")
+					}
+
+					updates = append(updates, update)
+
+					continue
+				}
+
+				if featureGate, ok := configFeatureGates[configFile.filename]; ok {
+					featureGates[pod.name] = append(featureGates[pod.name], featureGate)
+				}
+
 				var obj runtime.Object
 
-				obj, err = configFile.f()
+				// anything logged before is not about this config
+				warnings.take()
+
+				obj, err = configFile.render()
 				if err != nil {
 					return fmt.Errorf("error generating configuration %q for %q: %w", configFile.filename, pod.name, err)
 				}
 
-				var buf bytes.Buffer
+				if fileWarnings := warnings.take(); len(fileWarnings) > 0 {
+					validationWarnings[configFile.filename] = fileWarnings
+				}
+
+				if admissionCfg, ok := obj.(*apiserverv1.AdmissionConfiguration); ok {
+					effectivePlugins = effectiveAdmissionPlugins(admissionCfg)
+				}
+
+				var contents []byte
 
-				if err = serializer.Encode(obj, &buf); err != nil {
+				contents, err = encodeConfig(serializer, obj)
+				if err != nil {
 					return fmt.Errorf("error marshaling configuration %q for %q: %w", configFile.filename, pod.name, err)
 				}
 
-				if err = os.WriteFile(filepath.Join(pod.directory, configFile.filename), buf.Bytes(), 0o400); err != nil {
-					return fmt.Errorf("error writing configuration %q for %q: %w", configFile.filename, pod.name, err)
+				if ctrl.CanonicalOutput {
+					contents, err = canonicalConfig(obj, contents)
+					if err != nil {
+						return fmt.Errorf("error canonicalizing configuration %q for %q: %w", configFile.filename, pod.name, err)
+					}
+				}
+
+				if err = verifyRoundTrip(obj, contents); err != nil {
+					return fmt.Errorf("error verifying configuration %q for %q: %w", configFile.filename, pod.name, err)
+				}
+
+				if validateOnly {
+					traceFile.Debug("config validated, not written in validate-only mode")
+
+					continue
+				}
+
+				// unmanaged configs are still rendered, as the feature gates and admission plugins depend on them
+				if unmanagedConfig(update.path) {
+					traceFile.Debug("config is unmanaged, skipping")
+
+					continue
+				}
+
+				var existing []byte
+
+				existing, err = os.ReadFile(update.path)
+				if err != nil && !errors.Is(err, os.ErrNotExist) {
+					return fmt.Errorf("error reading configuration %q for %q: %w", configFile.filename, pod.name, err)
+				}
+
+				if err == nil && bytes.Equal(stripGeneratedHeader(existing), contents) {
+					appliedConfigs[configFile.filename] = update.applied(existing)
+
+					traceFile.Debug("config is up to date, skipping")
+
+					continue
+				}
+
+				switch {
+				case err != nil:
+					traceFile.Debug("config is missing, writing")
+				case ComputeConfigVersion(inputs.resources()...) == lastVersion:
+					logger.Warn("corrected config drift", zap.String("filename", update.path))
+				default:
+					traceFile.Debug("config changed, writing")
+				}
+
+				update.contents = contents
+				update.xattrs = xattrs
+
+				if ctrl.GeneratedHeader {
+					update.contents = withGeneratedHeader(obj, update.contents)
+				}
+
+				updates = append(updates, update)
+				appliedConfigs[configFile.filename] = update.applied(update.contents)
+				changedFiles++
+			}
+		}
+
+		for _, pod := range pods {
+			for _, configFile := range pod.configs {
+				previousPath, ok := previousPaths[configFile.filename]
+				if !ok {
+					continue
+				}
+
+				if _, current := currentPaths[previousPath]; current || unmanagedConfig(previousPath) {
+					continue
+				}
+
+				trace.Debug("config is renamed, removing the previous one",
+					zap.String("filename", configFile.filename), zap.String("pod", pod.name), zap.String("path", previousPath))
+
+				updates = append(updates, configUpdate{
+					filename: configFile.filename,
+					pod:      pod.name,
+					path:     previousPath,
+				})
+				changedFiles++
+			}
+		}
+
+		// configs which are not rendered anymore, e.g. the TLS material of the removed scheduler extenders, are removed as well
+		for _, filename := range slices.Sorted(maps.Keys(previousPaths)) {
+			previousPath := previousPaths[filename]
+
+			if _, current := currentPaths[previousPath]; current || slices.ContainsFunc(pods, func(pod staticPodConfigs) bool {
+				return slices.ContainsFunc(pod.configs, func(configFile configFile) bool { return configFile.filename == filename })
+			}) {
+				continue
+			}
+
+			// configs of the pods not running on this node are kept, as with the configs which are still rendered
+			idx := slices.IndexFunc(pods, func(pod staticPodConfigs) bool { return slices.Contains(pod.directories(), filepath.Dir(previousPath)) })
+			if idx == -1 || unmanagedConfig(previousPath) {
+				continue
+			}
+
+			trace.Debug("config is not rendered anymore, removing",
+				zap.String("filename", filename), zap.String("pod", pods[idx].name), zap.String("path", previousPath))
+
+			updates = append(updates, configUpdate{
+				filename: filename,
+				pod:      pods[idx].name,
+				path:     previousPath,
+			})
+			changedFiles++
+		}
+
+		if !validateOnly && changedFiles > 0 && ctrl.MinRenderInterval > 0 && !lastWriteTime.IsZero() {
+			if writeDue := lastWriteTime.Add(ctrl.MinRenderInterval); time.Now().Before(writeDue) {
+				deferredWrite = time.After(time.Until(writeDue))
+
+				if err = ctrl.deferWrites(ctx, r, previousConfigs, ComputeConfigVersion(inputs.resources()...)); err != nil {
+					return err
+				}
+
+				trace.Debug("config writes deferred", zap.Time("due", writeDue), zap.Int("changed_files", changedFiles))
+
+				r.ResetRestartBackoff()
+
+				continue
+			}
+		}
+
+		// generation of the applied configs, only set if the generations are retained
+		var generation uint64
+
+		if !validateOnly {
+			if err = ctrl.writeConfigs(ctx, pods, updates, appliedConfigs, inputs.resources()); err != nil {
+				return err
+			}
+
+			if changedFiles > 0 {
+				lastWriteTime = time.Now()
+			}
+
+			if ctrl.GenerationsDir != "" {
+				configStatus, getErr := safe.ReaderGetByID[*k8s.ConfigStatus](ctx, r, k8s.ConfigStatusStaticPodID)
+				if getErr != nil && !state.IsNotFoundError(getErr) {
+					return fmt.Errorf("error getting config status: %w", getErr)
+				}
+
+				if configStatus != nil {
+					generation = configStatus.TypedSpec().Generation
 				}
 
-				if err = os.Chown(filepath.Join(pod.directory, configFile.filename), pod.uid, pod.gid); err != nil {
-					return fmt.Errorf("error chowning %q for %q: %w", configFile.filename, pod.name, err)
+				generation, err = ctrl.retainGeneration(pods, appliedConfigs, generation, changedFiles > 0)
+				if err != nil {
+					return err
 				}
 			}
 		}
 
+		for filename, spec := range appliedConfigs {
+			if err = safe.WriterModify(ctx, r, k8s.NewAppliedConfigFile(filename), func(res *k8s.AppliedConfigFile) error {
+				*res.TypedSpec() = spec
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating applied config %q: %w", filename, err)
+			}
+		}
+
+		for filename, fileWarnings := range validationWarnings {
+			if err = safe.WriterModify(ctx, r, k8s.NewConfigValidationWarnings(filename), func(res *k8s.ConfigValidationWarnings) error {
+				res.TypedSpec().Warnings = xslices.Map(fileWarnings, func(warning ValidationWarning) k8s.ConfigValidationWarningSpec {
+					return k8s.ConfigValidationWarningSpec{
+						Field:   warning.Field,
+						Message: warning.Message,
+					}
+				})
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating config validation warnings %q: %w", filename, err)
+			}
+		}
+
+		// output tracking ends with the cleanup, so both per-file outputs are cleaned up at once
+		if err = r.CleanupOutputs(ctx,
+			resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.AppliedConfigFileType, "", resource.VersionUndefined),
+			resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.ConfigValidationWarningsType, "", resource.VersionUndefined),
+		); err != nil {
+			return err
+		}
+
+		for _, id := range []string{k8s.APIServerID, k8s.SchedulerID} {
+			if err = safe.WriterModify(ctx, r, k8s.NewRequiredFeatureGates(id), func(res *k8s.RequiredFeatureGates) error {
+				res.TypedSpec().FeatureGates = featureGates[id]
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating required feature gates for %q: %w", id, err)
+			}
+		}
+
+		if err = safe.WriterModify(ctx, r, k8s.NewEffectiveAdmissionPlugins(), func(res *k8s.EffectiveAdmissionPlugins) error {
+			res.TypedSpec().Plugins = effectivePlugins
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating effective admission plugins: %w", err)
+		}
+
 		if err = safe.WriterModify(ctx, r, k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID), func(r *k8s.ConfigStatus) error {
-			r.TypedSpec().Ready = true
-			r.TypedSpec().Version = admissionRes.Metadata().Version().String() +
-				auditRes.Metadata().Version().String() +
-				authorizerConfigRes.Metadata().Version().String() +
-				kubeSchedulerRes.Metadata().Version().String()
+			// nothing is written in the validate-only mode, so the configs on disk are the ones written before
+			if !validateOnly {
+				r.TypedSpec().PodVersions = podConfigVersions(pods, appliedConfigs)
+			}
+
+			r.TypedSpec().Ready = len(r.TypedSpec().PodVersions) > 0
+			r.TypedSpec().Version = ComputeConfigVersion(inputs.resources()...)
+
+			r.TypedSpec().ReconcileCount++
+			r.TypedSpec().LastReconcileTime = time.Now()
+			r.TypedSpec().Healthy = true
+			r.TypedSpec().ConsecutiveRenderFailures = 0
+			r.TypedSpec().LastRenderError = ""
+			r.TypedSpec().LastRenderTime = r.TypedSpec().LastReconcileTime
+			r.TypedSpec().Generation = generation
+			r.TypedSpec().PendingVersion = ""
 
 			return nil
 		}); err != nil {
 			return err
 		}
 
+		lastVersion = ComputeConfigVersion(inputs.resources()...)
+
+		trace.Debug("render finished", zap.String("version", lastVersion), zap.Int("changed_files", changedFiles))
+
+		ctrl.publishRenderEvent(ctx, &machine.StaticPodConfigRenderEvent{
+			Success:      true,
+			ChangedFiles: int32(changedFiles),
+			Version:      lastVersion,
+		})
+
 		r.ResetRestartBackoff()
 	}
 }
 
-func admissionControlConfig(spec *k8s.AdmissionControlConfigSpec) func() (runtime.Object, error) {
-	return func() (runtime.Object, error) {
-		var cfg apiserverv1.AdmissionConfiguration
-
-		cfg.APIVersion = apiserverv1.SchemeGroupVersion.String()
-		cfg.Kind = "AdmissionConfiguration"
-		cfg.Plugins = []apiserverv1.AdmissionPluginConfiguration{}
-
-		for _, plugin := range spec.Config {
-			raw, err := json.Marshal(plugin.Configuration)
-			if err != nil {
-				return nil, fmt.Errorf("error marshaling configuration for plugin %q: %w", plugin.Name, err)
-			}
+// deferWrites records the version of the configs which are not written yet, as the writes are rate limited.
+func (ctrl *RenderConfigsStaticPodController) deferWrites(
+	ctx context.Context, r controller.Runtime, previousConfigs map[string]k8s.AppliedConfigFileSpec, pendingVersion string,
+) error {
+	for filename, spec := range previousConfigs {
+		if err := safe.WriterModify(ctx, r, k8s.NewAppliedConfigFile(filename), func(res *k8s.AppliedConfigFile) error {
+			*res.TypedSpec() = spec
 
-			cfg.Plugins = append(cfg.Plugins,
-				apiserverv1.AdmissionPluginConfiguration{
-					Name: plugin.Name,
-					Configuration: &runtime.Unknown{
-						Raw: raw,
-					},
-				},
-			)
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating applied config %q: %w", filename, err)
 		}
+	}
 
-		return &cfg, nil
+	// only the applied configs are cleaned up, so that the validation warnings of the configs on disk are kept
+	if err := r.CleanupOutputs(ctx, resource.NewMetadata(k8s.ControlPlaneNamespaceName, k8s.AppliedConfigFileType, "", resource.VersionUndefined)); err != nil {
+		return err
 	}
+
+	return safe.WriterModify(ctx, r, k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID), func(r *k8s.ConfigStatus) error {
+		r.TypedSpec().PendingVersion = pendingVersion
+		r.TypedSpec().ReconcileCount++
+		r.TypedSpec().LastReconcileTime = time.Now()
+		r.TypedSpec().Healthy = true
+		r.TypedSpec().ConsecutiveRenderFailures = 0
+		r.TypedSpec().LastRenderError = ""
+
+		return nil
+	})
 }
 
-func auditPolicyConfig(spec *k8s.AuditPolicyConfigSpec) func() (runtime.Object, error) {
-	return func() (runtime.Object, error) {
-		var cfg auditv1.Policy
+// writeConfigs applies the config updates and writes the config indexes, holding the locks of the pod config directories.
+func (ctrl *RenderConfigsStaticPodController) writeConfigs(
+	ctx context.Context, pods []staticPodConfigs, updates []configUpdate, appliedConfigs map[string]k8s.AppliedConfigFileSpec, resources []resource.Resource,
+) error {
+	unlock, err := lockConfigDirs(ctx, pods)
+	if err != nil {
+		return err
+	}
 
-		if err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(spec.Config, &cfg, true); err != nil {
-			return nil, fmt.Errorf("error unmarshaling audit policy configuration: %w", err)
-		}
+	defer unlock()
+
+	if err = applyConfigUpdates(updates, ctrl.backupOptions()); err != nil {
+		return err
+	}
 
-		return &cfg, nil
+	for _, pod := range pods {
+		for _, dir := range pod.directories() {
+			if err = writeConfigIndex(pod, dir, appliedConfigs, resources); err != nil {
+				return fmt.Errorf("error writing config index of %q for %q: %w", dir, pod.name, err)
+			}
+		}
 	}
+
+	return nil
 }
 
-func schedulerConfig(spec *k8s.SchedulerConfigSpec) func() (runtime.Object, error) {
-	return func() (runtime.Object, error) {
-		var cfg schedulerv1.KubeSchedulerConfiguration
+// reportRenderFailure records the render failure in the ConfigStatus and publishes the failed render event.
+func (ctrl *RenderConfigsStaticPodController) reportRenderFailure(ctx context.Context, r controller.Runtime, logger *zap.Logger, renderErr error) {
+	threshold := uint64(max(ctrl.UnhealthyRenderFailures, 1))
+
+	_, err := safe.ReaderGetByID[*k8s.ConfigStatus](ctx, r, k8s.ConfigStatusStaticPodID)
 
-		if err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(spec.Config, &cfg, false); err != nil {
-			return nil, fmt.Errorf("error unmarshaling scheduler configuration: %w", err)
+	switch {
+	case err == nil:
+		if err = safe.WriterModify(ctx, r, k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID), func(r *k8s.ConfigStatus) error {
+			r.TypedSpec().ConsecutiveRenderFailures++
+			r.TypedSpec().Healthy = r.TypedSpec().Healthy && r.TypedSpec().ConsecutiveRenderFailures < threshold
+			r.TypedSpec().LastRenderError = renderErr.Error()
+			r.TypedSpec().LastRenderTime = time.Now()
+
+			return nil
+		}); err != nil {
+			logger.Warn("error updating config status health", zap.Error(err))
 		}
+	case !state.IsNotFoundError(err):
+		logger.Warn("error getting config status", zap.Error(err))
+	}
 
-		cfg.APIVersion = "kubescheduler.config.k8s.io/v1"
-		cfg.Kind = "KubeSchedulerConfiguration"
-		cfg.ClientConnection.Kubeconfig = filepath.Join(constants.KubernetesSchedulerSecretsDir, "kubeconfig")
+	ctrl.publishRenderEvent(ctx, &machine.StaticPodConfigRenderEvent{
+		Error: renderErr.Error(),
+	})
+}
 
-		return &cfg, nil
+func (ctrl *RenderConfigsStaticPodController) publishRenderEvent(ctx context.Context, event *machine.StaticPodConfigRenderEvent) {
+	if ctrl.V1Alpha1Events != nil {
+		ctrl.V1Alpha1Events.Publish(ctx, event)
 	}
 }
 
-func authorizationConfig(spec *k8s.AuthorizationConfigSpec, kubeAPIServerVersion compatibility.Version) func() (runtime.Object, error) {
-	return func() (runtime.Object, error) {
-		var cfg apiserverv1.AuthorizationConfiguration
+// RenderOne renders a single managed config by its filename.
+func (ctrl *RenderConfigsStaticPodController) RenderOne(ctx context.Context, r controller.Reader, filename string) (runtime.Object, []byte, error) {
+	inputs, err := readConfigInputs(ctx, r, zap.NewNop())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if inputs == nil {
+		return nil, nil, errors.New("static pod config inputs are not ready")
+	}
+
+	pods := ctrl.staticPodConfigs(inputs, zap.NewNop())
+
+	if err = applyNodeConfigOverrides(pods, inputs.nodeOverride); err != nil {
+		return nil, nil, err
+	}
+
+	for _, pod := range pods {
+		for _, configFile := range pod.configs {
+			if configFile.filename != filename {
+				continue
+			}
 
-		cfg.APIVersion = kubeAPIServerVersion.KubeAPIServerAuthorizationConfigAPIVersion()
-		cfg.Kind = "AuthorizationConfiguration"
-		cfg.Authorizers = []apiserverv1.AuthorizerConfiguration{}
+			if configFile.f == nil {
+				return nil, nil, fmt.Errorf("configuration %q for %q is not enabled", filename, pod.name)
+			}
 
-		for _, authorizer := range spec.Config {
-			authorizerConfig := apiserverv1.AuthorizerConfiguration{
-				Name: authorizer.Name,
-				Type: authorizer.Type,
+			obj, err := configFile.render()
+			if err != nil {
+				return nil, nil, fmt.Errorf("error generating configuration %q for %q: %w", filename, pod.name, err)
 			}
 
-			if authorizer.Webhook != nil {
-				var webhookCfg apiserverv1.WebhookConfiguration
+			contents, err := encodeConfig(newConfigSerializer(), obj)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error marshaling configuration %q for %q: %w", filename, pod.name, err)
+			}
 
-				if err := runtime.DefaultUnstructuredConverter.FromUnstructured(authorizer.Webhook, &webhookCfg); err != nil {
-					return nil, fmt.Errorf("error unmarshaling authorizer webhook configuration: %w", err)
+			if ctrl.CanonicalOutput {
+				contents, err = canonicalConfig(obj, contents)
+				if err != nil {
+					return nil, nil, fmt.Errorf("error canonicalizing configuration %q for %q: %w", filename, pod.name, err)
 				}
+			}
 
-				authorizerConfig.Webhook = &webhookCfg
+			if ctrl.GeneratedHeader {
+				contents = withGeneratedHeader(obj, contents)
 			}
 
-			cfg.Authorizers = append(cfg.Authorizers, authorizerConfig)
+			return obj, contents, nil
 		}
-
-		return &cfg, nil
 	}
+
+	return nil, nil, fmt.Errorf("unknown configuration %q", filename)
+}
+
+// configInputs are the resources rendered into the static pod configs.
+type configInputs struct {
+	admission     *k8s.AdmissionControlConfig
+	audit         *k8s.AuditPolicyConfig
+	authorization *k8s.AuthorizationConfig
+	scheduler     *k8s.SchedulerConfig
+
+	// structured authentication configs, ordered by ID, and merged into a single config
+	authentication     []*k8s.AuthenticationConfig
+	authenticationSpec *k8s.AuthenticationConfigSpec
+
+	clientCA             *k8s.APIServerClientCAConfig
+	konnectivity         *k8s.KonnectivityServerConfig
+	tracing              *k8s.APIServerTracingConfig
+	serviceAccountIssuer *k8s.ServiceAccountIssuerDiscovery
+	serviceAccountSigner *k8s.ServiceAccountSignerConfig
+	apiServerShutdown    *k8s.APIServerShutdownConfig
+
+	// secrets referenced from the structured auth configs, dangling references are missing
+	secrets secretResolver
+
+	// overrides of the configs specific to this node
+	nodeOverride *k8s.NodeConfigOverride
+
+	// operator-defined rendering mode, e.g. validate-only
+	renderPolicy *k8s.ConfigRenderPolicy
+
+	// operator-defined filenames of the configs
+	naming *k8s.ConfigNamingPolicy
+
+	// kube-apiserver configs are only rendered if kube-apiserver runs on this node
+	apiServer *k8s.APIServerConfig
+
+	// air-gapped mode restricts the hosts the structured auth configs might reach
+	airGapped *k8s.AirGappedConfig
+}
+
+// readConfigInputs reads the config inputs, returning nil if any of the required inputs is missing.
+//
+//nolint:gocyclo
+func readConfigInputs(ctx context.Context, r controller.Reader, trace *zap.Logger) (*configInputs, error) {
+	var (
+		inputs configInputs
+		err    error
+	)
+
+	inputs.admission, err = safe.ReaderGetByID[*k8s.AdmissionControlConfig](ctx, r, k8s.AdmissionControlConfigID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			trace.Debug("required input is missing", zap.String("type", k8s.AdmissionControlConfigType))
+
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting admission config resource: %w", err)
+	}
+
+	inputs.audit, err = safe.ReaderGetByID[*k8s.AuditPolicyConfig](ctx, r, k8s.AuditPolicyConfigID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			trace.Debug("required input is missing", zap.String("type", k8s.AuditPolicyConfigType))
+
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting audit config resource: %w", err)
+	}
+
+	inputs.authorization, err = safe.ReaderGetByID[*k8s.AuthorizationConfig](ctx, r, k8s.AuthorizationConfigID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			trace.Debug("required input is missing", zap.String("type", k8s.AuthorizationConfigType))
+
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting authorization config resource: %w", err)
+	}
+
+	inputs.scheduler, err = safe.ReaderGetByID[*k8s.SchedulerConfig](ctx, r, k8s.SchedulerConfigID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			trace.Debug("required input is missing", zap.String("type", k8s.SchedulerConfigType))
+
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting scheduler config resource: %w", err)
+	}
+
+	// structured authentication config is optional, it might be split into multiple resources, e.g. one per team
+	authenticationConfigs, err := safe.ReaderListAll[*k8s.AuthenticationConfig](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("error listing authentication config resources: %w", err)
+	}
+
+	inputs.authentication = slices.Collect(authenticationConfigs.All())
+
+	if len(inputs.authentication) > 0 {
+		inputs.authenticationSpec, err = mergeAuthenticationConfigs(inputs.authentication)
+		if err != nil {
+			return nil, fmt.Errorf("error merging authentication configs: %w", err)
+		}
+	}
+
+	// konnectivity server config is optional, egress selector config is only rendered if it's present
+	inputs.konnectivity, err = safe.ReaderGetByID[*k8s.KonnectivityServerConfig](ctx, r, k8s.KonnectivityServerConfigID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting konnectivity server config resource: %w", err)
+	}
+
+	// tracing config is optional, it's only rendered if the tracing is configured
+	inputs.tracing, err = safe.ReaderGetByID[*k8s.APIServerTracingConfig](ctx, r, k8s.APIServerTracingConfigID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting kube-apiserver tracing config resource: %w", err)
+	}
+
+	// client CA bundles are optional, e.g. for the aggregated API servers
+	inputs.clientCA, err = safe.ReaderGetByID[*k8s.APIServerClientCAConfig](ctx, r, k8s.APIServerClientCAConfigID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting kube-apiserver client CA config resource: %w", err)
+	}
+
+	inputs.airGapped, err = safe.ReaderGetByID[*k8s.AirGappedConfig](ctx, r, k8s.AirGappedConfigID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting air-gapped config resource: %w", err)
+	}
+
+	inputs.apiServer, err = safe.ReaderGetByID[*k8s.APIServerConfig](ctx, r, k8s.APIServerConfigID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting kube-apiserver config resource: %w", err)
+	}
+
+	// service account issuer discovery documents are only rendered if they were cached
+	inputs.serviceAccountIssuer, err = safe.ReaderGetByID[*k8s.ServiceAccountIssuerDiscovery](ctx, r, k8s.ServiceAccountIssuerDiscoveryID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting service account issuer discovery resource: %w", err)
+	}
+
+	inputs.renderPolicy, err = safe.ReaderGetByID[*k8s.ConfigRenderPolicy](ctx, r, k8s.ConfigRenderPolicyID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting config render policy resource: %w", err)
+	}
+
+	// external service account signer is optional, it's only validated as kube-apiserver connects to it directly
+	inputs.serviceAccountSigner, err = safe.ReaderGetByID[*k8s.ServiceAccountSignerConfig](ctx, r, k8s.ServiceAccountSignerConfigID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting service account signer config resource: %w", err)
+	}
+
+	// graceful shutdown is configured with the kube-apiserver flags, it's only validated against the pod termination grace period
+	inputs.apiServerShutdown, err = safe.ReaderGetByID[*k8s.APIServerShutdownConfig](ctx, r, k8s.APIServerShutdownConfigID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting kube-apiserver shutdown config resource: %w", err)
+	}
+
+	// configs are written under their default filenames unless the naming policy overrides them
+	inputs.naming, err = safe.ReaderGetByID[*k8s.ConfigNamingPolicy](ctx, r, k8s.ConfigNamingPolicyID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting config naming policy resource: %w", err)
+	}
+
+	nodename, err := safe.ReaderGetByID[*k8s.Nodename](ctx, r, k8s.NodenameID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting nodename resource: %w", err)
+	}
+
+	// node-specific overrides are optional, and they are looked up by the nodename
+	if nodename != nil {
+		inputs.nodeOverride, err = safe.ReaderGetByID[*k8s.NodeConfigOverride](ctx, r, nodename.TypedSpec().Nodename)
+		if err != nil && !state.IsNotFoundError(err) {
+			return nil, fmt.Errorf("error getting node config override resource: %w", err)
+		}
+	}
+
+	references := map[resource.ID]struct{}{}
+
+	if inputs.authenticationSpec != nil {
+		collectSecretReferences(inputs.authenticationSpec.Config, references)
+	}
+
+	for _, authorizer := range inputs.authorization.TypedSpec().Config {
+		collectSecretReferences(authorizer.Webhook, references)
+	}
+
+	inputs.secrets = secretResolver{}
+
+	for _, id := range slices.Sorted(maps.Keys(references)) {
+		secret, err := safe.ReaderGetByID[*secrets.ConfigSecret](ctx, r, id)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("error getting config secret %q: %w", id, err)
+		}
+
+		inputs.secrets[id] = secret
+	}
+
+	return &inputs, nil
+}
+
+// traceConfigInputs logs the inputs which are present, which optional ones are missing, and which changed since the last render.
+func traceConfigInputs(trace *zap.Logger, inputs *configInputs, lastInputVersions map[string]string) {
+	present := map[resource.Type]struct{}{}
+	current := map[string]string{}
+
+	for _, res := range inputs.resources() {
+		key := res.Metadata().Type() + "/" + res.Metadata().ID()
+		version := res.Metadata().Version().String()
+
+		present[res.Metadata().Type()] = struct{}{}
+		current[key] = version
+
+		switch previous, ok := lastInputVersions[key]; {
+		case !ok:
+			trace.Debug("input found", zap.String("type", res.Metadata().Type()), zap.String("id", res.Metadata().ID()), zap.String("version", version))
+		case previous != version:
+			trace.Debug("input changed", zap.String("type", res.Metadata().Type()), zap.String("id", res.Metadata().ID()),
+				zap.String("previous_version", previous), zap.String("version", version))
+		default:
+			trace.Debug("input unchanged", zap.String("type", res.Metadata().Type()), zap.String("id", res.Metadata().ID()), zap.String("version", version))
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(lastInputVersions)) {
+		if _, ok := current[key]; !ok {
+			trace.Debug("input removed", zap.String("input", key), zap.String("previous_version", lastInputVersions[key]))
+		}
+	}
+
+	for _, resourceType := range k8s.StaticPodConfigInputTypes() {
+		if _, ok := present[resourceType]; !ok {
+			trace.Debug("optional input is missing", zap.String("type", resourceType))
+		}
+	}
+
+	clear(lastInputVersions)
+	maps.Copy(lastInputVersions, current)
+}
+
+// namingPolicy returns the config naming policy, or nil if the configs are written under their default filenames.
+func (inputs *configInputs) namingPolicy() *k8s.ConfigNamingPolicySpec {
+	if inputs.naming == nil {
+		return nil
+	}
+
+	return inputs.naming.TypedSpec()
+}
+
+// resources returns all present config inputs.
+func (inputs *configInputs) resources() []resource.Resource {
+	resources := []resource.Resource{inputs.admission, inputs.audit, inputs.authorization, inputs.scheduler}
+
+	for _, authentication := range inputs.authentication {
+		resources = append(resources, authentication)
+	}
+
+	if inputs.clientCA != nil {
+		resources = append(resources, inputs.clientCA)
+	}
+
+	if inputs.konnectivity != nil {
+		resources = append(resources, inputs.konnectivity)
+	}
+
+	if inputs.tracing != nil {
+		resources = append(resources, inputs.tracing)
+	}
+
+	if inputs.serviceAccountIssuer != nil {
+		resources = append(resources, inputs.serviceAccountIssuer)
+	}
+
+	if inputs.serviceAccountSigner != nil {
+		resources = append(resources, inputs.serviceAccountSigner)
+	}
+
+	if inputs.apiServerShutdown != nil {
+		resources = append(resources, inputs.apiServerShutdown)
+	}
+
+	for _, secret := range inputs.secrets {
+		resources = append(resources, secret)
+	}
+
+	if inputs.nodeOverride != nil {
+		resources = append(resources, inputs.nodeOverride)
+	}
+
+	if inputs.renderPolicy != nil {
+		resources = append(resources, inputs.renderPolicy)
+	}
+
+	if inputs.naming != nil {
+		resources = append(resources, inputs.naming)
+	}
+
+	if inputs.apiServer != nil {
+		resources = append(resources, inputs.apiServer)
+	}
+
+	if inputs.airGapped != nil {
+		resources = append(resources, inputs.airGapped)
+	}
+
+	return resources
+}
+
+// ComputeConfigVersion computes the combined version of the static pod config input resources.
+func ComputeConfigVersion(resources ...resource.Resource) string {
+	resources = slices.SortedFunc(slices.Values(resources), func(a, b resource.Resource) int {
+		return cmp.Or(
+			cmp.Compare(a.Metadata().Type(), b.Metadata().Type()),
+			cmp.Compare(a.Metadata().ID(), b.Metadata().ID()),
+		)
+	})
+
+	var combined strings.Builder
+
+	for _, res := range resources {
+		combined.WriteString(res.Metadata().Version().String())
+	}
+
+	return combined.String()
+}
+
+// validateOnly returns true if the configs are only rendered and validated without writing them.
+func (inputs *configInputs) validateOnly() bool {
+	return inputs.renderPolicy != nil && inputs.renderPolicy.TypedSpec().ValidateOnly
+}
+
+// strictAdmissionPlugins returns true if admission plugins unknown to the kube-apiserver version are an error.
+func (inputs *configInputs) strictAdmissionPlugins() bool {
+	return inputs.renderPolicy != nil && inputs.renderPolicy.TypedSpec().StrictAdmissionPlugins
+}
+
+// strictStructuredAuth returns true if the structured auth configs which the kube-apiserver version can't honor are an error.
+func (inputs *configInputs) strictStructuredAuth() bool {
+	return inputs.renderPolicy != nil && inputs.renderPolicy.TypedSpec().StrictStructuredAuth
+}
+
+// podConfigVersions returns the versions of the applied configs by the static pod they are rendered for.
+func podConfigVersions(pods []staticPodConfigs, appliedConfigs map[string]k8s.AppliedConfigFileSpec) map[string]string {
+	versions := make(map[string]string, len(pods))
+
+	for _, pod := range pods {
+		hash := sha256.New()
+
+		filenames := xslices.Map(pod.configs, func(configFile configFile) string { return configFile.filename })
+		slices.Sort(filenames)
+
+		for _, filename := range filenames {
+			if applied, ok := appliedConfigs[filename]; ok {
+				fmt.Fprintf(hash, "%s %s %s\n", filename, applied.Path, applied.SHA256)
+			}
+		}
+
+		versions[pod.name] = hex.EncodeToString(hash.Sum(nil))
+	}
+
+	return versions
+}
+
+// configFile is a config rendered for the static pod.
+type configFile struct {
+	filename string
+	// secret is true if the config might contain secrets, so that it's rendered to the secret directory of the pod if set
+	secret bool
+	// f is nil if the config is not enabled, and any previously rendered config should be removed
+	f func() (runtime.Object, error)
+}
+
+// render generates the config, and runs the registered validators against it.
+func (file configFile) render() (runtime.Object, error) {
+	obj, err := file.f()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = runConfigValidators(obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// staticPodConfigs describes the configs rendered for the control plane static pod.
+type staticPodConfigs struct {
+	name      string
+	directory string
+	// secretDirectory is the directory of the configs which might contain secrets, if they are not rendered to the directory
+	secretDirectory  string
+	directoryMode    os.FileMode
+	selinuxLabel     string
+	fileSELinuxLabel string
+	uid              int
+	gid              int
+	configs          []configFile
+}
+
+// directories returns all config directories of the pod.
+func (pod staticPodConfigs) directories() []string {
+	if pod.secretDirectory == "" {
+		return []string{pod.directory}
+	}
+
+	return []string{pod.directory, pod.secretDirectory}
+}
+
+// configDir returns the directory the config is rendered to, depending on whether it might contain secrets.
+func (pod staticPodConfigs) configDir(secret bool) string {
+	if secret && pod.secretDirectory != "" {
+		return pod.secretDirectory
+	}
+
+	return pod.directory
+}
+
+func (ctrl *RenderConfigsStaticPodController) staticPodConfigs(inputs *configInputs, logger *zap.Logger) []staticPodConfigs {
+	authorizerConfig := inputs.authorization.TypedSpec()
+	kubeAPIServerVersion := compatibility.VersionFromImageRef(authorizerConfig.Image)
+
+	var authenticationConfigF, clientCAF, requestHeaderClientCAF, egressSelectorConfigF, tracingConfigF, discoveryDocumentF, jwksF func() (runtime.Object, error)
+
+	if inputs.authenticationSpec != nil {
+		var airGapped *k8s.AirGappedConfigSpec
+
+		if inputs.airGapped != nil {
+			airGapped = inputs.airGapped.TypedSpec()
+		}
+
+		authenticationConfigF = structuredAuthConfig(
+			"authentication-config.yaml",
+			authenticationConfig(inputs.authenticationSpec, nil, kubeAPIServerVersion, inputs.secrets, airGapped, logger),
+			kubeAPIServerVersion,
+			ctrl.StrictStructuredAuth || inputs.strictStructuredAuth(),
+			logger,
+		)
+	}
+
+	authorizationConfigF := authorizationConfig(authorizerConfig, authorizationFieldPath, kubeAPIServerVersion, inputs.secrets, logger)
+
+	// Node and RBAC authorizers are honored by any kube-apiserver version, as they fall back to the authorization mode flag
+	if slices.ContainsFunc(authorizerConfig.Config, func(authorizer k8s.AuthorizationAuthorizersSpec) bool {
+		return authorizer.Type != "Node" && authorizer.Type != "RBAC"
+	}) {
+		authorizationConfigF = structuredAuthConfig(
+			"authorization-config.yaml",
+			authorizationConfigF,
+			kubeAPIServerVersion,
+			ctrl.StrictStructuredAuth || inputs.strictStructuredAuth(),
+			logger,
+		)
+	}
+
+	if inputs.clientCA != nil {
+		if inputs.clientCA.TypedSpec().ClientCA != "" {
+			clientCAF = caBundle(inputs.clientCA.TypedSpec().ClientCA)
+		}
+
+		if inputs.clientCA.TypedSpec().RequestHeaderClientCA != "" {
+			requestHeaderClientCAF = caBundle(inputs.clientCA.TypedSpec().RequestHeaderClientCA)
+		}
+	}
+
+	if inputs.konnectivity != nil {
+		egressSelectorConfigF = egressSelectorConfig(inputs.konnectivity.TypedSpec(), kubeAPIServerVersion)
+	}
+
+	if inputs.tracing != nil {
+		tracingConfigF = apiServerTracingConfig(inputs.tracing.TypedSpec(), kubeAPIServerVersion)
+	}
+
+	if inputs.serviceAccountIssuer != nil {
+		discoveryDocumentF = serviceAccountIssuerDiscoveryDocument(inputs.serviceAccountIssuer.TypedSpec())
+		jwksF = serviceAccountIssuerJWKS(inputs.serviceAccountIssuer.TypedSpec())
+	}
+
+	apiServer, scheduler := ctrl.configPods()
+
+	scheduler.configs = append([]configFile{
+		{
+			filename: "scheduler-config.yaml",
+			f:        schedulerConfig(inputs.scheduler.TypedSpec(), scheduler.configDir(false), scheduler.configDir(true), inputs.namingPolicy(), logger),
+		},
+	}, schedulerExtenderTLSConfigs(inputs.scheduler.TypedSpec())...)
+
+	// kube-apiserver might not run on this node, e.g. in custom scheduler-only setups
+	if inputs.apiServer == nil {
+		return []staticPodConfigs{scheduler}
+	}
+
+	apiServer.configs = []configFile{
+		{
+			filename: "admission-control-config.yaml",
+			f:        admissionControlConfig(inputs.admission.TypedSpec(), kubeAPIServerVersion, ctrl.StrictAdmissionPlugins || inputs.strictAdmissionPlugins(), inputs.namingPolicy(), logger),
+		},
+		{
+			filename: "auditpolicy.yaml",
+			f:        auditPolicyConfig(inputs.audit.TypedSpec(), auditPolicyFieldPath, logger),
+		},
+		{
+			filename: "authentication-config.yaml",
+			secret:   true,
+			f:        authenticationConfigF,
+		},
+		{
+			filename: "authorization-config.yaml",
+			secret:   true,
+			f:        authorizationConfigF,
+		},
+		{
+			filename: "client-ca.crt",
+			f:        clientCAF,
+		},
+		{
+			filename: "egress-selector-config.yaml",
+			f:        egressSelectorConfigF,
+		},
+		{
+			filename: "jwks.json",
+			f:        jwksF,
+		},
+		{
+			filename: "openid-configuration.json",
+			f:        discoveryDocumentF,
+		},
+		{
+			filename: "requestheader-client-ca.crt",
+			f:        requestHeaderClientCAF,
+		},
+		{
+			filename: "tracing-config.yaml",
+			f:        tracingConfigF,
+		},
+	}
+
+	apiServer.configs = append(apiServer.configs, admissionPluginRawConfigs(inputs.admission.TypedSpec())...)
+
+	return []staticPodConfigs{apiServer, scheduler}
+}
+
+// structuredAuthMinVersions are the kube-apiserver versions which can honor the rendered structured auth configs.
+var structuredAuthMinVersions = map[string]semver.Version{
+	// the authentication config is rendered as v1beta1
+	"authentication-config.yaml": {Major: 1, Minor: 30},
+	"authorization-config.yaml":  {Major: 1, Minor: 29},
+}
+
+// structuredAuthConfig returns the structured auth config if the kube-apiserver version can honor it.
+func structuredAuthConfig(
+	filename string, f func() (runtime.Object, error), kubeAPIServerVersion compatibility.Version, strict bool, logger *zap.Logger,
+) func() (runtime.Object, error) {
+	minVersion := structuredAuthMinVersions[filename]
+
+	if semver.Version(kubeAPIServerVersion).GTE(minVersion) {
+		return f
+	}
+
+	if strict {
+		return func() (runtime.Object, error) {
+			return nil, fmt.Errorf("kube-apiserver %s doesn't support %s, at least %d.%d is required", kubeAPIServerVersion, filename, minVersion.Major, minVersion.Minor)
+		}
+	}
+
+	logger.Warn("skipping structured auth config not supported by kube-apiserver",
+		zap.String("filename", filename),
+		zap.Stringer("version", kubeAPIServerVersion),
+		zap.String("min_version", fmt.Sprintf("%d.%d", minVersion.Major, minVersion.Minor)),
+	)
+
+	return nil
+}
+
+// configPods returns the kube-apiserver and kube-scheduler config directories with their ownership, without any configs.
+func (ctrl *RenderConfigsStaticPodController) configPods() (apiServer, scheduler staticPodConfigs) {
+	apiServer = staticPodConfigs{
+		name:             k8s.APIServerID,
+		directory:        ctrl.APIServerConfigDir,
+		secretDirectory:  ctrl.APIServerSecretConfigDir,
+		directoryMode:    cmp.Or(ctrl.APIServerConfigDirMode, 0o755),
+		selinuxLabel:     constants.KubernetesAPIServerConfigDirSELinuxLabel,
+		fileSELinuxLabel: constants.KubernetesAPIServerConfigDirSELinuxLabel,
+		uid:              constants.KubernetesAPIServerRunUser,
+		gid:              constants.KubernetesAPIServerRunGroup,
+	}
+
+	scheduler = staticPodConfigs{
+		name:             k8s.SchedulerID,
+		directory:        ctrl.SchedulerConfigDir,
+		secretDirectory:  ctrl.SchedulerSecretConfigDir,
+		directoryMode:    cmp.Or(ctrl.SchedulerConfigDirMode, 0o755),
+		selinuxLabel:     constants.KubernetesSchedulerConfigDirSELinuxLabel,
+		fileSELinuxLabel: constants.KubernetesSchedulerConfigDirSELinuxLabel,
+		uid:              constants.KubernetesSchedulerRunUser,
+		gid:              constants.KubernetesSchedulerRunGroup,
+	}
+
+	return apiServer, scheduler
+}
+
+// nodeIdenticalConfigs are the security-critical configs which should be identical across the control plane nodes,
+// as otherwise requests would be authenticated or authorized depending on the kube-apiserver instance serving them.
+var nodeIdenticalConfigs = []string{
+	"authentication-config.yaml",
+	"authorization-config.yaml",
+}
+
+// applyNodeConfigOverrides merges the node-specific overrides into the rendered configs.
+func applyNodeConfigOverrides(pods []staticPodConfigs, override *k8s.NodeConfigOverride) error {
+	if override == nil {
+		return nil
+	}
+
+	overridden := map[string]struct{}{}
+
+	for _, fileOverride := range override.TypedSpec().Overrides {
+		if slices.Contains(nodeIdenticalConfigs, fileOverride.Filename) {
+			return fmt.Errorf("node %q: configuration %q should be identical across control plane nodes, node-specific overrides are not allowed",
+				override.Metadata().ID(), fileOverride.Filename)
+		}
+
+		if _, duplicate := overridden[fileOverride.Filename]; duplicate {
+			return fmt.Errorf("node %q: duplicate override of configuration %q", override.Metadata().ID(), fileOverride.Filename)
+		}
+
+		overridden[fileOverride.Filename] = struct{}{}
+
+		var found bool
+
+		for _, pod := range pods {
+			for i := range pod.configs {
+				if pod.configs[i].filename != fileOverride.Filename {
+					continue
+				}
+
+				if pod.configs[i].f == nil {
+					return fmt.Errorf("node %q: configuration %q for %q is not enabled", override.Metadata().ID(), fileOverride.Filename, pod.name)
+				}
+
+				pod.configs[i].f = overrideConfig(pod.configs[i].f, fileOverride.Config)
+				found = true
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("node %q: unknown configuration %q", override.Metadata().ID(), fileOverride.Filename)
+		}
+	}
+
+	return nil
+}
+
+// reservedConfigFilename returns true if the filename can't be used for a config in the pod config directory.
+func reservedConfigFilename(filename string) bool {
+	switch {
+	case filename != filepath.Base(filename), strings.HasPrefix(filename, "."):
+		return true
+	case filename == ConfigIndexFilename, filename == ConfigLockFilename:
+		return true
+	case strings.HasSuffix(filename, backupSuffix), strings.HasSuffix(filename, compressedBackupSuffix), strings.HasSuffix(filename, UnmanagedMarkerSuffix):
+		return true
+	default:
+		return false
+	}
+}
+
+// validateConfigNaming checks that the naming policy only renames the configs to the plain filenames unique within the pod config directory.
+func validateConfigNaming(pods []staticPodConfigs, naming *k8s.ConfigNamingPolicySpec, logger *zap.Logger) error {
+	if naming == nil {
+		return nil
+	}
+
+	known := map[string]struct{}{}
+
+	for _, pod := range pods {
+		diskFilenames := map[string]string{}
+
+		for _, configFile := range pod.configs {
+			known[configFile.filename] = struct{}{}
+
+			diskFilename := naming.Filename(configFile.filename)
+
+			if diskFilename != configFile.filename {
+				if reservedConfigFilename(diskFilename) {
+					return fmt.Errorf("config naming policy: invalid filename %q for configuration %q", diskFilename, configFile.filename)
+				}
+			}
+
+			if other, duplicate := diskFilenames[diskFilename]; duplicate {
+				return fmt.Errorf("config naming policy: configurations %q and %q for %q are both named %q", other, configFile.filename, pod.name, diskFilename)
+			}
+
+			diskFilenames[diskFilename] = configFile.filename
+		}
+	}
+
+	for _, filename := range slices.Sorted(maps.Keys(naming.Filenames)) {
+		if _, ok := known[filename]; !ok {
+			logger.Warn("config naming policy renames unknown configuration", zap.String("filename", filename))
+		}
+	}
+
+	return nil
+}
+
+// overrideConfig merges the override into the rendered config, maps are merged recursively, and any other values are replaced.
+func overrideConfig(f func() (runtime.Object, error), override map[string]any) func() (runtime.Object, error) {
+	return func() (runtime.Object, error) {
+		obj, err := f()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := obj.(jsonDocument); ok {
+			return nil, errors.New("configuration doesn't support node-specific overrides")
+		}
+
+		base, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		overridden := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(runtime.Object) //nolint:forcetypeassert
+
+		merged := normalizeIntegers(mergeConfigOverride(base, override))
+
+		if err = validateConfigSchema(merged, overridden); err != nil {
+			return nil, fmt.Errorf("error applying node-specific override: %w", err)
+		}
+
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(merged, overridden, true); err != nil {
+			return nil, fmt.Errorf("error applying node-specific override: %w", err)
+		}
+
+		return overridden, nil
+	}
+}
+
+func mergeConfigOverride(base, override map[string]any) map[string]any {
+	for k, v := range override {
+		baseMap, baseOk := base[k].(map[string]any)
+		overrideMap, overrideOk := v.(map[string]any)
+
+		if baseOk && overrideOk {
+			base[k] = mergeConfigOverride(baseMap, overrideMap)
+		} else {
+			base[k] = v
+		}
+	}
+
+	return base
+}
+
+// normalizeIntegers returns a copy of the config with the integral float64 values converted to int64.
+func normalizeIntegers(config map[string]any) map[string]any {
+	if config == nil {
+		return nil
+	}
+
+	return normalizeInteger(config).(map[string]any) //nolint:forcetypeassert
+}
+
+func normalizeInteger(value any) any {
+	switch v := value.(type) {
+	case float64:
+		if v == math.Trunc(v) && v >= math.MinInt64 && v < math.MaxInt64 {
+			return int64(v)
+		}
+
+		return v
+	case map[string]any:
+		normalized := make(map[string]any, len(v))
+
+		for k, item := range v {
+			normalized[k] = normalizeInteger(item)
+		}
+
+		return normalized
+	case []any:
+		normalized := make([]any, len(v))
+
+		for i, item := range v {
+			normalized[i] = normalizeInteger(item)
+		}
+
+		return normalized
+	default:
+		return value
+	}
+}
+
+// configFeatureGates maps the rendered config files to the Kubernetes feature gates they depend on.
+var configFeatureGates = map[string]string{
+	"authentication-config.yaml": "StructuredAuthenticationConfiguration",
+	"authorization-config.yaml":  "StructuredAuthorizationConfiguration",
 }
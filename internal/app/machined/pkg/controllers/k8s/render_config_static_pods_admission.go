@@ -0,0 +1,379 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+// admissionControlConfig renders the admission control config.
+func admissionControlConfig(
+	spec *k8s.AdmissionControlConfigSpec, kubeAPIServerVersion compatibility.Version, strict bool, naming *k8s.ConfigNamingPolicySpec, logger *zap.Logger,
+) func() (runtime.Object, error) {
+	return func() (runtime.Object, error) {
+		var cfg apiserverv1.AdmissionConfiguration
+
+		apiVersion, err := configAPIVersion("AdmissionConfiguration", kubeAPIServerVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.APIVersion = apiVersion
+		cfg.Kind = "AdmissionConfiguration"
+		cfg.Plugins = []apiserverv1.AdmissionPluginConfiguration{}
+
+		for _, plugin := range spec.Config {
+			if err := checkAdmissionPlugin(plugin.Name, kubeAPIServerVersion, logger); err != nil {
+				if strict {
+					return nil, err
+				}
+
+				logger.Warn("admission plugin configuration is ignored", zap.String("plugin", plugin.Name), zap.Error(err))
+			}
+
+			configuration, err := admissionPluginConfiguration(plugin)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding configuration for plugin %q: %w", plugin.Name, err)
+			}
+
+			if err = checkAdmissionPluginConfigKind(plugin.Name, configuration); err != nil {
+				return nil, err
+			}
+
+			if plugin.Name == "PodSecurity" {
+				if err = validatePodSecurityExemptions(configuration); err != nil {
+					return nil, fmt.Errorf("error validating configuration for plugin %q: %w", plugin.Name, err)
+				}
+			}
+
+			if plugin.RawConfiguration != "" {
+				// relative paths are resolved by kube-apiserver against the directory of the admission control config
+				cfg.Plugins = append(cfg.Plugins,
+					apiserverv1.AdmissionPluginConfiguration{
+						Name: plugin.Name,
+						Path: naming.Filename(admissionPluginConfigFilename(plugin.Name)),
+					},
+				)
+
+				continue
+			}
+
+			raw, err := json.Marshal(normalizeIntegers(configuration))
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling configuration for plugin %q: %w", plugin.Name, err)
+			}
+
+			cfg.Plugins = append(cfg.Plugins,
+				apiserverv1.AdmissionPluginConfiguration{
+					Name: plugin.Name,
+					Configuration: &runtime.Unknown{
+						Raw: raw,
+					},
+				},
+			)
+		}
+
+		if err := checkAdmissionPluginConflicts(spec.Config, kubeAPIServerVersion, logger); err != nil {
+			return nil, err
+		}
+
+		return &cfg, nil
+	}
+}
+
+// admissionPluginConfiguration returns the configuration of the admission plugin, decoding the raw configuration if it's set.
+func admissionPluginConfiguration(plugin k8s.AdmissionPluginSpec) (map[string]any, error) {
+	if plugin.RawConfiguration == "" {
+		return plugin.Configuration, nil
+	}
+
+	if len(plugin.Configuration) > 0 {
+		return nil, errors.New("configuration and raw configuration can't be set together")
+	}
+
+	var configuration map[string]any
+
+	if err := yaml.UnmarshalStrict([]byte(plugin.RawConfiguration), &configuration); err != nil {
+		return nil, err
+	}
+
+	return configuration, nil
+}
+
+// admissionPluginConfigFilename returns the name of the file the raw configuration of the admission plugin is written to.
+func admissionPluginConfigFilename(name string) string {
+	return "admission-" + strings.ToLower(name) + ".yaml"
+}
+
+// admissionPluginRawConfigs returns the files the raw configurations of the admission plugins are written to.
+func admissionPluginRawConfigs(spec *k8s.AdmissionControlConfigSpec) []configFile {
+	var configs []configFile
+
+	for _, plugin := range spec.Config {
+		if plugin.RawConfiguration == "" {
+			continue
+		}
+
+		contents := []byte(plugin.RawConfiguration)
+
+		configs = append(configs, configFile{
+			filename: admissionPluginConfigFilename(plugin.Name),
+			f: func() (runtime.Object, error) {
+				return rawYAMLDocument(contents), nil
+			},
+		})
+	}
+
+	return configs
+}
+
+// admissionPluginSupport is the range of Kubernetes minor versions (1.x) supporting the admission plugin.
+type admissionPluginSupport struct {
+	since      uint64
+	deprecated uint64
+	removed    uint64
+}
+
+// admissionPlugins are the admission plugins built into kube-apiserver.
+var admissionPlugins = map[string]admissionPluginSupport{
+	"AlwaysAdmit":                          {deprecated: 13},
+	"AlwaysDeny":                           {deprecated: 13},
+	"AlwaysPullImages":                     {},
+	"CertificateApproval":                  {},
+	"CertificateSigning":                   {},
+	"CertificateSubjectRestriction":        {},
+	"ClusterTrustBundleAttest":             {since: 27},
+	"DefaultIngressClass":                  {},
+	"DefaultStorageClass":                  {},
+	"DefaultTolerationSeconds":             {},
+	"DenyServiceExternalIPs":               {since: 21},
+	"EventRateLimit":                       {},
+	"ExtendedResourceToleration":           {},
+	"ImagePolicyWebhook":                   {},
+	"LimitPodHardAntiAffinityTopology":     {},
+	"LimitRanger":                          {},
+	"MutatingAdmissionPolicy":              {since: 32},
+	"MutatingAdmissionWebhook":             {},
+	"NamespaceAutoProvision":               {},
+	"NamespaceExists":                      {},
+	"NamespaceLifecycle":                   {},
+	"NodeRestriction":                      {},
+	"OwnerReferencesPermissionEnforcement": {},
+	"PersistentVolumeClaimResize":          {},
+	"PersistentVolumeLabel":                {deprecated: 13, removed: 31},
+	"PodNodeSelector":                      {},
+	"PodSecurity":                          {since: 22},
+	"PodSecurityPolicy":                    {deprecated: 21, removed: 25},
+	"PodTolerationRestriction":             {},
+	"Priority":                             {},
+	"ResourceQuota":                        {},
+	"RuntimeClass":                         {},
+	"SecurityContextDeny":                  {deprecated: 27, removed: 30},
+	"ServiceAccount":                       {},
+	"StorageObjectInUseProtection":         {},
+	"TaintNodesByCondition":                {},
+	"ValidatingAdmissionPolicy":            {since: 26},
+	"ValidatingAdmissionWebhook":           {},
+}
+
+// checkAdmissionPlugin returns an error if the admission plugin is not known to the kube-apiserver version,
+// as kube-apiserver ignores the configuration of such plugins.
+func checkAdmissionPlugin(name string, kubeAPIServerVersion compatibility.Version, logger *zap.Logger) error {
+	support, ok := admissionPlugins[name]
+	if !ok {
+		return fmt.Errorf("unknown admission plugin %q", name)
+	}
+
+	version := semver.Version(kubeAPIServerVersion)
+
+	minor := func(minor uint64) semver.Version {
+		return semver.Version{Major: 1, Minor: minor}
+	}
+
+	switch {
+	case support.since != 0 && version.LT(minor(support.since)):
+		return fmt.Errorf("admission plugin %q is not supported by Kubernetes %s, it was added in %s", name, kubeAPIServerVersion, minor(support.since))
+	case support.removed != 0 && version.GTE(minor(support.removed)):
+		return fmt.Errorf("admission plugin %q is not supported by Kubernetes %s, it was removed in %s", name, kubeAPIServerVersion, minor(support.removed))
+	case support.deprecated != 0 && version.GTE(minor(support.deprecated)):
+		logger.Warn("admission plugin is deprecated",
+			zap.String("plugin", name),
+			zap.Stringer("deprecated_in", minor(support.deprecated)),
+		)
+	}
+
+	return nil
+}
+
+// admissionPluginConfigKinds are the configuration kinds of the admission plugins with a versioned configuration.
+var admissionPluginConfigKinds = map[string]schema.GroupKind{
+	"EventRateLimit":             {Group: "eventratelimit.admission.k8s.io", Kind: "Configuration"},
+	"MutatingAdmissionWebhook":   {Group: "apiserver.config.k8s.io", Kind: "WebhookAdmissionConfiguration"},
+	"PodSecurity":                {Group: "pod-security.admission.config.k8s.io", Kind: "PodSecurityConfiguration"},
+	"PodTolerationRestriction":   {Group: "podtolerationrestriction.admission.k8s.io", Kind: "Configuration"},
+	"ValidatingAdmissionWebhook": {Group: "apiserver.config.k8s.io", Kind: "WebhookAdmissionConfiguration"},
+}
+
+// checkAdmissionPluginConfigKind returns an error if the apiVersion or kind of the admission plugin configuration
+// doesn't match the plugin, e.g. if the configuration was copied from another plugin.
+func checkAdmissionPluginConfigKind(name string, config map[string]any) error {
+	expected, ok := admissionPluginConfigKinds[name]
+	if !ok {
+		return nil
+	}
+
+	apiVersion, _ := config["apiVersion"].(string)
+	kind, _ := config["kind"].(string)
+
+	if apiVersion == "" && kind == "" {
+		return nil
+	}
+
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return fmt.Errorf("error parsing apiVersion of the configuration for plugin %q: %w", name, err)
+	}
+
+	if gv.Group != expected.Group || kind != expected.Kind {
+		return fmt.Errorf("configuration for plugin %q should be %s in the group %q, got apiVersion %q and kind %q",
+			name, expected.Kind, expected.Group, apiVersion, kind)
+	}
+
+	return nil
+}
+
+// admissionPluginConflict is a pair of admission plugins which shouldn't be enabled together.
+type admissionPluginConflict struct {
+	plugins   [2]string
+	reason    string
+	since     uint64
+	until     uint64
+	redundant bool
+}
+
+// admissionPluginConflicts are the known conflicting and redundant admission plugin combinations.
+var admissionPluginConflicts = []admissionPluginConflict{
+	{
+		plugins: [2]string{"AlwaysAdmit", "AlwaysDeny"},
+		reason:  "AlwaysDeny rejects all requests which AlwaysAdmit allows",
+	},
+	{
+		plugins:   [2]string{"NamespaceAutoProvision", "NamespaceExists"},
+		reason:    "NamespaceAutoProvision creates the missing namespaces, so NamespaceExists has nothing to reject",
+		redundant: true,
+	},
+	{
+		plugins:   [2]string{"NamespaceExists", "NamespaceLifecycle"},
+		reason:    "NamespaceLifecycle rejects requests in the missing namespaces as well",
+		redundant: true,
+	},
+}
+
+// checkAdmissionPluginConflicts returns an error if the conflicting admission plugins are enabled for the kube-apiserver version.
+func checkAdmissionPluginConflicts(plugins []k8s.AdmissionPluginSpec, kubeAPIServerVersion compatibility.Version, logger *zap.Logger) error {
+	version := semver.Version(kubeAPIServerVersion)
+
+	minor := func(minor uint64) semver.Version {
+		return semver.Version{Major: 1, Minor: minor}
+	}
+
+	enabled := func(name string) bool {
+		return slices.ContainsFunc(plugins, func(plugin k8s.AdmissionPluginSpec) bool { return plugin.Name == name })
+	}
+
+	for _, conflict := range admissionPluginConflicts {
+		if conflict.since != 0 && version.LT(minor(conflict.since)) {
+			continue
+		}
+
+		if conflict.until != 0 && version.GTE(minor(conflict.until)) {
+			continue
+		}
+
+		if !enabled(conflict.plugins[0]) || !enabled(conflict.plugins[1]) {
+			continue
+		}
+
+		if conflict.redundant {
+			logger.Warn("redundant admission plugins", zap.Strings("plugins", conflict.plugins[:]), zap.String("reason", conflict.reason))
+
+			continue
+		}
+
+		return fmt.Errorf("admission plugins %q and %q can't be enabled together: %s", conflict.plugins[0], conflict.plugins[1], conflict.reason)
+	}
+
+	return nil
+}
+
+// effectiveAdmissionPlugins returns the admission plugins in the order they are rendered.
+func effectiveAdmissionPlugins(cfg *apiserverv1.AdmissionConfiguration) []k8s.EffectiveAdmissionPluginSpec {
+	return xslices.Map(cfg.Plugins, func(plugin apiserverv1.AdmissionPluginConfiguration) k8s.EffectiveAdmissionPluginSpec {
+		return k8s.EffectiveAdmissionPluginSpec{
+			Name:       plugin.Name,
+			Configured: plugin.Path != "" || plugin.Configuration != nil && !slices.Contains([]string{"", "null", "{}"}, string(plugin.Configuration.Raw)),
+		}
+	})
+}
+
+// validatePodSecurityExemptions validates exemptions of the PodSecurity admission plugin configuration.
+func validatePodSecurityExemptions(config map[string]any) error {
+	exemptions, ok := config["exemptions"]
+	if !ok {
+		return nil
+	}
+
+	exemptionsMap, ok := exemptions.(map[string]any)
+	if !ok {
+		return fmt.Errorf("exemptions should be a map, got %T", exemptions)
+	}
+
+	for _, field := range []string{"namespaces", "runtimeClasses", "usernames"} {
+		values, ok := exemptionsMap[field]
+		if !ok {
+			continue
+		}
+
+		valuesList, ok := values.([]any)
+		if !ok {
+			return fmt.Errorf("exemptions.%s should be a list, got %T", field, values)
+		}
+
+		for i, value := range valuesList {
+			str, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("exemptions.%s[%d] should be a string, got %T", field, i, value)
+			}
+
+			if str == "" {
+				return fmt.Errorf("exemptions.%s[%d] should not be empty", field, i)
+			}
+
+			if field == "namespaces" {
+				if errs := validation.IsDNS1123Label(str); len(errs) > 0 {
+					return fmt.Errorf("exemptions.namespaces[%d] %q is not a valid namespace: %s", i, str, strings.Join(errs, ", "))
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,343 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+func TestAdmissionControlConfigPodSecurityExemptions(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name       string
+		exemptions map[string]any
+
+		expectedError string
+	}{
+		{
+			name: "valid",
+			exemptions: map[string]any{
+				"namespaces":     []any{"kube-system", "monitoring"},
+				"runtimeClasses": []any{"gvisor"},
+				"usernames":      []any{"system:serviceaccount:kube-system:replicaset-controller"},
+			},
+		},
+		{
+			name: "invalid namespace",
+			exemptions: map[string]any{
+				"namespaces": []any{"kube-system", "Monitoring_NS"},
+			},
+
+			expectedError: `exemptions.namespaces[1] "Monitoring_NS" is not a valid namespace`,
+		},
+		{
+			name: "empty runtime class",
+			exemptions: map[string]any{
+				"runtimeClasses": []any{""},
+			},
+
+			expectedError: "exemptions.runtimeClasses[0] should not be empty",
+		},
+		{
+			name: "non-string username",
+			exemptions: map[string]any{
+				"usernames": []any{42},
+			},
+
+			expectedError: "exemptions.usernames[0] should be a string, got int",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.AdmissionControlConfigSpec{
+				Config: []k8s.AdmissionPluginSpec{
+					{
+						Name: "PodSecurity",
+						Configuration: map[string]any{
+							"apiVersion": "pod-security.admission.config.k8s.io/v1alpha1",
+							"kind":       "PodSecurityConfiguration",
+							"exemptions": test.exemptions,
+						},
+					},
+				},
+			}
+
+			_, err := k8sctrl.AdmissionControlConfig(spec, compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:v1.33.0"), false, nil, zap.NewNop())()
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAdmissionControlConfigPluginNames(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		plugin string
+		image  string
+
+		expectedWarnings []string
+		expectedError    string
+	}{
+		{
+			name:   "known",
+			plugin: "PodSecurity",
+			image:  "registry.k8s.io/kube-apiserver:v1.33.0",
+		},
+		{
+			name:   "unknown",
+			plugin: "PodSecurityy",
+			image:  "registry.k8s.io/kube-apiserver:v1.33.0",
+
+			expectedWarnings: []string{"admission plugin configuration is ignored"},
+			expectedError:    `unknown admission plugin "PodSecurityy"`,
+		},
+		{
+			name:   "not yet added",
+			plugin: "MutatingAdmissionPolicy",
+			image:  "registry.k8s.io/kube-apiserver:v1.31.0",
+
+			expectedWarnings: []string{"admission plugin configuration is ignored"},
+			expectedError:    `admission plugin "MutatingAdmissionPolicy" is not supported by Kubernetes 1.31.0, it was added in 1.32.0`,
+		},
+		{
+			name:   "removed",
+			plugin: "PodSecurityPolicy",
+			image:  "registry.k8s.io/kube-apiserver:v1.33.0",
+
+			expectedWarnings: []string{"admission plugin configuration is ignored"},
+			expectedError:    `admission plugin "PodSecurityPolicy" is not supported by Kubernetes 1.33.0, it was removed in 1.25.0`,
+		},
+		{
+			name:   "deprecated",
+			plugin: "SecurityContextDeny",
+			image:  "registry.k8s.io/kube-apiserver:v1.29.0",
+
+			expectedWarnings: []string{"admission plugin is deprecated"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.AdmissionControlConfigSpec{
+				Config: []k8s.AdmissionPluginSpec{
+					{
+						Name:          test.plugin,
+						Configuration: map[string]any{},
+					},
+				},
+			}
+
+			kubeAPIServerVersion := compatibility.VersionFromImageRef(test.image)
+
+			core, logs := observer.New(zapcore.WarnLevel)
+
+			_, err := k8sctrl.AdmissionControlConfig(spec, kubeAPIServerVersion, false, nil, zap.New(core))()
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedWarnings, xslices.Map(logs.All(), func(entry observer.LoggedEntry) string {
+				return entry.Message
+			}))
+
+			_, err = k8sctrl.AdmissionControlConfig(spec, kubeAPIServerVersion, true, nil, zap.NewNop())()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAdmissionControlConfigPluginConfigKind(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name          string
+		plugin        string
+		configuration map[string]any
+
+		expectedError string
+	}{
+		{
+			name:   "matching",
+			plugin: "PodSecurity",
+			configuration: map[string]any{
+				"apiVersion": "pod-security.admission.config.k8s.io/v1",
+				"kind":       "PodSecurityConfiguration",
+			},
+		},
+		{
+			name:   "matching webhook",
+			plugin: "ValidatingAdmissionWebhook",
+			configuration: map[string]any{
+				"apiVersion":     "apiserver.config.k8s.io/v1",
+				"kind":           "WebhookAdmissionConfiguration",
+				"kubeConfigFile": "/etc/kubernetes/webhook-kubeconfig",
+			},
+		},
+		{
+			name:   "no kind",
+			plugin: "PodSecurity",
+			configuration: map[string]any{
+				"defaults": map[string]any{
+					"enforce": "baseline",
+				},
+			},
+		},
+		{
+			name:   "not versioned",
+			plugin: "PodNodeSelector",
+			configuration: map[string]any{
+				"podNodeSelectorPluginConfig": map[string]any{
+					"clusterDefaultNodeSelector": "role=worker",
+				},
+			},
+		},
+		{
+			name:   "mismatched group",
+			plugin: "PodSecurity",
+			configuration: map[string]any{
+				"apiVersion": "eventratelimit.admission.k8s.io/v1alpha1",
+				"kind":       "Configuration",
+			},
+
+			expectedError: `configuration for plugin "PodSecurity" should be PodSecurityConfiguration in the group "pod-security.admission.config.k8s.io", ` +
+				`got apiVersion "eventratelimit.admission.k8s.io/v1alpha1" and kind "Configuration"`,
+		},
+		{
+			name:   "mismatched kind",
+			plugin: "EventRateLimit",
+			configuration: map[string]any{
+				"apiVersion": "eventratelimit.admission.k8s.io/v1alpha1",
+				"kind":       "PodSecurityConfiguration",
+			},
+
+			expectedError: `configuration for plugin "EventRateLimit" should be Configuration in the group "eventratelimit.admission.k8s.io", ` +
+				`got apiVersion "eventratelimit.admission.k8s.io/v1alpha1" and kind "PodSecurityConfiguration"`,
+		},
+		{
+			name:   "invalid apiVersion",
+			plugin: "PodSecurity",
+			configuration: map[string]any{
+				"apiVersion": "pod-security.admission.config.k8s.io/v1/v2",
+				"kind":       "PodSecurityConfiguration",
+			},
+
+			expectedError: `error parsing apiVersion of the configuration for plugin "PodSecurity"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.AdmissionControlConfigSpec{
+				Config: []k8s.AdmissionPluginSpec{
+					{
+						Name:          test.plugin,
+						Configuration: test.configuration,
+					},
+				},
+			}
+
+			_, err := k8sctrl.AdmissionControlConfig(spec, compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:v1.33.0"), false, nil, zap.NewNop())()
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAdmissionControlConfigPluginConflicts(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name    string
+		plugins []string
+		image   string
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name:    "compatible",
+			plugins: []string{"PodSecurity", "NamespaceLifecycle", "AlwaysPullImages", "ValidatingAdmissionPolicy"},
+			image:   "registry.k8s.io/kube-apiserver:v1.33.0",
+		},
+		{
+			name:    "conflicting",
+			plugins: []string{"AlwaysDeny", "PodSecurity", "AlwaysAdmit"},
+			image:   "registry.k8s.io/kube-apiserver:v1.33.0",
+
+			expectedError: `admission plugins "AlwaysAdmit" and "AlwaysDeny" can't be enabled together: ` +
+				`AlwaysDeny rejects all requests which AlwaysAdmit allows`,
+		},
+		{
+			name:    "redundant",
+			plugins: []string{"NamespaceExists", "PodSecurity", "NamespaceAutoProvision"},
+			image:   "registry.k8s.io/kube-apiserver:v1.33.0",
+
+			expectedWarnings: []string{"NamespaceAutoProvision creates the missing namespaces, so NamespaceExists has nothing to reject"},
+		},
+		{
+			// PodSecurity is enabled alongside PodSecurityPolicy while migrating from it
+			name:    "migration",
+			plugins: []string{"PodSecurityPolicy", "PodSecurity"},
+			image:   "registry.k8s.io/kube-apiserver:v1.24.0",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.AdmissionControlConfigSpec{
+				Config: xslices.Map(test.plugins, func(name string) k8s.AdmissionPluginSpec {
+					return k8s.AdmissionPluginSpec{
+						Name:          name,
+						Configuration: map[string]any{},
+					}
+				}),
+			}
+
+			core, logs := observer.New(zapcore.WarnLevel)
+
+			_, err := k8sctrl.AdmissionControlConfig(spec, compatibility.VersionFromImageRef(test.image), false, nil, zap.New(core))()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedWarnings, xslices.Map(logs.FilterMessage("redundant admission plugins").All(), func(entry observer.LoggedEntry) string {
+				return entry.ContextMap()["reason"].(string) //nolint:forcetypeassert
+			}))
+		})
+	}
+}
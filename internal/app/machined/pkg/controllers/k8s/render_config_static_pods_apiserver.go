@@ -0,0 +1,450 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/ecdh"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	apiserverv1beta1 "k8s.io/apiserver/pkg/apis/apiserver/v1beta1"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+// serviceAccountSignerFeatureGate is the Kubernetes feature gate the external service account signer depends on.
+const serviceAccountSignerFeatureGate = "ExternalServiceAccountTokenSigner"
+
+// serviceAccountSignerMinVersion is the kube-apiserver version which introduced the external service account signer.
+var serviceAccountSignerMinVersion = semver.Version{Major: 1, Minor: 32}
+
+// validateServiceAccountSigner checks the endpoint of the external service account signer.
+func validateServiceAccountSigner(spec *k8s.ServiceAccountSignerConfigSpec, kubeAPIServerVersion compatibility.Version) error {
+	if semver.Version(kubeAPIServerVersion).LT(serviceAccountSignerMinVersion) {
+		return fmt.Errorf("kube-apiserver %s doesn't support the external service account signer, at least %d.%d is required",
+			kubeAPIServerVersion, serviceAccountSignerMinVersion.Major, serviceAccountSignerMinVersion.Minor)
+	}
+
+	if u, err := url.Parse(spec.Endpoint); err == nil && u.Scheme != "" && u.Host != "" {
+		return fmt.Errorf("invalid service account signer endpoint %q: kube-apiserver only supports signers listening on a unix socket", spec.Endpoint)
+	}
+
+	if !filepath.IsAbs(spec.Endpoint) || filepath.Clean(spec.Endpoint) != spec.Endpoint {
+		return fmt.Errorf("invalid service account signer endpoint %q: should be an absolute unix socket path", spec.Endpoint)
+	}
+
+	return nil
+}
+
+// defaultTerminationGracePeriod is the termination grace period of the pods which don't set it.
+const defaultTerminationGracePeriod = 30 * time.Second
+
+// validateAPIServerShutdown checks that kube-apiserver can shut down gracefully within the pod termination grace period.
+func validateAPIServerShutdown(spec *k8s.APIServerShutdownConfigSpec) error {
+	for _, duration := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"shutdown delay", spec.ShutdownDelay},
+		{"watch termination grace period", spec.WatchTerminationGracePeriod},
+		{"termination grace period", spec.TerminationGracePeriod},
+	} {
+		if duration.value < 0 {
+			return fmt.Errorf("invalid kube-apiserver shutdown config: %s should not be negative, got %s", duration.name, duration.value)
+		}
+	}
+
+	if spec.TerminationGracePeriod%time.Second != 0 {
+		return fmt.Errorf("invalid kube-apiserver shutdown config: termination grace period should be whole seconds, got %s", spec.TerminationGracePeriod)
+	}
+
+	terminationGracePeriod := cmp.Or(spec.TerminationGracePeriod, defaultTerminationGracePeriod)
+
+	if spec.ShutdownDelay+spec.WatchTerminationGracePeriod > terminationGracePeriod {
+		return fmt.Errorf("invalid kube-apiserver shutdown config: shutdown delay %s and watch termination grace period %s exceed the termination grace period %s",
+			spec.ShutdownDelay, spec.WatchTerminationGracePeriod, terminationGracePeriod)
+	}
+
+	return nil
+}
+
+func egressSelectorConfig(spec *k8s.KonnectivityServerConfigSpec, kubeAPIServerVersion compatibility.Version) func() (runtime.Object, error) {
+	return func() (runtime.Object, error) {
+		if errs := validation.IsDNS1123Label(spec.AgentNamespace); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid konnectivity agent namespace %q: %s", spec.AgentNamespace, strings.Join(errs, ", "))
+		}
+
+		if errs := validation.IsDNS1123Subdomain(spec.AgentServiceAccount); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid konnectivity agent service account %q: %s", spec.AgentServiceAccount, strings.Join(errs, ", "))
+		}
+
+		var connection apiserverv1beta1.Connection
+
+		if filepath.IsAbs(spec.ListenAddress) {
+			connection = apiserverv1beta1.Connection{
+				ProxyProtocol: apiserverv1beta1.ProtocolGRPC,
+				Transport: &apiserverv1beta1.Transport{
+					UDS: &apiserverv1beta1.UDSTransport{
+						UDSName: spec.ListenAddress,
+					},
+				},
+			}
+		} else {
+			addrPort, err := netip.ParseAddrPort(spec.ListenAddress)
+			if err != nil {
+				return nil, fmt.Errorf("invalid konnectivity server listen address %q: should be either an absolute unix socket path or IP:port: %w", spec.ListenAddress, err)
+			}
+
+			connection = apiserverv1beta1.Connection{
+				ProxyProtocol: apiserverv1beta1.ProtocolHTTPConnect,
+				Transport: &apiserverv1beta1.Transport{
+					TCP: &apiserverv1beta1.TCPTransport{
+						URL: "http://" + addrPort.String(),
+					},
+				},
+			}
+		}
+
+		var cfg apiserverv1beta1.EgressSelectorConfiguration
+
+		apiVersion, err := configAPIVersion("EgressSelectorConfiguration", kubeAPIServerVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.APIVersion = apiVersion
+		cfg.Kind = "EgressSelectorConfiguration"
+		cfg.EgressSelections = []apiserverv1beta1.EgressSelection{
+			{
+				Name:       "cluster",
+				Connection: connection,
+			},
+		}
+
+		for i, selection := range spec.EgressSelections {
+			if !slices.Contains(egressSelectionNames, selection.Name) {
+				return nil, fmt.Errorf("egress selection %d: unknown name %q, should be one of %s", i, selection.Name, strings.Join(egressSelectionNames, ", "))
+			}
+
+			// kube-apiserver refuses to start with the duplicate names, the cluster one is always rendered
+			if slices.ContainsFunc(cfg.EgressSelections, func(existing apiserverv1beta1.EgressSelection) bool { return existing.Name == selection.Name }) {
+				return nil, fmt.Errorf("egress selection %d: duplicate name %q", i, selection.Name)
+			}
+
+			selectionConnection, err := egressConnection(selection)
+			if err != nil {
+				return nil, fmt.Errorf("egress selection %d (%q): %w", i, selection.Name, err)
+			}
+
+			cfg.EgressSelections = append(cfg.EgressSelections, apiserverv1beta1.EgressSelection{
+				Name:       selection.Name,
+				Connection: selectionConnection,
+			})
+		}
+
+		return &cfg, nil
+	}
+}
+
+// egressSelectionNames are the egress selection names honored by kube-apiserver.
+var egressSelectionNames = []string{"cluster", "controlplane", "etcd"}
+
+// egressConnection returns the connection of the egress selection.
+func egressConnection(selection k8s.EgressSelectionSpec) (apiserverv1beta1.Connection, error) {
+	protocol := apiserverv1beta1.ProtocolType(selection.ProxyProtocol)
+
+	switch protocol {
+	case apiserverv1beta1.ProtocolDirect:
+		if selection.Transport != "" || selection.Address != "" {
+			return apiserverv1beta1.Connection{}, fmt.Errorf("%s proxy protocol doesn't take a transport", protocol)
+		}
+
+		return apiserverv1beta1.Connection{ProxyProtocol: protocol}, nil
+	case apiserverv1beta1.ProtocolHTTPConnect, apiserverv1beta1.ProtocolGRPC:
+		transport, err := egressTransport(selection.Transport, selection.Address)
+		if err != nil {
+			return apiserverv1beta1.Connection{}, err
+		}
+
+		if protocol == apiserverv1beta1.ProtocolGRPC && transport.UDS == nil {
+			return apiserverv1beta1.Connection{}, fmt.Errorf("%s proxy protocol requires the uds transport, got %q", protocol, selection.Transport)
+		}
+
+		return apiserverv1beta1.Connection{ProxyProtocol: protocol, Transport: transport}, nil
+	default:
+		return apiserverv1beta1.Connection{}, fmt.Errorf("unknown proxy protocol %q, should be one of %s, %s, %s",
+			selection.ProxyProtocol, apiserverv1beta1.ProtocolDirect, apiserverv1beta1.ProtocolHTTPConnect, apiserverv1beta1.ProtocolGRPC)
+	}
+}
+
+// egressTransport returns the transport of the egress selection connection.
+func egressTransport(transport, address string) (*apiserverv1beta1.Transport, error) {
+	switch transport {
+	case "uds":
+		if !filepath.IsAbs(address) {
+			return nil, fmt.Errorf("uds transport address %q should be an absolute unix socket path", address)
+		}
+
+		return &apiserverv1beta1.Transport{
+			UDS: &apiserverv1beta1.UDSTransport{
+				UDSName: address,
+			},
+		}, nil
+	case "tcp":
+		addrPort, err := netip.ParseAddrPort(address)
+		if err != nil {
+			return nil, fmt.Errorf("tcp transport address %q should be IP:port: %w", address, err)
+		}
+
+		return &apiserverv1beta1.Transport{
+			TCP: &apiserverv1beta1.TCPTransport{
+				URL: "http://" + addrPort.String(),
+			},
+		}, nil
+	case "udp":
+		return nil, errors.New("udp transport is not supported by kube-apiserver, should be either tcp or uds")
+	default:
+		return nil, fmt.Errorf("unknown transport %q, should be either tcp or uds", transport)
+	}
+}
+
+// apiServerTracingConfig renders the kube-apiserver tracing config.
+func apiServerTracingConfig(spec *k8s.APIServerTracingConfigSpec, kubeAPIServerVersion compatibility.Version) func() (runtime.Object, error) {
+	return func() (runtime.Object, error) {
+		var cfg apiserverv1beta1.TracingConfiguration
+
+		apiVersion, err := configAPIVersion("TracingConfiguration", kubeAPIServerVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.APIVersion = apiVersion
+		cfg.Kind = "TracingConfiguration"
+
+		if spec.Endpoint != "" {
+			cfg.Endpoint = &spec.Endpoint
+		}
+
+		if spec.SamplingRatePerMillion != 0 {
+			cfg.SamplingRatePerMillion = &spec.SamplingRatePerMillion
+		}
+
+		if errs := tracingapi.ValidateTracingConfiguration(&cfg.TracingConfiguration, nil, tracingFieldPath); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid kube-apiserver tracing config: %w", errs.ToAggregate())
+		}
+
+		return &cfg, nil
+	}
+}
+
+// validateCertificateAuthority checks that the CA bundle consists only of parseable PEM-encoded certificates.
+func validateCertificateAuthority(ca string) error {
+	rest := []byte(ca)
+
+	var certs int
+
+	for {
+		var block *pem.Block
+
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("unexpected PEM block type %q", block.Type)
+		}
+
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return err
+		}
+
+		certs++
+	}
+
+	if len(bytes.TrimSpace(rest)) > 0 {
+		return errors.New("trailing data after PEM-encoded certificates")
+	}
+
+	if certs == 0 {
+		return errors.New("no PEM-encoded certificates found")
+	}
+
+	return nil
+}
+
+// caBundle renders the PEM-encoded CA bundle, checking that it only consists of valid certificates.
+func caBundle(bundle string) func() (runtime.Object, error) {
+	return func() (runtime.Object, error) {
+		if err := validateCertificateAuthority(bundle); err != nil {
+			return nil, fmt.Errorf("error parsing CA bundle: %w", err)
+		}
+
+		if !strings.HasSuffix(bundle, "\n") {
+			bundle += "\n"
+		}
+
+		return pemDocument(bundle), nil
+	}
+}
+
+func serviceAccountIssuerDiscoveryDocument(spec *k8s.ServiceAccountIssuerDiscoverySpec) func() (runtime.Object, error) {
+	return func() (runtime.Object, error) {
+		issuerURL, err := url.Parse(spec.Issuer)
+		if err != nil {
+			return nil, fmt.Errorf("malformed service account issuer %q: %w", spec.Issuer, err)
+		}
+
+		// kube-apiserver only serves discovery documents for https issuers
+		if issuerURL.Scheme != "https" || issuerURL.Host == "" || issuerURL.RawQuery != "" || issuerURL.Fragment != "" {
+			return nil, fmt.Errorf("service account issuer %q should be an https URL without query or fragment", spec.Issuer)
+		}
+
+		var doc struct {
+			Issuer  string `json:"issuer"`
+			JWKSURI string `json:"jwks_uri"`
+		}
+
+		if err = json.Unmarshal([]byte(spec.DiscoveryDocument), &doc); err != nil {
+			return nil, fmt.Errorf("error unmarshaling discovery document: %w", err)
+		}
+
+		// service account tokens are rejected by the relying parties if the issuers don't match
+		if doc.Issuer != spec.Issuer {
+			return nil, fmt.Errorf("discovery document issuer %q doesn't match service account issuer %q", doc.Issuer, spec.Issuer)
+		}
+
+		if doc.JWKSURI == "" {
+			return nil, errors.New("discovery document doesn't have jwks_uri")
+		}
+
+		return indentJSONDocument(spec.DiscoveryDocument)
+	}
+}
+
+// jsonWebKey is a public key in JWKS, only the fields required to validate the key are decoded.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func serviceAccountIssuerJWKS(spec *k8s.ServiceAccountIssuerDiscoverySpec) func() (runtime.Object, error) {
+	return func() (runtime.Object, error) {
+		var jwks struct {
+			Keys []jsonWebKey `json:"keys"`
+		}
+
+		if err := json.Unmarshal([]byte(spec.JWKS), &jwks); err != nil {
+			return nil, fmt.Errorf("error unmarshaling JWKS: %w", err)
+		}
+
+		if len(jwks.Keys) == 0 {
+			return nil, errors.New("no keys found in JWKS")
+		}
+
+		for i, key := range jwks.Keys {
+			if err := validateJSONWebKey(key); err != nil {
+				return nil, fmt.Errorf("error parsing JWKS key %d (kid %q): %w", i, key.Kid, err)
+			}
+		}
+
+		return indentJSONDocument(spec.JWKS)
+	}
+}
+
+// validateJSONWebKey checks that the key is a well-formed service account token signing key.
+func validateJSONWebKey(key jsonWebKey) error {
+	if key.Use != "" && key.Use != "sig" {
+		return fmt.Errorf("key use should be %q, got %q", "sig", key.Use)
+	}
+
+	switch key.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil || len(n) == 0 {
+			return errors.New("malformed RSA modulus")
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil || len(e) == 0 {
+			return errors.New("malformed RSA exponent")
+		}
+
+		if exponent := new(big.Int).SetBytes(e); !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Bit(0) == 0 {
+			return errors.New("invalid RSA exponent")
+		}
+
+		return nil
+	case "EC":
+		var curve ecdh.Curve
+
+		switch key.Crv {
+		case "P-256":
+			curve = ecdh.P256()
+		case "P-384":
+			curve = ecdh.P384()
+		case "P-521":
+			curve = ecdh.P521()
+		default:
+			return fmt.Errorf("unsupported curve %q", key.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(key.X)
+		if err != nil {
+			return errors.New("malformed EC x coordinate")
+		}
+
+		y, err := base64.RawURLEncoding.DecodeString(key.Y)
+		if err != nil {
+			return errors.New("malformed EC y coordinate")
+		}
+
+		// NewPublicKey checks the coordinate lengths and that the point is on the curve
+		if _, err = curve.NewPublicKey(slices.Concat([]byte{4}, x, y)); err != nil {
+			return errors.New("invalid EC public key")
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported key type %q", key.Kty)
+	}
+}
+
+// indentJSONDocument normalizes the JSON document indentation, keeping all the fields.
+func indentJSONDocument(doc string) (jsonDocument, error) {
+	var buf bytes.Buffer
+
+	if err := json.Indent(&buf, []byte(doc), "", "  "); err != nil {
+		return nil, err
+	}
+
+	buf.WriteByte('\n')
+
+	return jsonDocument(buf.Bytes()), nil
+}
@@ -0,0 +1,787 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiserverv1beta1 "k8s.io/apiserver/pkg/apis/apiserver/v1beta1"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+func (suite *RenderConfigsStaticPodSuite) TestEgressSelectorConfig() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	path := filepath.Join(suite.apiServerConfigDir, "egress-selector-config.yaml")
+
+	suite.Assert().NoFileExists(path)
+
+	konnectivityConfig := k8s.NewKonnectivityServerConfig()
+	konnectivityConfig.TypedSpec().ListenAddress = "/etc/kubernetes/konnectivity-server/konnectivity-server.socket"
+	konnectivityConfig.TypedSpec().AgentNamespace = "kube-system"
+	konnectivityConfig.TypedSpec().AgentServiceAccount = "konnectivity-agent"
+	suite.Create(konnectivityConfig)
+
+	configStatus = suite.assertConfigStatusUpdated(configStatus)
+
+	contents, err := os.ReadFile(path)
+	suite.Require().NoError(err)
+	suite.Assert().Contains(string(contents), "udsName: /etc/kubernetes/konnectivity-server/konnectivity-server.socket")
+
+	suite.Destroy(konnectivityConfig)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	suite.Assert().NoFileExists(path)
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestAPIServerTracingConfig() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	path := filepath.Join(suite.apiServerConfigDir, "tracing-config.yaml")
+
+	suite.Assert().NoFileExists(path)
+
+	tracingConfig := k8s.NewAPIServerTracingConfig()
+	tracingConfig.TypedSpec().Endpoint = "10.5.0.1:4317"
+	tracingConfig.TypedSpec().SamplingRatePerMillion = 100
+	suite.Create(tracingConfig)
+
+	configStatus = suite.assertConfigStatusUpdated(configStatus)
+
+	contents, err := os.ReadFile(path)
+	suite.Require().NoError(err)
+	suite.Assert().Contains(string(contents), `apiVersion: apiserver.config.k8s.io/v1beta1
+endpoint: 10.5.0.1:4317
+kind: TracingConfiguration
+samplingRatePerMillion: 100
+`)
+
+	suite.Destroy(tracingConfig)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	suite.Assert().NoFileExists(path)
+}
+
+func TestEgressSelectorConfig(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		spec k8s.KonnectivityServerConfigSpec
+
+		expectedTransport apiserverv1beta1.Transport
+		expectedError     string
+	}{
+		{
+			name: "uds",
+			spec: k8s.KonnectivityServerConfigSpec{
+				ListenAddress:       "/etc/kubernetes/konnectivity-server/konnectivity-server.socket",
+				AgentNamespace:      "kube-system",
+				AgentServiceAccount: "konnectivity-agent",
+			},
+
+			expectedTransport: apiserverv1beta1.Transport{
+				UDS: &apiserverv1beta1.UDSTransport{
+					UDSName: "/etc/kubernetes/konnectivity-server/konnectivity-server.socket",
+				},
+			},
+		},
+		{
+			name: "tcp",
+			spec: k8s.KonnectivityServerConfigSpec{
+				ListenAddress:       "127.0.0.1:8131",
+				AgentNamespace:      "kube-system",
+				AgentServiceAccount: "konnectivity-agent",
+			},
+
+			expectedTransport: apiserverv1beta1.Transport{
+				TCP: &apiserverv1beta1.TCPTransport{
+					URL: "http://127.0.0.1:8131",
+				},
+			},
+		},
+		{
+			name: "malformed listen address",
+			spec: k8s.KonnectivityServerConfigSpec{
+				ListenAddress:       "konnectivity-server.socket",
+				AgentNamespace:      "kube-system",
+				AgentServiceAccount: "konnectivity-agent",
+			},
+
+			expectedError: `invalid konnectivity server listen address "konnectivity-server.socket"`,
+		},
+		{
+			name: "invalid agent namespace",
+			spec: k8s.KonnectivityServerConfigSpec{
+				ListenAddress:       "127.0.0.1:8131",
+				AgentNamespace:      "Kube_System",
+				AgentServiceAccount: "konnectivity-agent",
+			},
+
+			expectedError: `invalid konnectivity agent namespace "Kube_System"`,
+		},
+		{
+			name: "missing agent service account",
+			spec: k8s.KonnectivityServerConfigSpec{
+				ListenAddress:  "127.0.0.1:8131",
+				AgentNamespace: "kube-system",
+			},
+
+			expectedError: `invalid konnectivity agent service account ""`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			obj, err := k8sctrl.EgressSelectorConfig(&test.spec, testKubeAPIServerVersion)()
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			cfg, ok := obj.(*apiserverv1beta1.EgressSelectorConfiguration)
+			require.True(t, ok)
+			require.Len(t, cfg.EgressSelections, 1)
+			assert.Equal(t, "cluster", cfg.EgressSelections[0].Name)
+			assert.Equal(t, &test.expectedTransport, cfg.EgressSelections[0].Connection.Transport)
+		})
+	}
+}
+
+func TestEgressSelectorConfigSelections(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name       string
+		selections []k8s.EgressSelectionSpec
+
+		expectedSelections []apiserverv1beta1.EgressSelection
+		expectedError      string
+	}{
+		{
+			name: "multiple connections",
+			selections: []k8s.EgressSelectionSpec{
+				{
+					Name:          "controlplane",
+					ProxyProtocol: "Direct",
+				},
+				{
+					Name:          "etcd",
+					ProxyProtocol: "HTTPConnect",
+					Transport:     "tcp",
+					Address:       "127.0.0.1:8132",
+				},
+			},
+
+			expectedSelections: []apiserverv1beta1.EgressSelection{
+				{
+					Name: "cluster",
+					Connection: apiserverv1beta1.Connection{
+						ProxyProtocol: apiserverv1beta1.ProtocolGRPC,
+						Transport: &apiserverv1beta1.Transport{
+							UDS: &apiserverv1beta1.UDSTransport{
+								UDSName: "/etc/kubernetes/konnectivity-server/konnectivity-server.socket",
+							},
+						},
+					},
+				},
+				{
+					Name: "controlplane",
+					Connection: apiserverv1beta1.Connection{
+						ProxyProtocol: apiserverv1beta1.ProtocolDirect,
+					},
+				},
+				{
+					Name: "etcd",
+					Connection: apiserverv1beta1.Connection{
+						ProxyProtocol: apiserverv1beta1.ProtocolHTTPConnect,
+						Transport: &apiserverv1beta1.Transport{
+							TCP: &apiserverv1beta1.TCPTransport{
+								URL: "http://127.0.0.1:8132",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "grpc over uds",
+			selections: []k8s.EgressSelectionSpec{
+				{
+					Name:          "etcd",
+					ProxyProtocol: "GRPC",
+					Transport:     "uds",
+					Address:       "/run/etcd-proxy.socket",
+				},
+			},
+
+			expectedSelections: []apiserverv1beta1.EgressSelection{
+				{
+					Name: "cluster",
+					Connection: apiserverv1beta1.Connection{
+						ProxyProtocol: apiserverv1beta1.ProtocolGRPC,
+						Transport: &apiserverv1beta1.Transport{
+							UDS: &apiserverv1beta1.UDSTransport{
+								UDSName: "/etc/kubernetes/konnectivity-server/konnectivity-server.socket",
+							},
+						},
+					},
+				},
+				{
+					Name: "etcd",
+					Connection: apiserverv1beta1.Connection{
+						ProxyProtocol: apiserverv1beta1.ProtocolGRPC,
+						Transport: &apiserverv1beta1.Transport{
+							UDS: &apiserverv1beta1.UDSTransport{
+								UDSName: "/run/etcd-proxy.socket",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate name",
+			selections: []k8s.EgressSelectionSpec{
+				{
+					Name:          "etcd",
+					ProxyProtocol: "Direct",
+				},
+				{
+					Name:          "etcd",
+					ProxyProtocol: "Direct",
+				},
+			},
+
+			expectedError: `egress selection 1: duplicate name "etcd"`,
+		},
+		{
+			name: "duplicate cluster",
+			selections: []k8s.EgressSelectionSpec{
+				{
+					Name:          "cluster",
+					ProxyProtocol: "Direct",
+				},
+			},
+
+			expectedError: `egress selection 0: duplicate name "cluster"`,
+		},
+		{
+			name: "unknown name",
+			selections: []k8s.EgressSelectionSpec{
+				{
+					Name:          "master",
+					ProxyProtocol: "Direct",
+				},
+			},
+
+			expectedError: `egress selection 0: unknown name "master", should be one of cluster, controlplane, etcd`,
+		},
+		{
+			name: "udp transport",
+			selections: []k8s.EgressSelectionSpec{
+				{
+					Name:          "controlplane",
+					ProxyProtocol: "HTTPConnect",
+					Transport:     "udp",
+					Address:       "127.0.0.1:8132",
+				},
+			},
+
+			expectedError: `egress selection 0 ("controlplane"): udp transport is not supported by kube-apiserver, should be either tcp or uds`,
+		},
+		{
+			name: "grpc over tcp",
+			selections: []k8s.EgressSelectionSpec{
+				{
+					Name:          "controlplane",
+					ProxyProtocol: "GRPC",
+					Transport:     "tcp",
+					Address:       "127.0.0.1:8132",
+				},
+			},
+
+			expectedError: `egress selection 0 ("controlplane"): GRPC proxy protocol requires the uds transport, got "tcp"`,
+		},
+		{
+			name: "direct with transport",
+			selections: []k8s.EgressSelectionSpec{
+				{
+					Name:          "controlplane",
+					ProxyProtocol: "Direct",
+					Transport:     "tcp",
+					Address:       "127.0.0.1:8132",
+				},
+			},
+
+			expectedError: `egress selection 0 ("controlplane"): Direct proxy protocol doesn't take a transport`,
+		},
+		{
+			name: "relative uds path",
+			selections: []k8s.EgressSelectionSpec{
+				{
+					Name:          "etcd",
+					ProxyProtocol: "HTTPConnect",
+					Transport:     "uds",
+					Address:       "etcd-proxy.socket",
+				},
+			},
+
+			expectedError: `egress selection 0 ("etcd"): uds transport address "etcd-proxy.socket" should be an absolute unix socket path`,
+		},
+		{
+			name: "unknown proxy protocol",
+			selections: []k8s.EgressSelectionSpec{
+				{
+					Name:          "etcd",
+					ProxyProtocol: "SOCKS",
+				},
+			},
+
+			expectedError: `egress selection 0 ("etcd"): unknown proxy protocol "SOCKS", should be one of Direct, HTTPConnect, GRPC`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			obj, err := k8sctrl.EgressSelectorConfig(&k8s.KonnectivityServerConfigSpec{
+				ListenAddress:       "/etc/kubernetes/konnectivity-server/konnectivity-server.socket",
+				AgentNamespace:      "kube-system",
+				AgentServiceAccount: "konnectivity-agent",
+				EgressSelections:    test.selections,
+			}, testKubeAPIServerVersion)()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			cfg, ok := obj.(*apiserverv1beta1.EgressSelectorConfiguration)
+			require.True(t, ok)
+			assert.Equal(t, test.expectedSelections, cfg.EgressSelections)
+		})
+	}
+}
+
+func TestValidateAPIServerShutdown(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		spec k8s.APIServerShutdownConfigSpec
+
+		expectedError string
+	}{
+		{
+			name: "defaults",
+		},
+		{
+			name: "within termination grace period",
+			spec: k8s.APIServerShutdownConfigSpec{
+				ShutdownDelay:               20 * time.Second,
+				WatchTerminationGracePeriod: 30 * time.Second,
+				TerminationGracePeriod:      60 * time.Second,
+			},
+		},
+		{
+			name: "within default termination grace period",
+			spec: k8s.APIServerShutdownConfigSpec{
+				ShutdownDelay: 30 * time.Second,
+			},
+		},
+		{
+			name: "inverted",
+			spec: k8s.APIServerShutdownConfigSpec{
+				ShutdownDelay:          60 * time.Second,
+				TerminationGracePeriod: 30 * time.Second,
+			},
+
+			expectedError: "invalid kube-apiserver shutdown config: shutdown delay 1m0s and watch termination grace period 0s exceed the termination grace period 30s",
+		},
+		{
+			name: "exceeds default termination grace period",
+			spec: k8s.APIServerShutdownConfigSpec{
+				ShutdownDelay:               20 * time.Second,
+				WatchTerminationGracePeriod: 15 * time.Second,
+			},
+
+			expectedError: "invalid kube-apiserver shutdown config: shutdown delay 20s and watch termination grace period 15s exceed the termination grace period 30s",
+		},
+		{
+			name: "negative",
+			spec: k8s.APIServerShutdownConfigSpec{
+				ShutdownDelay: -5 * time.Second,
+			},
+
+			expectedError: "invalid kube-apiserver shutdown config: shutdown delay should not be negative, got -5s",
+		},
+		{
+			name: "fractional termination grace period",
+			spec: k8s.APIServerShutdownConfigSpec{
+				TerminationGracePeriod: 1500 * time.Millisecond,
+			},
+
+			expectedError: "invalid kube-apiserver shutdown config: termination grace period should be whole seconds, got 1.5s",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := k8sctrl.ValidateAPIServerShutdown(&test.spec)
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateServiceAccountSigner(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		endpoint string
+		version  string
+
+		expectedError string
+	}{
+		{
+			name:     "unix socket",
+			endpoint: "/var/run/signer/signer.sock",
+		},
+		{
+			name:     "oldest supported version",
+			endpoint: "/var/run/signer/signer.sock",
+			version:  "v1.32.0",
+		},
+		{
+			name:     "unsupported version",
+			endpoint: "/var/run/signer/signer.sock",
+			version:  "v1.31.4",
+
+			expectedError: "kube-apiserver 1.31.4 doesn't support the external service account signer, at least 1.32 is required",
+		},
+		{
+			name:     "https URL",
+			endpoint: "https://signer.example.com:8443",
+
+			expectedError: `invalid service account signer endpoint "https://signer.example.com:8443": kube-apiserver only supports signers listening on a unix socket`,
+		},
+		{
+			name:     "relative path",
+			endpoint: "signer.sock",
+
+			expectedError: `invalid service account signer endpoint "signer.sock": should be an absolute unix socket path`,
+		},
+		{
+			name:     "unclean path",
+			endpoint: "/var/run/signer/../signer.sock",
+
+			expectedError: `invalid service account signer endpoint "/var/run/signer/../signer.sock": should be an absolute unix socket path`,
+		},
+		{
+			name: "empty",
+
+			expectedError: `invalid service account signer endpoint "": should be an absolute unix socket path`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			version := testKubeAPIServerVersion
+			if test.version != "" {
+				version = compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:" + test.version)
+			}
+
+			err := k8sctrl.ValidateServiceAccountSigner(&k8s.ServiceAccountSignerConfigSpec{Endpoint: test.endpoint}, version)
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAPIServerTracingConfig(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		spec k8s.APIServerTracingConfigSpec
+
+		expectedEndpoint               *string
+		expectedSamplingRatePerMillion *int32
+		expectedError                  string
+	}{
+		{
+			name: "defaults",
+		},
+		{
+			name: "endpoint and sampling rate",
+			spec: k8s.APIServerTracingConfigSpec{
+				Endpoint:               "10.5.0.1:4317",
+				SamplingRatePerMillion: 100,
+			},
+
+			expectedEndpoint:               pointer.To("10.5.0.1:4317"),
+			expectedSamplingRatePerMillion: pointer.To[int32](100),
+		},
+		{
+			name: "unix socket endpoint",
+			spec: k8s.APIServerTracingConfigSpec{
+				Endpoint: "unix:///var/run/otel/otel.sock",
+			},
+
+			expectedEndpoint: pointer.To("unix:///var/run/otel/otel.sock"),
+		},
+		{
+			name: "https endpoint",
+			spec: k8s.APIServerTracingConfigSpec{
+				Endpoint: "https://otel.corp.internal:4317",
+			},
+
+			expectedError: `cluster.apiServer.tracing.endpoint: Invalid value: "https://otel.corp.internal:4317": unsupported scheme: https`,
+		},
+		{
+			name: "sampling rate out of range",
+			spec: k8s.APIServerTracingConfigSpec{
+				SamplingRatePerMillion: 2_000_000,
+			},
+
+			expectedError: "sampling rate per million must be less than or equal to one million",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			obj, err := k8sctrl.APIServerTracingConfig(&test.spec, testKubeAPIServerVersion)()
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			cfg, ok := obj.(*apiserverv1beta1.TracingConfiguration)
+			require.True(t, ok)
+
+			assert.Equal(t, "TracingConfiguration", cfg.Kind)
+			assert.Equal(t, test.expectedEndpoint, cfg.Endpoint)
+			assert.Equal(t, test.expectedSamplingRatePerMillion, cfg.SamplingRatePerMillion)
+		})
+	}
+}
+
+// testServiceAccountIssuerDiscovery returns discovery documents with a freshly generated ECDSA key.
+func testServiceAccountIssuerDiscovery(t *testing.T) k8s.ServiceAccountIssuerDiscoverySpec {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	ecdhKey, err := key.PublicKey.ECDH()
+	require.NoError(t, err)
+
+	// uncompressed point is 0x04 || x || y
+	point := ecdhKey.Bytes()[1:]
+
+	jwks, err := json.Marshal(map[string]any{
+		"keys": []any{
+			map[string]any{
+				"kty": "EC",
+				"kid": "test",
+				"use": "sig",
+				"alg": "ES256",
+				"crv": "P-256",
+				"x":   base64.RawURLEncoding.EncodeToString(point[:len(point)/2]),
+				"y":   base64.RawURLEncoding.EncodeToString(point[len(point)/2:]),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	return k8s.ServiceAccountIssuerDiscoverySpec{
+		Issuer: "https://kubernetes.example.com:6443",
+		DiscoveryDocument: `{"issuer":"https://kubernetes.example.com:6443","jwks_uri":"https://kubernetes.example.com:6443/openid/v1/jwks",` +
+			`"response_types_supported":["id_token"],"subject_types_supported":["public"],"id_token_signing_alg_values_supported":["ES256"]}`,
+		JWKS: string(jwks),
+	}
+}
+
+func TestServiceAccountIssuerDiscoveryDocument(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		modify func(*k8s.ServiceAccountIssuerDiscoverySpec)
+
+		expectedError string
+	}{
+		{
+			name:   "valid",
+			modify: func(*k8s.ServiceAccountIssuerDiscoverySpec) {},
+		},
+		{
+			name: "http issuer",
+			modify: func(spec *k8s.ServiceAccountIssuerDiscoverySpec) {
+				spec.Issuer = "http://kubernetes.example.com:6443"
+			},
+
+			expectedError: `service account issuer "http://kubernetes.example.com:6443" should be an https URL without query or fragment`,
+		},
+		{
+			name: "issuer mismatch",
+			modify: func(spec *k8s.ServiceAccountIssuerDiscoverySpec) {
+				spec.Issuer = "https://kubernetes.default.svc"
+			},
+
+			expectedError: `discovery document issuer "https://kubernetes.example.com:6443" doesn't match service account issuer "https://kubernetes.default.svc"`,
+		},
+		{
+			name: "no jwks_uri",
+			modify: func(spec *k8s.ServiceAccountIssuerDiscoverySpec) {
+				spec.DiscoveryDocument = `{"issuer":"https://kubernetes.example.com:6443"}`
+			},
+
+			expectedError: "discovery document doesn't have jwks_uri",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := testServiceAccountIssuerDiscovery(t)
+			test.modify(&spec)
+
+			obj, err := k8sctrl.ServiceAccountIssuerDiscoveryDocument(&spec)()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			contents, err := k8sctrl.CanonicalConfig(obj)
+			require.NoError(t, err)
+
+			var doc map[string]any
+
+			require.NoError(t, json.Unmarshal(contents, &doc))
+			assert.Equal(t, spec.Issuer, doc["issuer"])
+			assert.Equal(t, []any{"ES256"}, doc["id_token_signing_alg_values_supported"])
+		})
+	}
+}
+
+func TestServiceAccountIssuerJWKS(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		jwks func(valid string) string
+
+		expectedError string
+	}{
+		{
+			name: "valid",
+			jwks: func(valid string) string { return valid },
+		},
+		{
+			name: "valid RSA",
+			jwks: func(string) string {
+				return `{"keys":[{"kty":"RSA","kid":"rsa","use":"sig","alg":"RS256","n":"sXchDaQebHnPiGvyDOAT4saGEUetSyo9MKLOoWFsueri23bOdgWp4Dy1WlUzewbgBHod5pcM9H95GQRV3JDXboIRROSBigeC5yjU1hGzHHyXss8UDprecbAYxknTcQkhslANGRUZmdTOQ5qTRsLAt6BTYuyvVRdhS8exSZEy_c4gs_7svlJJQ4H9_NxsiIoLwAEk7-Q3UXERGYw_75IDrGA84-lA_-Ct4eTlXHBIY2EaV7t7LjJaynVJCpkv4LKjTTAumiGUIuQhrNhZLuF_RJLqHpM2kgWFLU7-VTdL1VbC2tejvcI2BlMkEpk1BzBZI0KQB0GaDWFLN-aEAw3vRw","e":"AQAB"}]}`
+			},
+		},
+		{
+			name: "malformed JSON",
+			jwks: func(string) string { return `{"keys":[` },
+
+			expectedError: "error unmarshaling JWKS: unexpected end of JSON input",
+		},
+		{
+			name: "no keys",
+			jwks: func(string) string { return `{"keys":[]}` },
+
+			expectedError: "no keys found in JWKS",
+		},
+		{
+			name: "point not on curve",
+			jwks: func(string) string {
+				return `{"keys":[{"kty":"EC","kid":"broken","crv":"P-256","x":"AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE","y":"AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE"}]}`
+			},
+
+			expectedError: `error parsing JWKS key 0 (kid "broken"): invalid EC public key`,
+		},
+		{
+			name: "malformed RSA exponent",
+			jwks: func(string) string { return `{"keys":[{"kty":"RSA","kid":"rsa","n":"sXch","e":"AA"}]}` },
+
+			expectedError: `error parsing JWKS key 0 (kid "rsa"): invalid RSA exponent`,
+		},
+		{
+			name: "encryption key",
+			jwks: func(string) string { return `{"keys":[{"kty":"RSA","kid":"enc","use":"enc","n":"sXch","e":"AQAB"}]}` },
+
+			expectedError: `error parsing JWKS key 0 (kid "enc"): key use should be "sig", got "enc"`,
+		},
+		{
+			name: "symmetric key",
+			jwks: func(string) string { return `{"keys":[{"kty":"oct","kid":"hmac","k":"c2VjcmV0"}]}` },
+
+			expectedError: `error parsing JWKS key 0 (kid "hmac"): unsupported key type "oct"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := testServiceAccountIssuerDiscovery(t)
+			spec.JWKS = test.jwks(spec.JWKS)
+
+			obj, err := k8sctrl.ServiceAccountIssuerJWKS(&spec)()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			contents, err := k8sctrl.CanonicalConfig(obj)
+			require.NoError(t, err)
+			assert.True(t, json.Valid(contents))
+		})
+	}
+}
@@ -0,0 +1,269 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+func auditPolicyConfig(spec *k8s.AuditPolicyConfigSpec, fldPath *field.Path, logger *zap.Logger) func() (runtime.Object, error) {
+	return func() (runtime.Object, error) {
+		var cfg auditv1.Policy
+
+		config, err := activeAuditPolicy(spec)
+		if err != nil {
+			return nil, &fieldPathError{path: fldPath, err: err}
+		}
+
+		if err = fromUnstructuredStrict(normalizeIntegers(config), &cfg, spec.StrictDecodingAllowlist); err != nil {
+			return nil, &fieldPathError{path: fldPath, err: fmt.Errorf("error unmarshaling audit policy configuration: %w", err)}
+		}
+
+		if err := validateAuditPolicy(&cfg, fldPath, spec.ValidationOverrides, logger); err != nil {
+			return nil, err
+		}
+
+		warnAuditPolicyResources(logger, &cfg)
+		warnShadowedAuditPolicyRules(logger, &cfg)
+
+		return &cfg, nil
+	}
+}
+
+// activeAuditPolicy returns the named policy selected by the active policy, or the config if there is no selector.
+func activeAuditPolicy(spec *k8s.AuditPolicyConfigSpec) (map[string]any, error) {
+	if spec.ActivePolicy == "" {
+		return spec.Config, nil
+	}
+
+	for _, policy := range spec.Policies {
+		if policy.Name == spec.ActivePolicy {
+			return policy.Config, nil
+		}
+	}
+
+	return nil, fmt.Errorf("active audit policy %q is not defined, defined policies: %q", spec.ActivePolicy,
+		xslices.Map(spec.Policies, func(policy k8s.AuditPolicyNamedSpec) string { return policy.Name }))
+}
+
+var (
+	auditLevels = []auditv1.Level{auditv1.LevelNone, auditv1.LevelMetadata, auditv1.LevelRequest, auditv1.LevelRequestResponse}
+	auditStages = []auditv1.Stage{auditv1.StageRequestReceived, auditv1.StageResponseStarted, auditv1.StageResponseComplete, auditv1.StagePanic}
+)
+
+// validateAuditPolicy checks audit levels and stages, as kube-apiserver refuses to start with unknown ones.
+func validateAuditPolicy(policy *auditv1.Policy, fldPath *field.Path, overrides validationOverrides, logger *zap.Logger) error {
+	for i, stage := range policy.OmitStages {
+		if !slices.Contains(auditStages, stage) {
+			if err := overrides.check(logger, &fieldPathError{path: fldPath.Child("omitStages").Index(i), err: fmt.Errorf("unknown audit stage %q", stage)}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, rule := range policy.Rules {
+		rulePath := fldPath.Child("rules").Index(i)
+
+		if !slices.Contains(auditLevels, rule.Level) {
+			if err := overrides.check(logger, &fieldPathError{
+				path: rulePath.Child("level"),
+				err:  fmt.Errorf("unknown audit level %q, should be one of %q", rule.Level, auditLevels),
+			}); err != nil {
+				return err
+			}
+		}
+
+		for j, stage := range rule.OmitStages {
+			if !slices.Contains(auditStages, stage) {
+				if err := overrides.check(logger, &fieldPathError{path: rulePath.Child("omitStages").Index(j), err: fmt.Errorf("unknown audit stage %q", stage)}); err != nil {
+					return err
+				}
+			}
+		}
+
+		for j, user := range rule.Users {
+			if err := validateAuditPolicyUser(user); err != nil {
+				if err = overrides.check(logger, &fieldPathError{path: rulePath.Child("users").Index(j), err: err}); err != nil {
+					return err
+				}
+			}
+		}
+
+		for j, group := range rule.UserGroups {
+			if err := validateAuditPolicyUserGroup(group); err != nil {
+				if err = overrides.check(logger, &fieldPathError{path: rulePath.Child("userGroups").Index(j), err: err}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// builtinGroups are the groups Kubernetes assigns to the users.
+var builtinGroups = []string{"system:authenticated", "system:unauthenticated", "system:masters", "system:nodes", "system:serviceaccounts"}
+
+func validateAuditPolicyUser(user string) error {
+	if err := validateAuditPolicySubject(user); err != nil {
+		return err
+	}
+
+	if slices.Contains(builtinGroups, user) || strings.HasPrefix(user, "system:serviceaccounts:") {
+		return fmt.Errorf("%q is a group, it should be listed in userGroups", user)
+	}
+
+	return nil
+}
+
+// validateAuditPolicyUserGroup checks the group, including the common mix-ups of the node and service account usernames with their groups.
+func validateAuditPolicyUserGroup(group string) error {
+	if err := validateAuditPolicySubject(group); err != nil {
+		return err
+	}
+
+	switch {
+	case group == "system:node" || strings.HasPrefix(group, "system:node:"):
+		return fmt.Errorf("%q is not a group, nodes are in the \"system:nodes\" group", group)
+	case group == "system:serviceaccount" || strings.HasPrefix(group, "system:serviceaccount:"):
+		return fmt.Errorf("%q is not a group, service account groups are prefixed with \"system:serviceaccounts\"", group)
+	}
+
+	return nil
+}
+
+func validateAuditPolicySubject(subject string) error {
+	if subject == "" {
+		return errors.New("should not be empty")
+	}
+
+	if strings.TrimSpace(subject) != subject {
+		return fmt.Errorf("%q should not have leading or trailing whitespace", subject)
+	}
+
+	return nil
+}
+
+// warnAuditPolicyResources logs a warning for audit policy rules referencing resources which look like typos.
+func warnAuditPolicyResources(logger *zap.Logger, policy *auditv1.Policy) {
+	for i, rule := range policy.Rules {
+		for _, group := range rule.Resources {
+			if strings.Contains(group.Group, "/") {
+				logger.Warn("audit policy rule resource group should not include the version",
+					zap.Int("rule", i),
+					zap.String("group", group.Group),
+				)
+			}
+
+			for _, groupResource := range group.Resources {
+				// only check the resource, subresources (e.g. "pods/log") are singular
+				name, _, _ := strings.Cut(groupResource, "/")
+
+				var problem string
+
+				switch {
+				case name == "*":
+				case name != strings.ToLower(name):
+					problem = "resource names should be lowercase"
+				case !strings.HasSuffix(name, "s"):
+					problem = "resource names should be plural"
+				}
+
+				if problem != "" {
+					logger.Warn("audit policy rule references suspicious resource name",
+						zap.Int("rule", i),
+						zap.String("group", group.Group),
+						zap.String("resource", groupResource),
+						zap.String("problem", problem),
+					)
+				}
+			}
+		}
+	}
+}
+
+// warnShadowedAuditPolicyRules logs a warning for audit policy rules shadowed by an earlier broader rule with the lower level.
+func warnShadowedAuditPolicyRules(logger *zap.Logger, policy *auditv1.Policy) {
+	for j, rule := range policy.Rules {
+		for i, earlier := range policy.Rules[:j] {
+			if slices.Index(auditLevels, earlier.Level) >= slices.Index(auditLevels, rule.Level) || !auditPolicyRuleCovers(earlier, rule) {
+				continue
+			}
+
+			logger.Warn("audit policy rule is shadowed by an earlier broader rule with the lower level",
+				zap.Int("rule", j),
+				zap.Int("shadowedBy", i),
+				zap.String("level", string(rule.Level)),
+				zap.String("effectiveLevel", string(earlier.Level)),
+			)
+
+			break
+		}
+	}
+}
+
+// auditPolicyRuleCovers returns true if the audit policy rule matches all requests the other rule matches.
+func auditPolicyRuleCovers(rule, other auditv1.PolicyRule) bool {
+	if !subsetOrAny(rule.Users, other.Users) || !subsetOrAny(rule.UserGroups, other.UserGroups) ||
+		!subsetOrAny(rule.Verbs, other.Verbs) || !subsetOrAny(rule.Namespaces, other.Namespaces) {
+		return false
+	}
+
+	// the rule without resources and non-resource URLs matches all requests
+	if len(rule.Resources) == 0 && len(rule.NonResourceURLs) == 0 {
+		return true
+	}
+
+	if len(other.Resources) == 0 && len(other.NonResourceURLs) == 0 {
+		return false
+	}
+
+	for _, otherGroup := range other.Resources {
+		if !slices.ContainsFunc(rule.Resources, func(group auditv1.GroupResources) bool { return groupResourcesCover(group, otherGroup) }) {
+			return false
+		}
+	}
+
+	for _, otherURL := range other.NonResourceURLs {
+		if !slices.ContainsFunc(rule.NonResourceURLs, func(url string) bool {
+			prefix, wildcard := strings.CutSuffix(url, "*")
+
+			return url == otherURL || (wildcard && strings.HasPrefix(otherURL, prefix))
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// groupResourcesCover returns true if the audit policy rule resources match all resources the other ones match.
+func groupResourcesCover(group, other auditv1.GroupResources) bool {
+	if group.Group != other.Group || !subsetOrAny(group.ResourceNames, other.ResourceNames) {
+		return false
+	}
+
+	return slices.Contains(group.Resources, "*") || subsetOrAny(group.Resources, other.Resources)
+}
+
+// subsetOrAny returns true if the values are empty, i.e. match anything, or contain all other values.
+func subsetOrAny(values, other []string) bool {
+	if len(values) == 0 {
+		return true
+	}
+
+	return len(other) > 0 && !slices.ContainsFunc(other, func(value string) bool { return !slices.Contains(values, value) })
+}
@@ -0,0 +1,376 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+func TestAuditPolicyConfigResourceWarnings(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name      string
+		resources []any
+
+		expectedWarnings []string
+	}{
+		{
+			name: "valid",
+			resources: []any{
+				map[string]any{
+					"group":     "",
+					"resources": []any{"pods", "pods/log", "endpoints"},
+				},
+				map[string]any{
+					"group":     "apps",
+					"resources": []any{"*"},
+				},
+			},
+		},
+		{
+			name: "singular",
+			resources: []any{
+				map[string]any{
+					"group":     "",
+					"resources": []any{"pod", "secrets"},
+				},
+			},
+
+			expectedWarnings: []string{"pod"},
+		},
+		{
+			name: "uppercase",
+			resources: []any{
+				map[string]any{
+					"group":     "apps",
+					"resources": []any{"Deployments"},
+				},
+			},
+
+			expectedWarnings: []string{"Deployments"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			core, logs := observer.New(zapcore.WarnLevel)
+
+			spec := &k8s.AuditPolicyConfigSpec{
+				Config: map[string]any{
+					"apiVersion": "audit.k8s.io/v1",
+					"kind":       "Policy",
+					"rules": []any{
+						map[string]any{
+							"level":     "RequestResponse",
+							"resources": test.resources,
+						},
+					},
+				},
+			}
+
+			_, err := k8sctrl.AuditPolicyConfig(spec, k8sctrl.AuditPolicyFieldPath, zap.New(core))()
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedWarnings, xslices.Map(logs.All(), func(entry observer.LoggedEntry) string {
+				return entry.ContextMap()["resource"].(string) //nolint:forcetypeassert
+			}))
+		})
+	}
+}
+
+func TestAuditPolicyConfigShadowedRules(t *testing.T) {
+	t.Parallel()
+
+	secrets := map[string]any{
+		"level": "RequestResponse",
+		"resources": []any{
+			map[string]any{
+				"group":     "",
+				"resources": []any{"secrets"},
+			},
+		},
+	}
+
+	for _, test := range []struct {
+		name  string
+		rules []any
+
+		expectedShadowed [][2]int
+	}{
+		{
+			name: "specific rule first",
+			rules: []any{
+				secrets,
+				map[string]any{
+					"level": "Metadata",
+				},
+			},
+		},
+		{
+			name: "broad rule first",
+			rules: []any{
+				map[string]any{
+					"level": "None",
+					"users": []any{"system:kube-proxy"},
+				},
+				map[string]any{
+					"level": "Metadata",
+				},
+				secrets,
+			},
+
+			expectedShadowed: [][2]int{{2, 1}},
+		},
+		{
+			name: "broad resources first",
+			rules: []any{
+				map[string]any{
+					"level":      "Metadata",
+					"namespaces": []any{"kube-system"},
+					"resources": []any{
+						map[string]any{
+							"group": "",
+						},
+					},
+				},
+				map[string]any{
+					"level":      "Request",
+					"namespaces": []any{"kube-system"},
+					"verbs":      []any{"create"},
+					"resources": []any{
+						map[string]any{
+							"group":     "",
+							"resources": []any{"configmaps"},
+						},
+					},
+				},
+				map[string]any{
+					"level":           "Request",
+					"nonResourceURLs": []any{"/healthz"},
+				},
+			},
+
+			expectedShadowed: [][2]int{{1, 0}},
+		},
+		{
+			name: "narrower rule first",
+			rules: []any{
+				map[string]any{
+					"level":      "Metadata",
+					"namespaces": []any{"kube-system"},
+				},
+				map[string]any{
+					"level":           "None",
+					"nonResourceURLs": []any{"/healthz*"},
+				},
+				map[string]any{
+					"level":           "Request",
+					"nonResourceURLs": []any{"/healthz/etcd"},
+				},
+				secrets,
+			},
+
+			expectedShadowed: [][2]int{{2, 1}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			core, logs := observer.New(zapcore.WarnLevel)
+
+			spec := &k8s.AuditPolicyConfigSpec{
+				Config: map[string]any{
+					"apiVersion": "audit.k8s.io/v1",
+					"kind":       "Policy",
+					"rules":      test.rules,
+				},
+			}
+
+			_, err := k8sctrl.AuditPolicyConfig(spec, k8sctrl.AuditPolicyFieldPath, zap.New(core))()
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedShadowed, xslices.Map(logs.All(), func(entry observer.LoggedEntry) [2]int {
+				return [2]int{int(entry.ContextMap()["rule"].(int64)), int(entry.ContextMap()["shadowedBy"].(int64))} //nolint:forcetypeassert
+			}))
+		})
+	}
+}
+
+func TestAuditPolicyConfigFieldPath(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name  string
+		rules []any
+
+		expectedError string
+	}{
+		{
+			name: "valid",
+			rules: []any{
+				map[string]any{
+					"level":      "Metadata",
+					"omitStages": []any{"RequestReceived"},
+				},
+			},
+		},
+		{
+			name: "unknown level",
+			rules: []any{
+				map[string]any{
+					"level": "None",
+				},
+				map[string]any{
+					"level": "Metadata",
+				},
+				map[string]any{
+					"level": "Everything",
+				},
+			},
+
+			expectedError: `cluster.apiServer.auditPolicy.rules[2].level: unknown audit level "Everything"`,
+		},
+		{
+			name: "unknown stage",
+			rules: []any{
+				map[string]any{
+					"level":      "Metadata",
+					"omitStages": []any{"RequestReceived", "ResponseSent"},
+				},
+			},
+
+			expectedError: `cluster.apiServer.auditPolicy.rules[0].omitStages[1]: unknown audit stage "ResponseSent"`,
+		},
+		{
+			name: "targeted rule",
+			rules: []any{
+				map[string]any{
+					"level":      "RequestResponse",
+					"users":      []any{"admin@example.com", "system:serviceaccount:kube-system:default"},
+					"userGroups": []any{"system:masters", "system:serviceaccounts:kube-system", "oidc:admins"},
+				},
+			},
+		},
+		{
+			name: "empty user",
+			rules: []any{
+				map[string]any{
+					"level": "None",
+				},
+				map[string]any{
+					"level": "Metadata",
+					"users": []any{"admin@example.com", ""},
+				},
+			},
+
+			expectedError: `cluster.apiServer.auditPolicy.rules[1].users[1]: should not be empty`,
+		},
+		{
+			name: "user with whitespace",
+			rules: []any{
+				map[string]any{
+					"level": "Metadata",
+					"users": []any{"admin@example.com "},
+				},
+			},
+
+			expectedError: `cluster.apiServer.auditPolicy.rules[0].users[0]: "admin@example.com " should not have leading or trailing whitespace`,
+		},
+		{
+			name: "group as user",
+			rules: []any{
+				map[string]any{
+					"level": "Metadata",
+					"users": []any{"system:serviceaccounts:kube-system"},
+				},
+			},
+
+			expectedError: `cluster.apiServer.auditPolicy.rules[0].users[0]: "system:serviceaccounts:kube-system" is a group, it should be listed in userGroups`,
+		},
+		{
+			name: "node user as group",
+			rules: []any{
+				map[string]any{
+					"level":      "Metadata",
+					"userGroups": []any{"system:node"},
+				},
+			},
+
+			expectedError: `cluster.apiServer.auditPolicy.rules[0].userGroups[0]: "system:node" is not a group, nodes are in the "system:nodes" group`,
+		},
+		{
+			name: "service account user as group",
+			rules: []any{
+				map[string]any{
+					"level":      "Metadata",
+					"userGroups": []any{"system:serviceaccount:kube-system"},
+				},
+			},
+
+			expectedError: `cluster.apiServer.auditPolicy.rules[0].userGroups[0]: "system:serviceaccount:kube-system" is not a group, service account groups are prefixed with "system:serviceaccounts"`,
+		},
+		{
+			name: "unknown field",
+			rules: []any{
+				map[string]any{
+					"level": "Metadata",
+					"users": "admin",
+				},
+			},
+
+			expectedError: "cluster.apiServer.auditPolicy: error unmarshaling audit policy configuration",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.AuditPolicyConfigSpec{
+				Config: map[string]any{
+					"apiVersion": "audit.k8s.io/v1",
+					"kind":       "Policy",
+					"rules":      test.rules,
+				},
+			}
+
+			_, err := k8sctrl.AuditPolicyConfig(spec, k8sctrl.AuditPolicyFieldPath, zap.NewNop())()
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuditPolicyConfigUndefinedActivePolicy(t *testing.T) {
+	t.Parallel()
+
+	_, err := k8sctrl.AuditPolicyConfig(&k8s.AuditPolicyConfigSpec{
+		Policies: []k8s.AuditPolicyNamedSpec{
+			{
+				Name: "quiet",
+				Config: map[string]any{
+					"apiVersion": "audit.k8s.io/v1",
+					"kind":       "Policy",
+				},
+			},
+		},
+		ActivePolicy: "verbose",
+	}, k8sctrl.AuditPolicyFieldPath, zap.NewNop())()
+	require.EqualError(t, err, `cluster.apiServer.auditPolicy: active audit policy "verbose" is not defined, defined policies: ["quiet"]`)
+}
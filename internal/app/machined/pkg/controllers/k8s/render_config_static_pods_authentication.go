@@ -0,0 +1,490 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	apiserverv1beta1 "k8s.io/apiserver/pkg/apis/apiserver/v1beta1"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// authenticationConfig renders the structured authentication config.
+func authenticationConfig(
+	spec *k8s.AuthenticationConfigSpec, fldPath *field.Path, kubeAPIServerVersion compatibility.Version, resolver secretResolver, airGapped *k8s.AirGappedConfigSpec,
+	logger *zap.Logger,
+) func() (runtime.Object, error) {
+	return func() (runtime.Object, error) {
+		var cfg apiserverv1beta1.AuthenticationConfiguration
+
+		config, err := resolver.resolveMap(spec.Config, fldPath)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = fromUnstructuredStrict(normalizeIntegers(config), &cfg, spec.StrictDecodingAllowlist); err != nil {
+			return nil, fmt.Errorf("error unmarshaling authentication configuration: %w", err)
+		}
+
+		apiVersion, err := configAPIVersion("AuthenticationConfiguration", kubeAPIServerVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.APIVersion = apiVersion
+		cfg.Kind = "AuthenticationConfiguration"
+
+		overrides := validationOverrides(spec.ValidationOverrides)
+
+		for i, jwt := range cfg.JWT {
+			if err = validateJWTAudiences(jwt.Issuer, fldPath.Child("jwt").Index(i).Child("issuer"), overrides, logger); err != nil {
+				return nil, err
+			}
+
+			if airGapped != nil {
+				if err = validateAirGappedJWTIssuer(jwt.Issuer, fldPath.Child("jwt").Index(i).Child("issuer"), airGapped.AllowedHosts, overrides, logger); err != nil {
+					return nil, err
+				}
+			}
+
+			warnContradictoryClaimValidationRules(logger, jwt, fldPath.Child("jwt").Index(i).Child("claimValidationRules"))
+
+			for _, mapping := range []struct {
+				field string
+				apiserverv1beta1.PrefixedClaimOrExpression
+			}{
+				{"username", jwt.ClaimMappings.Username},
+				{"groups", jwt.ClaimMappings.Groups},
+			} {
+				if mapping.Expression != "" && mapping.Prefix != nil {
+					if err = overrides.check(logger, &fieldPathError{
+						path: fldPath.Child("jwt").Index(i).Child("claimMappings", mapping.field, "prefix"),
+						err:  fmt.Errorf("JWT authenticator for issuer %q: prefix can't be set together with expression, prefix the value in the expression instead", jwt.Issuer.URL),
+					}); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+
+		for _, cas := range spec.JWTCertificateAuthorities {
+			idx := slices.IndexFunc(cfg.JWT, func(jwt apiserverv1beta1.JWTAuthenticator) bool {
+				return jwt.Issuer.URL == cas.IssuerURL
+			})
+			if idx == -1 {
+				return nil, fmt.Errorf("no JWT authenticator found for issuer %q", cas.IssuerURL)
+			}
+
+			bundle := cfg.JWT[idx].Issuer.CertificateAuthority
+
+			for i, ca := range cas.CertificateAuthorities {
+				if err := validateCertificateAuthority(ca); err != nil {
+					return nil, fmt.Errorf("error parsing certificate authority %d for issuer %q: %w", i, cas.IssuerURL, err)
+				}
+
+				if bundle != "" && !strings.HasSuffix(bundle, "\n") {
+					bundle += "\n"
+				}
+
+				bundle += ca
+			}
+
+			cfg.JWT[idx].Issuer.CertificateAuthority = bundle
+		}
+
+		warnUsernamePrefixCollisions(logger, cfg.JWT, fldPath.Child("jwt"))
+
+		// anonymous authentication is disabled with the kube-apiserver flag unless it's enabled in the config
+		if len(cfg.JWT) == 0 && (cfg.Anonymous == nil || !cfg.Anonymous.Enabled) {
+			logger.Warn("structured authentication config defines no JWT authenticators and anonymous authentication is disabled, "+
+				"only client certificates and Kubernetes-issued tokens are accepted",
+				zap.String("field", fldPath.Child("jwt").String()),
+			)
+		}
+
+		return &cfg, nil
+	}
+}
+
+// jwtOverrideIndexRe matches the index of the JWT authenticator in the validation override field path, e.g. jwt[1].issuer.audiences.
+var jwtOverrideIndexRe = regexp.MustCompile(`^jwt\[(\d+)\]`)
+
+// mergeAuthenticationConfigs merges the structured authentication configs into a single config, in the order of their IDs.
+func mergeAuthenticationConfigs(configs []*k8s.AuthenticationConfig) (*k8s.AuthenticationConfigSpec, error) {
+	if len(configs) == 1 {
+		return configs[0].TypedSpec(), nil
+	}
+
+	merged := &k8s.AuthenticationConfigSpec{
+		Config: map[string]any{},
+	}
+
+	var jwts []any
+
+	// IDs of the configs which set the top-level settings and the JWT issuers
+	settingSources := map[string]resource.ID{}
+	issuerSources := map[string]resource.ID{}
+
+	for _, config := range configs {
+		id, spec := config.Metadata().ID(), config.TypedSpec()
+
+		for _, key := range slices.Sorted(maps.Keys(spec.Config)) {
+			if key == "jwt" {
+				continue
+			}
+
+			if previous, ok := settingSources[key]; ok {
+				if !reflect.DeepEqual(merged.Config[key], spec.Config[key]) {
+					return nil, fmt.Errorf("authentication configs %q and %q set different %q", previous, id, key)
+				}
+
+				continue
+			}
+
+			settingSources[key] = id
+			merged.Config[key] = spec.Config[key]
+		}
+
+		configJWTs, ok := spec.Config["jwt"].([]any)
+		if !ok && spec.Config["jwt"] != nil {
+			return nil, fmt.Errorf("authentication config %q: jwt should be a list", id)
+		}
+
+		for _, jwt := range configJWTs {
+			// malformed authenticators are rejected when the merged config is decoded
+			authenticator, _ := jwt.(map[string]any)
+			issuer, _ := authenticator["issuer"].(map[string]any)
+
+			url, _ := issuer["url"].(string)
+			if url == "" {
+				continue
+			}
+
+			if previous, ok := issuerSources[url]; ok && previous != id {
+				return nil, fmt.Errorf("JWT issuer %q is defined in both authentication configs %q and %q", url, previous, id)
+			}
+
+			issuerSources[url] = id
+		}
+
+		offset := len(jwts)
+		jwts = append(jwts, configJWTs...)
+
+		for _, override := range spec.ValidationOverrides {
+			merged.ValidationOverrides = append(merged.ValidationOverrides, jwtOverrideIndexRe.ReplaceAllStringFunc(override, func(match string) string {
+				index, _ := strconv.Atoi(jwtOverrideIndexRe.FindStringSubmatch(match)[1]) //nolint:errcheck
+
+				return fmt.Sprintf("jwt[%d]", index+offset)
+			}))
+		}
+
+		merged.JWTCertificateAuthorities = append(merged.JWTCertificateAuthorities, spec.JWTCertificateAuthorities...)
+
+		for _, fieldPath := range spec.StrictDecodingAllowlist {
+			if !slices.Contains(merged.StrictDecodingAllowlist, fieldPath) {
+				merged.StrictDecodingAllowlist = append(merged.StrictDecodingAllowlist, fieldPath)
+			}
+		}
+	}
+
+	if jwts != nil {
+		merged.Config["jwt"] = jwts
+	}
+
+	return merged, nil
+}
+
+// claimEqualityExpressionRe matches the CEL expressions which assert a claim equals a string literal, e.g. claims.hd == "example.com".
+var claimEqualityExpressionRe = regexp.MustCompile(`^\s*claims\.([A-Za-z_][A-Za-z0-9_]*)\s*==\s*(?:"([^"\\]*)"|'([^'\\]*)')\s*$`)
+
+// warnContradictoryClaimValidationRules logs a warning if the claim validation rules require the same claim to be equal to different values.
+func warnContradictoryClaimValidationRules(logger *zap.Logger, jwt apiserverv1beta1.JWTAuthenticator, fldPath *field.Path) {
+	type requiredValue struct {
+		value string
+		path  *field.Path
+	}
+
+	required := map[string]requiredValue{}
+
+	for i, rule := range jwt.ClaimValidationRules {
+		claim, value := rule.Claim, rule.RequiredValue
+		rulePath := fldPath.Index(i)
+
+		if rule.Expression != "" {
+			matches := claimEqualityExpressionRe.FindStringSubmatch(rule.Expression)
+			if matches == nil {
+				continue
+			}
+
+			claim, value = matches[1], matches[2]+matches[3]
+		}
+
+		previous, ok := required[claim]
+		if !ok {
+			required[claim] = requiredValue{value: value, path: rulePath}
+
+			continue
+		}
+
+		if previous.value != value {
+			logger.Warn("JWT authenticator claim validation rules contradict each other, all tokens are rejected",
+				zap.String("issuer", jwt.Issuer.URL),
+				zap.String("claim", claim),
+				zap.Strings("fields", []string{previous.path.String(), rulePath.String()}),
+			)
+		}
+	}
+}
+
+// warnUsernamePrefixCollisions logs a warning if two JWT authenticators might map different identities to the same username.
+func warnUsernamePrefixCollisions(logger *zap.Logger, jwts []apiserverv1beta1.JWTAuthenticator, fldPath *field.Path) {
+	type usernamePrefix struct {
+		issuer string
+		claim  string
+		prefix string
+		path   *field.Path
+	}
+
+	var prefixes []usernamePrefix
+
+	for i, jwt := range jwts {
+		username := jwt.ClaimMappings.Username
+		if username.Expression != "" {
+			continue
+		}
+
+		current := usernamePrefix{
+			issuer: jwt.Issuer.URL,
+			claim:  username.Claim,
+			prefix: pointer.SafeDeref(username.Prefix),
+			path:   fldPath.Index(i).Child("claimMappings", "username", "prefix"),
+		}
+
+		for _, previous := range prefixes {
+			if !strings.HasPrefix(previous.prefix, current.prefix) && !strings.HasPrefix(current.prefix, previous.prefix) {
+				continue
+			}
+
+			logger.Warn("JWT authenticators might map different identities to the same username",
+				zap.Strings("issuers", []string{previous.issuer, current.issuer}),
+				zap.Strings("claims", []string{previous.claim, current.claim}),
+				zap.Strings("prefixes", []string{previous.prefix, current.prefix}),
+				zap.Strings("fields", []string{previous.path.String(), current.path.String()}),
+			)
+		}
+
+		prefixes = append(prefixes, current)
+	}
+}
+
+// validateJWTAudiences checks the JWT authenticator audiences, as kube-apiserver accepts no tokens if there are none.
+func validateJWTAudiences(issuer apiserverv1beta1.Issuer, fldPath *field.Path, overrides validationOverrides, logger *zap.Logger) error {
+	if len(issuer.Audiences) == 0 {
+		if err := overrides.check(logger, &fieldPathError{
+			path: fldPath.Child("audiences"),
+			err:  fmt.Errorf("JWT authenticator for issuer %q: at least one audience should be set", issuer.URL),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for i, audience := range issuer.Audiences {
+		var problem string
+
+		switch {
+		case audience == "":
+			problem = "audience should not be empty"
+		case slices.Contains(issuer.Audiences[:i], audience):
+			problem = fmt.Sprintf("duplicate audience %q", audience)
+		}
+
+		if problem != "" {
+			if err := overrides.check(logger, &fieldPathError{
+				path: fldPath.Child("audiences").Index(i),
+				err:  fmt.Errorf("JWT authenticator for issuer %q: %s", issuer.URL, problem),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch issuer.AudienceMatchPolicy {
+	case apiserverv1beta1.AudienceMatchPolicyMatchAny:
+	case "":
+		if len(issuer.Audiences) > 1 {
+			return overrides.check(logger, &fieldPathError{
+				path: fldPath.Child("audienceMatchPolicy"),
+				err:  fmt.Errorf("JWT authenticator for issuer %q: audienceMatchPolicy should be %q with multiple audiences", issuer.URL, apiserverv1beta1.AudienceMatchPolicyMatchAny),
+			})
+		}
+	default:
+		return overrides.check(logger, &fieldPathError{
+			path: fldPath.Child("audienceMatchPolicy"),
+			err:  fmt.Errorf("JWT authenticator for issuer %q: unsupported audienceMatchPolicy %q", issuer.URL, issuer.AudienceMatchPolicy),
+		})
+	}
+
+	return nil
+}
+
+// validateAirGappedJWTIssuer checks that the JWT issuer and discovery URLs only point to the allowed hosts.
+func validateAirGappedJWTIssuer(issuer apiserverv1beta1.Issuer, fldPath *field.Path, allowedHosts []string, overrides validationOverrides, logger *zap.Logger) error {
+	for _, issuerURL := range []struct {
+		field string
+		url   string
+	}{
+		{"url", issuer.URL},
+		{"discoveryURL", pointer.SafeDeref(issuer.DiscoveryURL)},
+	} {
+		if issuerURL.url == "" {
+			continue
+		}
+
+		u, err := url.Parse(issuerURL.url)
+		if err != nil {
+			return &fieldPathError{path: fldPath.Child(issuerURL.field), err: fmt.Errorf("JWT authenticator for issuer %q: %w", issuer.URL, err)}
+		}
+
+		if !hostAllowed(u.Hostname(), allowedHosts) {
+			if err = overrides.check(logger, &fieldPathError{
+				path: fldPath.Child(issuerURL.field),
+				err:  fmt.Errorf("JWT authenticator for issuer %q: host %q is not allowed in the air-gapped cluster", issuer.URL, u.Hostname()),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// hostAllowed checks the host against the allowlist, entries with a leading dot allow all subdomains.
+func hostAllowed(host string, allowedHosts []string) bool {
+	host = strings.ToLower(host)
+
+	return slices.ContainsFunc(allowedHosts, func(allowed string) bool {
+		allowed = strings.ToLower(allowed)
+
+		if domain, ok := strings.CutPrefix(allowed, "."); ok {
+			return strings.HasSuffix(host, allowed) || host == domain
+		}
+
+		return host == allowed
+	})
+}
+
+// secretReferenceRe matches references to the config secrets, e.g. `${secret:oidc/clientSecret}`.
+var secretReferenceRe = regexp.MustCompile(`\$\{secret:([^/}]+)/([^}]+)\}`)
+
+// collectSecretReferences collects IDs of the config secrets referenced in the config values.
+func collectSecretReferences(value any, references map[resource.ID]struct{}) {
+	switch v := value.(type) {
+	case string:
+		for _, match := range secretReferenceRe.FindAllStringSubmatch(v, -1) {
+			references[match[1]] = struct{}{}
+		}
+	case map[string]any:
+		for _, item := range v {
+			collectSecretReferences(item, references)
+		}
+	case []any:
+		for _, item := range v {
+			collectSecretReferences(item, references)
+		}
+	}
+}
+
+// secretResolver resolves references to the config secrets by their IDs.
+type secretResolver map[resource.ID]*secrets.ConfigSecret
+
+// resolveMap returns a copy of the config with the secret references resolved.
+func (resolver secretResolver) resolveMap(config map[string]any, fldPath *field.Path) (map[string]any, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	resolved, err := resolver.resolve(config, fldPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved.(map[string]any), nil //nolint:forcetypeassert
+}
+
+func (resolver secretResolver) resolve(value any, fldPath *field.Path) (any, error) {
+	switch v := value.(type) {
+	case string:
+		var err error
+
+		resolved := secretReferenceRe.ReplaceAllStringFunc(v, func(reference string) string {
+			match := secretReferenceRe.FindStringSubmatch(reference)
+			id, key := match[1], match[2]
+
+			secret, ok := resolver[id]
+			if !ok {
+				if err == nil {
+					err = &fieldPathError{path: fldPath, err: fmt.Errorf("secret reference %q: config secret %q not found", reference, id)}
+				}
+
+				return reference
+			}
+
+			data, ok := secret.TypedSpec().Data[key]
+			if !ok {
+				if err == nil {
+					err = &fieldPathError{path: fldPath, err: fmt.Errorf("secret reference %q: key %q not found in config secret %q", reference, key, id)}
+				}
+
+				return reference
+			}
+
+			return data
+		})
+
+		return resolved, err
+	case map[string]any:
+		resolved := make(map[string]any, len(v))
+
+		for _, k := range slices.Sorted(maps.Keys(v)) {
+			item, err := resolver.resolve(v[k], fldPath.Child(k))
+			if err != nil {
+				return nil, err
+			}
+
+			resolved[k] = item
+		}
+
+		return resolved, nil
+	case []any:
+		resolved := make([]any, len(v))
+
+		for i, item := range v {
+			var err error
+
+			if resolved[i], err = resolver.resolve(item, fldPath.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+
+		return resolved, nil
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,683 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	apiserverv1beta1 "k8s.io/apiserver/pkg/apis/apiserver/v1beta1"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+func TestAuthenticationConfigCertificateAuthorities(t *testing.T) {
+	t.Parallel()
+
+	ca1, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("idp-old"))
+	require.NoError(t, err)
+
+	ca2, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("idp-new"))
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name string
+		cas  []string
+
+		expectedCA    string
+		expectedError string
+	}{
+		{
+			name: "merged",
+			cas:  []string{string(ca1.CrtPEM), string(ca2.CrtPEM)},
+
+			expectedCA: string(ca1.CrtPEM) + string(ca2.CrtPEM),
+		},
+		{
+			name: "invalid",
+			cas:  []string{string(ca1.CrtPEM), "-----BEGIN CERTIFICATE-----\nbm90IGEgY2VydA==\n-----END CERTIFICATE-----\n"},
+
+			expectedError: `error parsing certificate authority 1 for issuer "https://issuer.example.com"`,
+		},
+		{
+			name: "not pem",
+			cas:  []string{"not a certificate"},
+
+			expectedError: `error parsing certificate authority 0 for issuer "https://issuer.example.com": trailing data after PEM-encoded certificates`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.AuthenticationConfigSpec{
+				Config: map[string]any{
+					"jwt": []any{
+						map[string]any{
+							"issuer": map[string]any{
+								"url":       "https://issuer.example.com",
+								"audiences": []any{"talos"},
+							},
+							"claimMappings": map[string]any{
+								"username": map[string]any{
+									"claim":  "email",
+									"prefix": "",
+								},
+							},
+						},
+					},
+				},
+				JWTCertificateAuthorities: []k8s.AuthenticationJWTCertificateAuthoritiesSpec{
+					{
+						IssuerURL:              "https://issuer.example.com",
+						CertificateAuthorities: test.cas,
+					},
+				},
+			}
+
+			obj, err := k8sctrl.AuthenticationConfig(spec, nil, testKubeAPIServerVersion, nil, nil, zap.NewNop())()
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			cfg, ok := obj.(*apiserverv1beta1.AuthenticationConfiguration)
+			require.True(t, ok)
+			require.Len(t, cfg.JWT, 1)
+			assert.Equal(t, test.expectedCA, cfg.JWT[0].Issuer.CertificateAuthority)
+		})
+	}
+}
+
+func TestAuthenticationConfigClaimMappingPrefix(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		groups map[string]any
+
+		expectedError string
+	}{
+		{
+			name: "prefix only",
+			groups: map[string]any{
+				"claim":  "groups",
+				"prefix": "oidc:",
+			},
+		},
+		{
+			name: "expression only",
+			groups: map[string]any{
+				"expression": "claims.groups.map(g, 'oidc:' + g)",
+			},
+		},
+		{
+			name: "conflicting",
+			groups: map[string]any{
+				"expression": "claims.groups",
+				"prefix":     "oidc:",
+			},
+
+			expectedError: `jwt[0].claimMappings.groups.prefix: JWT authenticator for issuer "https://issuer.example.com": prefix can't be set together with expression`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.AuthenticationConfigSpec{
+				Config: map[string]any{
+					"jwt": []any{
+						map[string]any{
+							"issuer": map[string]any{
+								"url":       "https://issuer.example.com",
+								"audiences": []any{"talos"},
+							},
+							"claimMappings": map[string]any{
+								"username": map[string]any{
+									"claim":  "email",
+									"prefix": "oidc:",
+								},
+								"groups": test.groups,
+							},
+						},
+					},
+				},
+			}
+
+			_, err := k8sctrl.AuthenticationConfig(spec, nil, testKubeAPIServerVersion, nil, nil, zap.NewNop())()
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthenticationConfigSecretReferences(t *testing.T) {
+	t.Parallel()
+
+	secret := secrets.NewConfigSecret("oidc")
+	secret.TypedSpec().Data = map[string]string{
+		"issuer": "issuer.example.com",
+	}
+
+	resolver := k8sctrl.SecretResolver{"oidc": secret}
+
+	for _, test := range []struct {
+		name string
+		url  string
+
+		expectedURL   string
+		expectedError string
+	}{
+		{
+			name: "resolved",
+			url:  "https://${secret:oidc/issuer}/oidc",
+
+			expectedURL: "https://issuer.example.com/oidc",
+		},
+		{
+			name: "dangling secret",
+			url:  "https://${secret:github/issuer}",
+
+			expectedError: `jwt[0].issuer.url: secret reference "${secret:github/issuer}": config secret "github" not found`,
+		},
+		{
+			name: "dangling key",
+			url:  "https://${secret:oidc/url}",
+
+			expectedError: `jwt[0].issuer.url: secret reference "${secret:oidc/url}": key "url" not found in config secret "oidc"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.AuthenticationConfigSpec{
+				Config: map[string]any{
+					"jwt": []any{
+						map[string]any{
+							"issuer": map[string]any{
+								"url":       test.url,
+								"audiences": []any{"talos"},
+							},
+							"claimMappings": map[string]any{
+								"username": map[string]any{
+									"claim":  "email",
+									"prefix": "",
+								},
+							},
+						},
+					},
+				},
+			}
+
+			obj, err := k8sctrl.AuthenticationConfig(spec, nil, testKubeAPIServerVersion, resolver, nil, zap.NewNop())()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			cfg, ok := obj.(*apiserverv1beta1.AuthenticationConfiguration)
+			require.True(t, ok)
+			assert.Equal(t, test.expectedURL, cfg.JWT[0].Issuer.URL)
+
+			// the config resource is not modified
+			assert.Equal(t, test.url, spec.Config["jwt"].([]any)[0].(map[string]any)["issuer"].(map[string]any)["url"]) //nolint:forcetypeassert
+		})
+	}
+}
+
+func TestAuthenticationConfigAudiences(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		issuer map[string]any
+
+		expectedError string
+	}{
+		{
+			name: "single audience",
+			issuer: map[string]any{
+				"audiences": []any{"talos"},
+			},
+		},
+		{
+			name: "multiple audiences",
+			issuer: map[string]any{
+				"audiences":           []any{"talos", "kubernetes"},
+				"audienceMatchPolicy": "MatchAny",
+			},
+		},
+		{
+			name:   "no audiences",
+			issuer: map[string]any{},
+
+			expectedError: `jwt[0].issuer.audiences: JWT authenticator for issuer "https://issuer.example.com": at least one audience should be set`,
+		},
+		{
+			name: "empty audiences",
+			issuer: map[string]any{
+				"audiences": []any{},
+			},
+
+			expectedError: `jwt[0].issuer.audiences: JWT authenticator for issuer "https://issuer.example.com": at least one audience should be set`,
+		},
+		{
+			name: "empty audience",
+			issuer: map[string]any{
+				"audiences":           []any{"talos", ""},
+				"audienceMatchPolicy": "MatchAny",
+			},
+
+			expectedError: `jwt[0].issuer.audiences[1]: JWT authenticator for issuer "https://issuer.example.com": audience should not be empty`,
+		},
+		{
+			name: "duplicate audience",
+			issuer: map[string]any{
+				"audiences":           []any{"talos", "talos"},
+				"audienceMatchPolicy": "MatchAny",
+			},
+
+			expectedError: `jwt[0].issuer.audiences[1]: JWT authenticator for issuer "https://issuer.example.com": duplicate audience "talos"`,
+		},
+		{
+			name: "multiple audiences without policy",
+			issuer: map[string]any{
+				"audiences": []any{"talos", "kubernetes"},
+			},
+
+			expectedError: `jwt[0].issuer.audienceMatchPolicy: JWT authenticator for issuer "https://issuer.example.com": audienceMatchPolicy should be "MatchAny" with multiple audiences`,
+		},
+		{
+			name: "unknown policy",
+			issuer: map[string]any{
+				"audiences":           []any{"talos"},
+				"audienceMatchPolicy": "MatchAll",
+			},
+
+			expectedError: `jwt[0].issuer.audienceMatchPolicy: JWT authenticator for issuer "https://issuer.example.com": unsupported audienceMatchPolicy "MatchAll"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			test.issuer["url"] = "https://issuer.example.com"
+
+			spec := &k8s.AuthenticationConfigSpec{
+				Config: map[string]any{
+					"jwt": []any{
+						map[string]any{
+							"issuer": test.issuer,
+							"claimMappings": map[string]any{
+								"username": map[string]any{
+									"claim":  "email",
+									"prefix": "",
+								},
+							},
+						},
+					},
+				},
+			}
+
+			_, err := k8sctrl.AuthenticationConfig(spec, nil, testKubeAPIServerVersion, nil, nil, zap.NewNop())()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthenticationConfigAirGapped(t *testing.T) {
+	t.Parallel()
+
+	airGapped := &k8s.AirGappedConfigSpec{
+		AllowedHosts: []string{"issuer.example.com", ".corp.internal"},
+	}
+
+	for _, test := range []struct {
+		name   string
+		issuer map[string]any
+
+		expectedError string
+	}{
+		{
+			name: "allowed host",
+			issuer: map[string]any{
+				"url": "https://issuer.example.com",
+			},
+		},
+		{
+			name: "allowed subdomain",
+			issuer: map[string]any{
+				"url":          "https://dex.corp.internal",
+				"discoveryURL": "https://dex.auth.corp.internal/.well-known/openid-configuration",
+			},
+		},
+		{
+			name: "external issuer",
+			issuer: map[string]any{
+				"url": "https://accounts.google.com",
+			},
+
+			expectedError: `jwt[0].issuer.url: JWT authenticator for issuer "https://accounts.google.com": host "accounts.google.com" is not allowed in the air-gapped cluster`,
+		},
+		{
+			name: "external discovery URL",
+			issuer: map[string]any{
+				"url":          "https://issuer.example.com",
+				"discoveryURL": "https://discovery.example.org/.well-known/openid-configuration",
+			},
+
+			expectedError: `jwt[0].issuer.discoveryURL: JWT authenticator for issuer "https://issuer.example.com": host "discovery.example.org" is not allowed in the air-gapped cluster`,
+		},
+		{
+			name: "suffix without dot",
+			issuer: map[string]any{
+				"url": "https://evilcorp.internal",
+			},
+
+			expectedError: `jwt[0].issuer.url: JWT authenticator for issuer "https://evilcorp.internal": host "evilcorp.internal" is not allowed in the air-gapped cluster`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			test.issuer["audiences"] = []any{"talos"}
+
+			spec := &k8s.AuthenticationConfigSpec{
+				Config: map[string]any{
+					"jwt": []any{
+						map[string]any{
+							"issuer": test.issuer,
+							"claimMappings": map[string]any{
+								"username": map[string]any{
+									"claim":  "email",
+									"prefix": "",
+								},
+							},
+						},
+					},
+				},
+			}
+
+			_, err := k8sctrl.AuthenticationConfig(spec, nil, testKubeAPIServerVersion, nil, airGapped, zap.NewNop())()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthenticationConfigContradictoryClaimValidationRules(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name  string
+		rules []any
+
+		expectedWarnings [][]string
+	}{
+		{
+			name: "complementary",
+			rules: []any{
+				map[string]any{"claim": "hd", "requiredValue": "example.com"},
+				map[string]any{"claim": "email_verified", "requiredValue": "true"},
+				map[string]any{"expression": `claims.hd == "example.com"`},
+			},
+		},
+		{
+			name: "contradictory required values",
+			rules: []any{
+				map[string]any{"claim": "hd", "requiredValue": "example.com"},
+				map[string]any{"claim": "hd", "requiredValue": "example.org"},
+			},
+
+			expectedWarnings: [][]string{
+				{"jwt[0].claimValidationRules[0]", "jwt[0].claimValidationRules[1]"},
+			},
+		},
+		{
+			name: "contradictory expression",
+			rules: []any{
+				map[string]any{"claim": "hd", "requiredValue": "example.com"},
+				map[string]any{"expression": `claims.email_verified == true`},
+				map[string]any{"expression": `claims.hd == 'example.org'`},
+			},
+
+			expectedWarnings: [][]string{
+				{"jwt[0].claimValidationRules[0]", "jwt[0].claimValidationRules[2]"},
+			},
+		},
+		{
+			name: "complex expression",
+			rules: []any{
+				map[string]any{"claim": "hd", "requiredValue": "example.com"},
+				map[string]any{"expression": `claims.hd == "example.org" || claims.hd == "example.com"`},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.AuthenticationConfigSpec{
+				Config: map[string]any{
+					"jwt": []any{
+						map[string]any{
+							"issuer": map[string]any{
+								"url":       "https://issuer.example.com",
+								"audiences": []any{"talos"},
+							},
+							"claimValidationRules": test.rules,
+							"claimMappings": map[string]any{
+								"username": map[string]any{
+									"claim":  "email",
+									"prefix": "",
+								},
+							},
+						},
+					},
+				},
+			}
+
+			core, logs := observer.New(zapcore.WarnLevel)
+
+			_, err := k8sctrl.AuthenticationConfig(spec, nil, testKubeAPIServerVersion, nil, nil, zap.New(core))()
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedWarnings, xslices.Map(logs.All(), func(entry observer.LoggedEntry) []string {
+				return xslices.Map(entry.ContextMap()["fields"].([]any), func(field any) string { //nolint:forcetypeassert
+					return field.(string) //nolint:forcetypeassert
+				})
+			}))
+		})
+	}
+}
+
+func TestAuthenticationConfigUsernamePrefixCollisions(t *testing.T) {
+	t.Parallel()
+
+	jwt := func(issuer string, username map[string]any) map[string]any {
+		return map[string]any{
+			"issuer": map[string]any{
+				"url":       issuer,
+				"audiences": []any{"talos"},
+			},
+			"claimMappings": map[string]any{
+				"username": username,
+			},
+		}
+	}
+
+	for _, test := range []struct {
+		name      string
+		usernames []map[string]any
+
+		expectedPrefixes [][]string
+	}{
+		{
+			name: "distinct prefixes",
+			usernames: []map[string]any{
+				{"claim": "email", "prefix": "corp:"},
+				{"claim": "email", "prefix": "partner:"},
+				{"expression": "'ci:' + claims.sub"},
+			},
+		},
+		{
+			name: "same prefix",
+			usernames: []map[string]any{
+				{"claim": "email", "prefix": "oidc:"},
+				{"claim": "sub", "prefix": "oidc:"},
+			},
+
+			expectedPrefixes: [][]string{{"oidc:", "oidc:"}},
+		},
+		{
+			name: "nested prefix",
+			usernames: []map[string]any{
+				{"claim": "email", "prefix": "oidc:"},
+				{"claim": "email", "prefix": "partner:"},
+				{"claim": "email", "prefix": "oidc:corp:"},
+			},
+
+			expectedPrefixes: [][]string{{"oidc:", "oidc:corp:"}},
+		},
+		{
+			name: "no prefix",
+			usernames: []map[string]any{
+				{"claim": "email", "prefix": ""},
+				{"claim": "email", "prefix": "partner:"},
+			},
+
+			expectedPrefixes: [][]string{{"", "partner:"}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			jwts := make([]any, 0, len(test.usernames))
+
+			for i, username := range test.usernames {
+				jwts = append(jwts, jwt(fmt.Sprintf("https://issuer%d.example.com", i), username))
+			}
+
+			core, logs := observer.New(zapcore.WarnLevel)
+
+			_, err := k8sctrl.AuthenticationConfig(&k8s.AuthenticationConfigSpec{
+				Config: map[string]any{
+					"jwt": jwts,
+				},
+			}, nil, testKubeAPIServerVersion, nil, nil, zap.New(core))()
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedPrefixes, xslices.Map(logs.All(), func(entry observer.LoggedEntry) []string {
+				return xslices.Map(entry.ContextMap()["prefixes"].([]any), func(prefix any) string { //nolint:forcetypeassert
+					return prefix.(string) //nolint:forcetypeassert
+				})
+			}))
+		})
+	}
+}
+
+func TestAuthenticationConfigNoTokenAuthentication(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		config map[string]any
+
+		expectedWarning bool
+	}{
+		{
+			name: "no authenticators",
+			config: map[string]any{
+				"jwt": []any{},
+			},
+
+			expectedWarning: true,
+		},
+		{
+			name: "anonymous disabled",
+			config: map[string]any{
+				"anonymous": map[string]any{
+					"enabled": false,
+				},
+			},
+
+			expectedWarning: true,
+		},
+		{
+			name: "anonymous enabled",
+			config: map[string]any{
+				"anonymous": map[string]any{
+					"enabled": true,
+					"conditions": []any{
+						map[string]any{"path": "/livez"},
+					},
+				},
+			},
+		},
+		{
+			name: "authenticators",
+			config: map[string]any{
+				"jwt": []any{
+					map[string]any{
+						"issuer": map[string]any{
+							"url":       "https://issuer.example.com",
+							"audiences": []any{"talos"},
+						},
+						"claimMappings": map[string]any{
+							"username": map[string]any{"claim": "email", "prefix": ""},
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			core, logs := observer.New(zapcore.WarnLevel)
+
+			_, err := k8sctrl.AuthenticationConfig(&k8s.AuthenticationConfigSpec{
+				Config: test.config,
+			}, nil, testKubeAPIServerVersion, nil, nil, zap.New(core))()
+			require.NoError(t, err)
+
+			if test.expectedWarning {
+				require.Equal(t, 1, logs.Len())
+				assert.Contains(t, logs.All()[0].Message, "defines no JWT authenticators")
+				assert.Equal(t, "jwt", logs.All()[0].ContextMap()["field"])
+			} else {
+				assert.Zero(t, logs.Len())
+			}
+		})
+	}
+}
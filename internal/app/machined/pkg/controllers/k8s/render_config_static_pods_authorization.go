@@ -0,0 +1,387 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	apiserverv1beta1 "k8s.io/apiserver/pkg/apis/apiserver/v1beta1"
+	authorizationcel "k8s.io/apiserver/pkg/authorization/cel"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+var (
+	// matchConditionUserAttributeRe matches references to the optional user attributes in authorizer webhook match conditions.
+	matchConditionUserAttributeRe = regexp.MustCompile(`\brequest\.(groups|uid|extra)\b`)
+	// matchConditionUsernamePrefixRe matches username prefix checks in authorizer webhook match conditions.
+	matchConditionUsernamePrefixRe = regexp.MustCompile(`\brequest\.user\.startsWith\(\s*['"]([^'"]*)['"]\s*\)`)
+)
+
+// warnAuthConfigMismatches logs a warning if authorizer webhook match conditions reference user attributes
+// which are not mapped by the JWT authenticators, or username prefixes which JWT authenticators never produce.
+//
+//nolint:gocyclo,cyclop
+func warnAuthConfigMismatches(logger *zap.Logger, authn *k8s.AuthenticationConfigSpec, authz *k8s.AuthorizationConfigSpec) {
+	var authnCfg apiserverv1beta1.AuthenticationConfiguration
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(normalizeIntegers(authn.Config), &authnCfg); err != nil || len(authnCfg.JWT) == 0 {
+		return
+	}
+
+	mappedAttributes := map[string]bool{}
+
+	var (
+		usernamePrefixes   []string
+		usernameExpression bool
+	)
+
+	for _, jwt := range authnCfg.JWT {
+		mappings := jwt.ClaimMappings
+
+		if mappings.Groups.Claim != "" || mappings.Groups.Expression != "" {
+			mappedAttributes["groups"] = true
+		}
+
+		if mappings.UID.Claim != "" || mappings.UID.Expression != "" {
+			mappedAttributes["uid"] = true
+		}
+
+		if len(mappings.Extra) > 0 {
+			mappedAttributes["extra"] = true
+		}
+
+		switch {
+		case mappings.Username.Expression != "":
+			// the username might be anything, so username prefixes can't be checked
+			usernameExpression = true
+		case mappings.Username.Prefix != nil:
+			usernamePrefixes = append(usernamePrefixes, *mappings.Username.Prefix)
+		}
+	}
+
+	for _, authorizer := range authz.Config {
+		if authorizer.Webhook == nil {
+			continue
+		}
+
+		var webhookCfg apiserverv1.WebhookConfiguration
+
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(normalizeIntegers(authorizer.Webhook), &webhookCfg); err != nil {
+			continue
+		}
+
+		for _, matchCondition := range webhookCfg.MatchConditions {
+			for _, match := range matchConditionUserAttributeRe.FindAllStringSubmatch(matchCondition.Expression, -1) {
+				if !mappedAttributes[match[1]] {
+					logger.Warn("authorizer match condition references user attribute not mapped by JWT authenticators",
+						zap.String("authorizer", authorizer.Name),
+						zap.String("expression", matchCondition.Expression),
+						zap.String("attribute", "request."+match[1]),
+					)
+				}
+			}
+
+			if usernameExpression {
+				continue
+			}
+
+			for _, match := range matchConditionUsernamePrefixRe.FindAllStringSubmatch(matchCondition.Expression, -1) {
+				prefix := match[1]
+
+				// built-in users are not produced by JWT authenticators
+				if strings.HasPrefix(prefix, "system:") {
+					continue
+				}
+
+				if !slices.ContainsFunc(usernamePrefixes, func(usernamePrefix string) bool {
+					return strings.HasPrefix(prefix, usernamePrefix) || strings.HasPrefix(usernamePrefix, prefix)
+				}) {
+					logger.Warn("authorizer match condition username prefix doesn't match JWT authenticators username prefixes",
+						zap.String("authorizer", authorizer.Name),
+						zap.String("expression", matchCondition.Expression),
+						zap.String("prefix", prefix),
+						zap.Strings("username_prefixes", usernamePrefixes),
+					)
+				}
+			}
+		}
+	}
+}
+
+// matchConditionCompiler compiles webhook authorizer match conditions the same way kube-apiserver does.
+var matchConditionCompiler = sync.OnceValue(authorizationcel.NewDefaultCompiler)
+
+// authorizationConfig renders the structured authorization config.
+func authorizationConfig(
+	spec *k8s.AuthorizationConfigSpec, fldPath *field.Path, kubeAPIServerVersion compatibility.Version, resolver secretResolver, logger *zap.Logger,
+) func() (runtime.Object, error) {
+	return func() (runtime.Object, error) {
+		var cfg apiserverv1.AuthorizationConfiguration
+
+		apiVersion, err := configAPIVersion("AuthorizationConfiguration", kubeAPIServerVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.APIVersion = apiVersion
+		cfg.Kind = "AuthorizationConfiguration"
+		cfg.Authorizers = []apiserverv1.AuthorizerConfiguration{}
+
+		// indexes of the authorizers by name, kube-apiserver refuses to start with the duplicate names
+		names := map[string]int{}
+
+		overrides := validationOverrides(spec.ValidationOverrides)
+
+		for i, authorizer := range spec.Config {
+			webhookPath := fldPath.Index(i).Child("webhook")
+
+			if previous, duplicate := names[authorizer.Name]; duplicate && authorizer.Name != "" {
+				if err := overrides.check(logger, &fieldPathError{
+					path: fldPath.Index(i).Child("name"),
+					err:  fmt.Errorf("authorizer name %q is not unique, it's already used by authorizer %d", authorizer.Name, previous),
+				}); err != nil {
+					return nil, err
+				}
+			} else {
+				names[authorizer.Name] = i
+			}
+
+			authorizerConfig := apiserverv1.AuthorizerConfiguration{
+				Name: authorizer.Name,
+				Type: authorizer.Type,
+			}
+
+			// Node and RBAC authorizers don't take any configuration, webhook fields would be silently ignored
+			if authorizer.Type != string(apiserverv1.TypeWebhook) {
+				if len(authorizer.Webhook) > 0 {
+					if err := overrides.check(logger, &fieldPathError{
+						path: webhookPath,
+						err: fmt.Errorf("authorizer %q of type %q doesn't take webhook configuration, got fields: %s",
+							authorizer.Name, authorizer.Type, strings.Join(slices.Sorted(maps.Keys(authorizer.Webhook)), ", ")),
+					}); err != nil {
+						return nil, err
+					}
+				}
+			} else if authorizer.Webhook != nil {
+				var webhookCfg apiserverv1.WebhookConfiguration
+
+				webhook, err := resolver.resolveMap(authorizer.Webhook, webhookPath)
+				if err != nil {
+					return nil, err
+				}
+
+				if err = runtime.DefaultUnstructuredConverter.FromUnstructured(normalizeIntegers(webhook), &webhookCfg); err != nil {
+					return nil, &fieldPathError{path: webhookPath, err: fmt.Errorf("error unmarshaling authorizer webhook configuration: %w", err)}
+				}
+
+				for j, matchCondition := range webhookCfg.MatchConditions {
+					if _, err := matchConditionCompiler().CompileCELExpression(&authorizationcel.SubjectAccessReviewMatchCondition{
+						Expression: matchCondition.Expression,
+					}); err != nil {
+						return nil, &fieldPathError{
+							path: webhookPath.Child("matchConditions").Index(j).Child("expression"),
+							err:  fmt.Errorf("error compiling match condition %q for authorizer %q: %w", matchCondition.Expression, authorizer.Name, err),
+						}
+					}
+				}
+
+				if err = overrides.check(logger, validateWebhookTimeout(&webhookCfg, webhookPath, authorizer.Name, logger)); err != nil {
+					return nil, err
+				}
+
+				if err = overrides.check(logger, validateWebhookFailurePolicy(&webhookCfg, webhookPath, authorizer.Name, logger)); err != nil {
+					return nil, err
+				}
+
+				if err = validateWebhookCacheTTLs(&webhookCfg, webhookPath, authorizer.Name, overrides, logger); err != nil {
+					return nil, err
+				}
+
+				// match conditions are sorted after they are validated, so that the errors point to the configured ones
+				slices.SortStableFunc(webhookCfg.MatchConditions, func(a, b apiserverv1.WebhookMatchCondition) int {
+					return cmp.Compare(a.Expression, b.Expression)
+				})
+
+				authorizerConfig.Webhook = &webhookCfg
+			}
+
+			cfg.Authorizers = append(cfg.Authorizers, authorizerConfig)
+		}
+
+		if err := overrides.check(logger, validateNodeAuthorizerOrder(&cfg, fldPath)); err != nil {
+			return nil, err
+		}
+
+		warnAuthorizationFallthrough(logger, &cfg)
+
+		return &cfg, nil
+	}
+}
+
+// Authorizer webhook timeout bounds.
+const (
+	// minWebhookTimeout and maxWebhookTimeout are the bounds enforced by kube-apiserver.
+	minWebhookTimeout = time.Second
+	maxWebhookTimeout = 30 * time.Second
+
+	// recommendedMinWebhookTimeout and recommendedMaxWebhookTimeout are the bounds outside of which a warning is logged.
+	recommendedMinWebhookTimeout = 2 * time.Second
+	recommendedMaxWebhookTimeout = 10 * time.Second
+)
+
+// validateWebhookTimeout checks that the authorizer webhook timeout is within the bounds accepted by kube-apiserver.
+func validateWebhookTimeout(cfg *apiserverv1.WebhookConfiguration, fldPath *field.Path, authorizerName string, logger *zap.Logger) error {
+	timeout := cfg.Timeout.Duration
+
+	switch {
+	case timeout <= 0:
+		return &fieldPathError{
+			path: fldPath.Child("timeout"),
+			err:  fmt.Errorf("authorizer %q timeout should be positive, got %s", authorizerName, timeout),
+		}
+	case timeout < minWebhookTimeout || timeout > maxWebhookTimeout:
+		return &fieldPathError{
+			path: fldPath.Child("timeout"),
+			err:  fmt.Errorf("authorizer %q timeout should be between %s and %s, got %s", authorizerName, minWebhookTimeout, maxWebhookTimeout, timeout),
+		}
+	case timeout < recommendedMinWebhookTimeout || timeout > recommendedMaxWebhookTimeout:
+		logger.Warn("authorizer webhook timeout is outside of the recommended range",
+			zap.String("authorizer", authorizerName),
+			zap.String("field", fldPath.Child("timeout").String()),
+			zap.Duration("timeout", timeout),
+			zap.Duration("recommended_min", recommendedMinWebhookTimeout),
+			zap.Duration("recommended_max", recommendedMaxWebhookTimeout),
+		)
+	}
+
+	return nil
+}
+
+// Authorizer webhook timeouts which make the failure policy risky.
+const (
+	riskyDenyWebhookTimeout      = 5 * time.Second
+	riskyNoOpinionWebhookTimeout = recommendedMinWebhookTimeout
+)
+
+// validateWebhookFailurePolicy checks that the authorizer webhook failure policy is one of the values accepted by kube-apiserver.
+func validateWebhookFailurePolicy(cfg *apiserverv1.WebhookConfiguration, fldPath *field.Path, authorizerName string, logger *zap.Logger) error {
+	timeout := cfg.Timeout.Duration
+
+	var risk string
+
+	switch cfg.FailurePolicy {
+	case apiserverv1.FailurePolicyDeny:
+		if timeout > riskyDenyWebhookTimeout {
+			risk = fmt.Sprintf("requests stall for up to %s and are denied while the webhook is unavailable, which might lock out the cluster", timeout)
+		}
+	case apiserverv1.FailurePolicyNoOpinion:
+		if timeout < riskyNoOpinionWebhookTimeout {
+			risk = fmt.Sprintf("requests fall through to the next authorizer if the webhook doesn't respond within %s, bypassing the webhook", timeout)
+		}
+	default:
+		return &fieldPathError{
+			path: fldPath.Child("failurePolicy"),
+			err: fmt.Errorf("authorizer %q failure policy should be one of %q, got %q",
+				authorizerName, []string{apiserverv1.FailurePolicyNoOpinion, apiserverv1.FailurePolicyDeny}, cfg.FailurePolicy),
+		}
+	}
+
+	if risk != "" {
+		logger.Warn("authorizer webhook failure policy and timeout combination is risky",
+			zap.String("authorizer", authorizerName),
+			zap.String("field", fldPath.Child("failurePolicy").String()),
+			zap.String("failure_policy", cfg.FailurePolicy),
+			zap.Duration("timeout", timeout),
+			zap.String("risk", risk),
+		)
+	}
+
+	return nil
+}
+
+// lowWebhookCacheTTL is the cache TTL below which almost every request hits the webhook.
+const lowWebhookCacheTTL = time.Second
+
+// validateWebhookCacheTTLs checks the authorizer webhook cache TTLs.
+func validateWebhookCacheTTLs(cfg *apiserverv1.WebhookConfiguration, fldPath *field.Path, authorizerName string, overrides validationOverrides, logger *zap.Logger) error {
+	for _, ttl := range []struct {
+		field string
+		value time.Duration
+	}{
+		{"authorizedTTL", cfg.AuthorizedTTL.Duration},
+		{"unauthorizedTTL", cfg.UnauthorizedTTL.Duration},
+	} {
+		switch {
+		case ttl.value < 0:
+			if err := overrides.check(logger, &fieldPathError{
+				path: fldPath.Child(ttl.field),
+				err:  fmt.Errorf("authorizer %q cache TTL should be positive, got %s", authorizerName, ttl.value),
+			}); err != nil {
+				return err
+			}
+		case ttl.value > 0 && ttl.value < lowWebhookCacheTTL:
+			logger.Warn("authorizer webhook cache TTL is very low, the webhook is called on almost every request",
+				zap.String("authorizer", authorizerName),
+				zap.String("field", fldPath.Child(ttl.field).String()),
+				zap.Duration("ttl", ttl.value),
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateNodeAuthorizerOrder checks that the Node authorizer precedes the webhooks with the Deny failure policy.
+func validateNodeAuthorizerOrder(cfg *apiserverv1.AuthorizationConfiguration, fldPath *field.Path) error {
+	nodeIdx := slices.IndexFunc(cfg.Authorizers, func(authorizer apiserverv1.AuthorizerConfiguration) bool {
+		return authorizer.Type == "Node"
+	})
+	if nodeIdx == -1 {
+		return nil
+	}
+
+	for i, authorizer := range cfg.Authorizers[:nodeIdx] {
+		if authorizer.Type != string(apiserverv1.TypeWebhook) || authorizer.Webhook == nil || authorizer.Webhook.FailurePolicy != apiserverv1.FailurePolicyDeny {
+			continue
+		}
+
+		return &fieldPathError{
+			path: fldPath.Index(i),
+			err: fmt.Errorf("authorizer %q with failure policy %q should follow the Node authorizer %q, as kubelet requests are denied while the webhook is unavailable",
+				authorizer.Name, authorizer.Webhook.FailurePolicy, cfg.Authorizers[nodeIdx].Name),
+		}
+	}
+
+	return nil
+}
+
+// warnAuthorizationFallthrough logs a warning if the authorization config has no RBAC authorizer.
+func warnAuthorizationFallthrough(logger *zap.Logger, cfg *apiserverv1.AuthorizationConfiguration) {
+	if slices.ContainsFunc(cfg.Authorizers, func(authorizer apiserverv1.AuthorizerConfiguration) bool {
+		return authorizer.Type == "RBAC"
+	}) {
+		return
+	}
+
+	logger.Warn("authorization config has no RBAC authorizer, requests not handled by the authorizers are denied",
+		zap.Strings("authorizers", xslices.Map(cfg.Authorizers, func(authorizer apiserverv1.AuthorizerConfiguration) string {
+			return authorizer.Name
+		})),
+	)
+}
@@ -0,0 +1,798 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+func TestAuthorizationConfigNodeAuthorizer(t *testing.T) {
+	t.Parallel()
+
+	kubeAPIServerVersion := compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:v1.33.0")
+
+	for _, test := range []struct {
+		name    string
+		webhook map[string]any
+
+		expectedError string
+	}{
+		{
+			name: "clean",
+		},
+		{
+			name:    "empty webhook",
+			webhook: map[string]any{},
+		},
+		{
+			name: "webhook fields",
+			webhook: map[string]any{
+				"timeout": "3s",
+				"connectionInfo": map[string]any{
+					"type": "InClusterConfig",
+				},
+			},
+
+			expectedError: `cluster.apiServer.authorizationConfig[0].webhook: authorizer "node" of type "Node" doesn't take webhook configuration, got fields: connectionInfo, timeout`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.AuthorizationConfigSpec{
+				Config: []k8s.AuthorizationAuthorizersSpec{
+					{
+						Type:    "Node",
+						Name:    "node",
+						Webhook: test.webhook,
+					},
+					{
+						Type: "RBAC",
+						Name: "rbac",
+					},
+				},
+			}
+
+			obj, err := k8sctrl.AuthorizationConfig(spec, k8sctrl.AuthorizationFieldPath, kubeAPIServerVersion, nil, zap.NewNop())()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			cfg, ok := obj.(*apiserverv1.AuthorizationConfiguration)
+			require.True(t, ok)
+			require.Len(t, cfg.Authorizers, 2)
+			assert.Nil(t, cfg.Authorizers[0].Webhook)
+		})
+	}
+}
+
+func TestAuthorizationConfigNodeAuthorizerOrder(t *testing.T) {
+	t.Parallel()
+
+	kubeAPIServerVersion := compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:v1.33.0")
+
+	webhook := func(name, failurePolicy string) k8s.AuthorizationAuthorizersSpec {
+		return k8s.AuthorizationAuthorizersSpec{
+			Type: "Webhook",
+			Name: name,
+			Webhook: map[string]any{
+				"timeout":                    "3s",
+				"failurePolicy":              failurePolicy,
+				"subjectAccessReviewVersion": "v1",
+				"matchConditionSubjectAccessReviewVersion": "v1",
+				"connectionInfo": map[string]any{
+					"type": "InClusterConfig",
+				},
+			},
+		}
+	}
+
+	node := k8s.AuthorizationAuthorizersSpec{Type: "Node", Name: "node"}
+	rbac := k8s.AuthorizationAuthorizersSpec{Type: "RBAC", Name: "rbac"}
+
+	for _, test := range []struct {
+		name        string
+		authorizers []k8s.AuthorizationAuthorizersSpec
+		overrides   []string
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name:        "node first",
+			authorizers: []k8s.AuthorizationAuthorizersSpec{node, webhook("webhook", "Deny"), rbac},
+		},
+		{
+			name:        "no opinion webhook first",
+			authorizers: []k8s.AuthorizationAuthorizersSpec{webhook("webhook", "NoOpinion"), node, rbac},
+		},
+		{
+			name:        "no node authorizer",
+			authorizers: []k8s.AuthorizationAuthorizersSpec{webhook("webhook", "Deny"), rbac},
+		},
+		{
+			name:        "deny webhook first",
+			authorizers: []k8s.AuthorizationAuthorizersSpec{webhook("audit", "NoOpinion"), webhook("webhook", "Deny"), node, rbac},
+
+			expectedError: `cluster.apiServer.authorizationConfig[1]: authorizer "webhook" with failure policy "Deny" should follow the Node authorizer "node", ` +
+				`as kubelet requests are denied while the webhook is unavailable`,
+		},
+		{
+			name:        "deny webhook first overridden",
+			authorizers: []k8s.AuthorizationAuthorizersSpec{webhook("webhook", "Deny"), node, rbac},
+			overrides:   []string{"cluster.apiServer.authorizationConfig[0]"},
+
+			expectedWarnings: []string{"config validation error is overridden"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.AuthorizationConfigSpec{
+				Config:              test.authorizers,
+				ValidationOverrides: test.overrides,
+			}
+
+			core, logs := observer.New(zapcore.WarnLevel)
+
+			_, err := k8sctrl.AuthorizationConfig(spec, k8sctrl.AuthorizationFieldPath, kubeAPIServerVersion, nil, zap.New(core))()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedWarnings, xslices.Map(logs.All(), func(entry observer.LoggedEntry) string {
+				return entry.Message
+			}))
+		})
+	}
+}
+
+func TestAuthorizationConfigAuthorizerNames(t *testing.T) {
+	t.Parallel()
+
+	kubeAPIServerVersion := compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:v1.33.0")
+
+	for _, test := range []struct {
+		name  string
+		names []string
+
+		expectedError string
+	}{
+		{
+			name:  "unique",
+			names: []string{"node", "rbac", "webhook"},
+		},
+		{
+			name:  "duplicate",
+			names: []string{"node", "rbac", "node"},
+
+			expectedError: `cluster.apiServer.authorizationConfig[2].name: authorizer name "node" is not unique, it's already used by authorizer 0`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.AuthorizationConfigSpec{
+				Config: []k8s.AuthorizationAuthorizersSpec{
+					{
+						Type: "Node",
+						Name: test.names[0],
+					},
+					{
+						Type: "RBAC",
+						Name: test.names[1],
+					},
+					{
+						Type: "RBAC",
+						Name: test.names[2],
+					},
+				},
+			}
+
+			_, err := k8sctrl.AuthorizationConfig(spec, k8sctrl.AuthorizationFieldPath, kubeAPIServerVersion, nil, zap.NewNop())()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthorizationConfigStableOrder(t *testing.T) {
+	t.Parallel()
+
+	kubeAPIServerVersion := compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:v1.33.0")
+
+	render := func(matchConditions ...string) []byte {
+		spec := &k8s.AuthorizationConfigSpec{
+			Config: []k8s.AuthorizationAuthorizersSpec{
+				{
+					Type: "Node",
+					Name: "node",
+				},
+				{
+					Type: "Webhook",
+					Name: "webhook",
+					Webhook: map[string]any{
+						"timeout":                    "3s",
+						"subjectAccessReviewVersion": "v1",
+						"matchConditionSubjectAccessReviewVersion": "v1",
+						"failurePolicy": "NoOpinion",
+						"connectionInfo": map[string]any{
+							"type": "InClusterConfig",
+						},
+						"matchConditions": xslices.Map(matchConditions, func(expression string) any {
+							return map[string]any{"expression": expression}
+						}),
+					},
+				},
+				{
+					Type: "RBAC",
+					Name: "rbac",
+				},
+			},
+		}
+
+		obj, err := k8sctrl.AuthorizationConfig(spec, k8sctrl.AuthorizationFieldPath, kubeAPIServerVersion, nil, zap.NewNop())()
+		require.NoError(t, err)
+
+		contents, err := k8sctrl.EncodeConfig(obj)
+		require.NoError(t, err)
+
+		return contents
+	}
+
+	expected := render(
+		"has(request.resourceAttributes)",
+		"request.resourceAttributes.namespace == 'kube-system'",
+		"request.user != 'system:anonymous'",
+	)
+
+	assert.Equal(t, string(expected), string(render(
+		"request.user != 'system:anonymous'",
+		"has(request.resourceAttributes)",
+		"request.resourceAttributes.namespace == 'kube-system'",
+	)))
+
+	assert.Equal(t, string(expected), string(render(
+		"request.resourceAttributes.namespace == 'kube-system'",
+		"request.user != 'system:anonymous'",
+		"has(request.resourceAttributes)",
+	)))
+
+	// authorizers themselves are not reordered
+	assert.Regexp(t, `(?s)name: node\n.*name: webhook\n.*name: rbac\n`, string(expected))
+}
+
+func TestAuthorizationConfigFallthroughWarning(t *testing.T) {
+	t.Parallel()
+
+	kubeAPIServerVersion := compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:v1.33.0")
+
+	webhook := k8s.AuthorizationAuthorizersSpec{
+		Type: "Webhook",
+		Name: "webhook",
+		Webhook: map[string]any{
+			"timeout":                    "3s",
+			"subjectAccessReviewVersion": "v1",
+			"matchConditionSubjectAccessReviewVersion": "v1",
+			"failurePolicy": "NoOpinion",
+			"connectionInfo": map[string]any{
+				"type": "InClusterConfig",
+			},
+			"matchConditions": []any{
+				map[string]any{
+					"expression": "request.resourceAttributes.namespace == 'kube-system'",
+				},
+			},
+		},
+	}
+
+	for _, test := range []struct {
+		name        string
+		authorizers []k8s.AuthorizationAuthorizersSpec
+
+		expectedWarnings [][]any
+	}{
+		{
+			name: "rbac fallback",
+			authorizers: []k8s.AuthorizationAuthorizersSpec{
+				{
+					Type: "Node",
+					Name: "node",
+				},
+				webhook,
+				{
+					Type: "RBAC",
+					Name: "rbac",
+				},
+			},
+		},
+		{
+			name: "no rbac",
+			authorizers: []k8s.AuthorizationAuthorizersSpec{
+				{
+					Type: "Node",
+					Name: "node",
+				},
+				webhook,
+			},
+
+			expectedWarnings: [][]any{{"node", "webhook"}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			core, logs := observer.New(zapcore.WarnLevel)
+
+			spec := &k8s.AuthorizationConfigSpec{
+				Config: test.authorizers,
+			}
+
+			_, err := k8sctrl.AuthorizationConfig(spec, k8sctrl.AuthorizationFieldPath, kubeAPIServerVersion, nil, zap.New(core))()
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedWarnings, xslices.Map(logs.All(), func(entry observer.LoggedEntry) []any {
+				return entry.ContextMap()["authorizers"].([]any) //nolint:forcetypeassert
+			}))
+		})
+	}
+}
+
+func TestAuthorizationConfigWebhookCacheTTLs(t *testing.T) {
+	t.Parallel()
+
+	kubeAPIServerVersion := compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:v1.33.0")
+
+	for _, test := range []struct {
+		name string
+		ttls map[string]any
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name: "defaults",
+			ttls: map[string]any{},
+		},
+		{
+			name: "valid",
+			ttls: map[string]any{
+				"authorizedTTL":   "1m",
+				"unauthorizedTTL": "10s",
+			},
+		},
+		{
+			name: "negative",
+			ttls: map[string]any{
+				"authorizedTTL":   "1m",
+				"unauthorizedTTL": "-10s",
+			},
+
+			expectedError: `cluster.apiServer.authorizationConfig[0].webhook.unauthorizedTTL: authorizer "webhook" cache TTL should be positive, got -10s`,
+		},
+		{
+			name: "very low",
+			ttls: map[string]any{
+				"authorizedTTL":   "100ms",
+				"unauthorizedTTL": "10s",
+			},
+
+			expectedWarnings: []string{"cluster.apiServer.authorizationConfig[0].webhook.authorizedTTL"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhook := map[string]any{
+				"timeout":                    "3s",
+				"subjectAccessReviewVersion": "v1",
+				"matchConditionSubjectAccessReviewVersion": "v1",
+				"failurePolicy": "NoOpinion",
+				"connectionInfo": map[string]any{
+					"type": "InClusterConfig",
+				},
+			}
+
+			maps.Copy(webhook, test.ttls)
+
+			spec := &k8s.AuthorizationConfigSpec{
+				Config: []k8s.AuthorizationAuthorizersSpec{
+					{
+						Type:    "Webhook",
+						Name:    "webhook",
+						Webhook: webhook,
+					},
+					{
+						Type: "RBAC",
+						Name: "rbac",
+					},
+				},
+			}
+
+			core, logs := observer.New(zapcore.WarnLevel)
+
+			_, err := k8sctrl.AuthorizationConfig(spec, k8sctrl.AuthorizationFieldPath, kubeAPIServerVersion, nil, zap.New(core))()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedWarnings, xslices.Map(logs.All(), func(entry observer.LoggedEntry) string {
+				return entry.ContextMap()["field"].(string) //nolint:forcetypeassert
+			}))
+		})
+	}
+}
+
+func TestAuthorizationConfigWebhookTimeout(t *testing.T) {
+	t.Parallel()
+
+	kubeAPIServerVersion := compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:v1.33.0")
+
+	for _, test := range []struct {
+		name    string
+		timeout any
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name:    "in range",
+			timeout: "5s",
+		},
+		{
+			name:    "outside of recommended range",
+			timeout: "20s",
+
+			expectedWarnings: []string{"cluster.apiServer.authorizationConfig[0].webhook.timeout"},
+		},
+		{
+			name:    "too high",
+			timeout: "1m",
+
+			expectedError: `cluster.apiServer.authorizationConfig[0].webhook.timeout: authorizer "webhook" timeout should be between 1s and 30s, got 1m0s`,
+		},
+		{
+			name:    "too low",
+			timeout: "500ms",
+
+			expectedError: `cluster.apiServer.authorizationConfig[0].webhook.timeout: authorizer "webhook" timeout should be between 1s and 30s, got 500ms`,
+		},
+		{
+			name:    "zero",
+			timeout: "0s",
+
+			expectedError: `cluster.apiServer.authorizationConfig[0].webhook.timeout: authorizer "webhook" timeout should be positive, got 0s`,
+		},
+		{
+			name:    "negative",
+			timeout: "-3s",
+
+			expectedError: `cluster.apiServer.authorizationConfig[0].webhook.timeout: authorizer "webhook" timeout should be positive, got -3s`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.AuthorizationConfigSpec{
+				Config: []k8s.AuthorizationAuthorizersSpec{
+					{
+						Type: "Webhook",
+						Name: "webhook",
+						Webhook: map[string]any{
+							"timeout":                    test.timeout,
+							"subjectAccessReviewVersion": "v1",
+							"matchConditionSubjectAccessReviewVersion": "v1",
+							"failurePolicy": "NoOpinion",
+							"connectionInfo": map[string]any{
+								"type": "InClusterConfig",
+							},
+						},
+					},
+					{
+						Type: "RBAC",
+						Name: "rbac",
+					},
+				},
+			}
+
+			core, logs := observer.New(zapcore.WarnLevel)
+
+			_, err := k8sctrl.AuthorizationConfig(spec, k8sctrl.AuthorizationFieldPath, kubeAPIServerVersion, nil, zap.New(core))()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedWarnings, xslices.Map(logs.All(), func(entry observer.LoggedEntry) string {
+				return entry.ContextMap()["field"].(string) //nolint:forcetypeassert
+			}))
+		})
+	}
+}
+
+func TestAuthorizationConfigWebhookFailurePolicy(t *testing.T) {
+	t.Parallel()
+
+	kubeAPIServerVersion := compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:v1.33.0")
+
+	for _, test := range []struct {
+		name          string
+		failurePolicy any
+		timeout       string
+
+		expectedError    string
+		expectedWarnings []string
+	}{
+		{
+			name:          "no opinion",
+			failurePolicy: "NoOpinion",
+			timeout:       "3s",
+		},
+		{
+			name:          "deny",
+			failurePolicy: "Deny",
+			timeout:       "3s",
+		},
+		{
+			name:          "deny with long timeout",
+			failurePolicy: "Deny",
+			timeout:       "8s",
+
+			expectedWarnings: []string{"cluster.apiServer.authorizationConfig[0].webhook.failurePolicy"},
+		},
+		{
+			name:          "no opinion with short timeout",
+			failurePolicy: "NoOpinion",
+			timeout:       "1500ms",
+
+			expectedWarnings: []string{
+				"cluster.apiServer.authorizationConfig[0].webhook.timeout",
+				"cluster.apiServer.authorizationConfig[0].webhook.failurePolicy",
+			},
+		},
+		{
+			name:          "invalid",
+			failurePolicy: "Allow",
+			timeout:       "3s",
+
+			expectedError: `cluster.apiServer.authorizationConfig[0].webhook.failurePolicy: authorizer "webhook" failure policy should be one of ["NoOpinion" "Deny"], got "Allow"`,
+		},
+		{
+			name:          "wrong case",
+			failurePolicy: "deny",
+			timeout:       "3s",
+
+			expectedError: `cluster.apiServer.authorizationConfig[0].webhook.failurePolicy: authorizer "webhook" failure policy should be one of ["NoOpinion" "Deny"], got "deny"`,
+		},
+		{
+			name:    "missing",
+			timeout: "3s",
+
+			expectedError: `cluster.apiServer.authorizationConfig[0].webhook.failurePolicy: authorizer "webhook" failure policy should be one of ["NoOpinion" "Deny"], got ""`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhook := map[string]any{
+				"timeout":                    test.timeout,
+				"subjectAccessReviewVersion": "v1",
+				"matchConditionSubjectAccessReviewVersion": "v1",
+				"connectionInfo": map[string]any{
+					"type": "InClusterConfig",
+				},
+			}
+
+			if test.failurePolicy != nil {
+				webhook["failurePolicy"] = test.failurePolicy
+			}
+
+			spec := &k8s.AuthorizationConfigSpec{
+				Config: []k8s.AuthorizationAuthorizersSpec{
+					{
+						Type:    "Webhook",
+						Name:    "webhook",
+						Webhook: webhook,
+					},
+					{
+						Type: "RBAC",
+						Name: "rbac",
+					},
+				},
+			}
+
+			core, logs := observer.New(zapcore.WarnLevel)
+
+			_, err := k8sctrl.AuthorizationConfig(spec, k8sctrl.AuthorizationFieldPath, kubeAPIServerVersion, nil, zap.New(core))()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedWarnings, xslices.Map(logs.All(), func(entry observer.LoggedEntry) string {
+				return entry.ContextMap()["field"].(string) //nolint:forcetypeassert
+			}))
+		})
+	}
+}
+
+func TestAuthorizationConfigMatchConditions(t *testing.T) {
+	t.Parallel()
+
+	kubeAPIServerVersion := compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:v1.33.0")
+
+	for _, test := range []struct {
+		name       string
+		expression string
+
+		expectedError string
+	}{
+		{
+			name:       "valid",
+			expression: "has(request.resourceAttributes) && request.resourceAttributes.namespace == 'kube-system'",
+		},
+		{
+			name:       "broken",
+			expression: "has(request.resourceAttributes) &&",
+
+			expectedError: `cluster.apiServer.authorizationConfig[1].webhook.matchConditions[0].expression: error compiling match condition "has(request.resourceAttributes) &&" for authorizer "webhook"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.AuthorizationConfigSpec{
+				Config: []k8s.AuthorizationAuthorizersSpec{
+					{
+						Type: "Node",
+						Name: "node",
+					},
+					{
+						Type: "Webhook",
+						Name: "webhook",
+						Webhook: map[string]any{
+							"timeout":                    "3s",
+							"subjectAccessReviewVersion": "v1",
+							"matchConditionSubjectAccessReviewVersion": "v1",
+							"failurePolicy": "NoOpinion",
+							"connectionInfo": map[string]any{
+								"type":           "KubeConfigFile",
+								"kubeConfigFile": "/etc/kubernetes/webhook.yaml",
+							},
+							"matchConditions": []any{
+								map[string]any{
+									"expression": test.expression,
+								},
+							},
+						},
+					},
+				},
+			}
+
+			obj, err := k8sctrl.AuthorizationConfig(spec, k8sctrl.AuthorizationFieldPath, kubeAPIServerVersion, nil, zap.NewNop())()
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			cfg, ok := obj.(*apiserverv1.AuthorizationConfiguration)
+			require.True(t, ok)
+			require.Len(t, cfg.Authorizers, 2)
+			assert.Equal(t, test.expression, cfg.Authorizers[1].Webhook.MatchConditions[0].Expression)
+		})
+	}
+}
+
+func TestWarnAuthConfigMismatches(t *testing.T) {
+	t.Parallel()
+
+	authn := &k8s.AuthenticationConfigSpec{
+		Config: map[string]any{
+			"jwt": []any{
+				map[string]any{
+					"issuer": map[string]any{
+						"url":       "https://issuer.example.com",
+						"audiences": []any{"talos"},
+					},
+					"claimMappings": map[string]any{
+						"username": map[string]any{
+							"claim":  "email",
+							"prefix": "oidc:",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range []struct {
+		name       string
+		expression string
+
+		expectedWarnings []string
+	}{
+		{
+			name:       "aligned",
+			expression: "request.user.startsWith('oidc:') || request.user.startsWith('system:serviceaccount:')",
+		},
+		{
+			name:       "unmapped groups",
+			expression: "'admins' in request.groups",
+
+			expectedWarnings: []string{"authorizer match condition references user attribute not mapped by JWT authenticators"},
+		},
+		{
+			name:       "mismatched username prefix",
+			expression: "request.user.startsWith('sso:')",
+
+			expectedWarnings: []string{"authorizer match condition username prefix doesn't match JWT authenticators username prefixes"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			core, logs := observer.New(zapcore.WarnLevel)
+
+			authz := &k8s.AuthorizationConfigSpec{
+				Config: []k8s.AuthorizationAuthorizersSpec{
+					{
+						Type: "Webhook",
+						Name: "webhook",
+						Webhook: map[string]any{
+							"matchConditions": []any{
+								map[string]any{
+									"expression": test.expression,
+								},
+							},
+						},
+					},
+				},
+			}
+
+			k8sctrl.WarnAuthConfigMismatches(zap.New(core), authn, authz)
+
+			assert.Equal(t, test.expectedWarnings, xslices.Map(logs.All(), func(entry observer.LoggedEntry) string { return entry.Message }))
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// backupOptions configures how the previous versions of the configs are kept.
+type backupOptions struct {
+	enabled bool
+	// compressAbove is the config size above which the backup is compressed, zero disables compression.
+	compressAbove int
+}
+
+func (ctrl *RenderConfigsStaticPodController) backupOptions() backupOptions {
+	return backupOptions{
+		enabled:       ctrl.BackupPreviousConfigs,
+		compressAbove: ctrl.CompressBackupsAbove,
+	}
+}
+
+// Backup suffixes, the compressed backup replaces the plain one and vice versa.
+const (
+	backupSuffix           = ".bak"
+	compressedBackupSuffix = ".bak.gz"
+)
+
+// backupConfig keeps the current version of the config (if it exists) as <path>.bak, replacing any older backup.
+func backupConfig(path string, compressAbove int) error {
+	st, err := os.Lstat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		return err
+	}
+
+	backupPath, stalePath := path+backupSuffix, path+compressedBackupSuffix
+
+	compress := compressAbove > 0 && st.Size() > int64(compressAbove)
+	if compress {
+		backupPath, stalePath = stalePath, backupPath
+	}
+
+	for _, p := range []string{backupPath, stalePath} {
+		if err = os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	if !compress {
+		return os.Link(path, backupPath)
+	}
+
+	return compressConfig(path, backupPath, st)
+}
+
+// compressConfig writes the gzip-compressed copy of the config, swapping it in once it's complete.
+func compressConfig(path, compressedPath string, st os.FileInfo) error {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+
+	zw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return err
+	}
+
+	zw.Name = filepath.Base(path)
+
+	if _, err = zw.Write(contents); err != nil {
+		return err
+	}
+
+	if err = zw.Close(); err != nil {
+		return err
+	}
+
+	tmpPath := compressedPath + ".tmp"
+
+	if err = os.WriteFile(tmpPath, buf.Bytes(), st.Mode().Perm()); err != nil {
+		return err
+	}
+
+	// WriteFile respects umask, and the backup should be readable by the same users as the config
+	if err = os.Chmod(tmpPath, st.Mode().Perm()); err != nil {
+		return err
+	}
+
+	if stat, ok := st.Sys().(*syscall.Stat_t); ok {
+		if err = os.Chown(tmpPath, int(stat.Uid), int(stat.Gid)); err != nil {
+			return err
+		}
+	}
+
+	return os.Rename(tmpPath, compressedPath)
+}
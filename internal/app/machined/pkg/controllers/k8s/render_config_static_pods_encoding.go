@@ -0,0 +1,237 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sjson "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+func newConfigSerializer() *k8sjson.Serializer {
+	return k8sjson.NewSerializerWithOptions(
+		k8sjson.DefaultMetaFactory, nil, nil,
+		k8sjson.SerializerOptions{
+			Yaml:   true,
+			Pretty: true,
+			Strict: true,
+		},
+	)
+}
+
+// encodeConfig encodes the rendered config as it is written to disk.
+func encodeConfig(serializer *k8sjson.Serializer, obj runtime.Object) ([]byte, error) {
+	switch doc := obj.(type) {
+	case jsonDocument:
+		return withTrailingNewline(doc), nil
+	case pemDocument:
+		return withTrailingNewline(doc), nil
+	case rawYAMLDocument:
+		return withTrailingNewline(doc), nil
+	}
+
+	var buf bytes.Buffer
+
+	if err := serializer.Encode(obj, &buf); err != nil {
+		return nil, err
+	}
+
+	return withTrailingNewline(buf.Bytes()), nil
+}
+
+// canonicalConfig rewrites the encoded config in the canonical form.
+func canonicalConfig(obj runtime.Object, contents []byte) ([]byte, error) {
+	switch obj.(type) {
+	case pemDocument, rawYAMLDocument:
+		return contents, nil
+	case jsonDocument:
+		value, err := canonicalValue(contents)
+		if err != nil {
+			return nil, err
+		}
+
+		var buf bytes.Buffer
+
+		encoder := json.NewEncoder(&buf)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+
+		if err = encoder.Encode(value); err != nil {
+			return nil, err
+		}
+
+		return withTrailingNewline(buf.Bytes()), nil
+	}
+
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(contents)))
+
+	var buf bytes.Buffer
+
+	for i := 0; ; i++ {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		jsonDoc, err := yaml.YAMLToJSON(doc)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding document %d: %w", i, err)
+		}
+
+		// empty documents carry no config, so they are dropped
+		if bytes.Equal(jsonDoc, []byte("null")) {
+			continue
+		}
+
+		value, err := canonicalValue(jsonDoc)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding document %d: %w", i, err)
+		}
+
+		// JSON marshaling sorts the map keys
+		jsonDoc, err = json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		canonical, err := yaml.JSONToYAML(jsonDoc)
+		if err != nil {
+			return nil, err
+		}
+
+		if buf.Len() > 0 {
+			buf.WriteString("---\n")
+		}
+
+		buf.Write(canonical)
+	}
+
+	return withTrailingNewline(buf.Bytes()), nil
+}
+
+// canonicalValue decodes the JSON document, keeping numbers as integers if they are integral.
+func canonicalValue(doc []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(doc))
+	decoder.UseNumber()
+
+	var value any
+
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return canonicalNumbers(value), nil
+}
+
+func canonicalNumbers(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = canonicalNumbers(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = canonicalNumbers(item)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+
+		if f, err := v.Float64(); err == nil {
+			return normalizeInteger(f)
+		}
+	}
+
+	return value
+}
+
+// withTrailingNewline replaces any trailing newlines with a single one, as POSIX tools expect text files to end with a newline.
+func withTrailingNewline(contents []byte) []byte {
+	return append(slices.Clip(bytes.TrimRight(contents, "\n")), '\n')
+}
+
+// jsonDocument is a rendered config which is not a Kubernetes object, it is written as is.
+type jsonDocument []byte
+
+// GetObjectKind implements runtime.Object interface.
+func (jsonDocument) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+// DeepCopyObject implements runtime.Object interface.
+func (doc jsonDocument) DeepCopyObject() runtime.Object {
+	return slices.Clone(doc)
+}
+
+// pemDocument is a rendered PEM bundle, it is written as is without the generated header.
+type pemDocument []byte
+
+// GetObjectKind implements runtime.Object interface.
+func (pemDocument) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+// DeepCopyObject implements runtime.Object interface.
+func (doc pemDocument) DeepCopyObject() runtime.Object {
+	return slices.Clone(doc)
+}
+
+// rawYAMLDocument is a YAML document written as is, so that the comments in it are preserved.
+type rawYAMLDocument []byte
+
+// GetObjectKind implements runtime.Object interface.
+func (rawYAMLDocument) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+// DeepCopyObject implements runtime.Object interface.
+func (doc rawYAMLDocument) DeepCopyObject() runtime.Object {
+	return slices.Clone(doc)
+}
+
+// generatedHeaderPrefix is the prefix of the comment marking rendered configs as managed by Talos.
+const generatedHeaderPrefix = "# Generated by Talos RenderConfigsStaticPodController"
+
+func generatedHeader() []byte {
+	return []byte(generatedHeaderPrefix + " at " + version.Tag + "\n")
+}
+
+// withGeneratedHeader prepends the generated header to the contents if the config supports comments.
+func withGeneratedHeader(obj runtime.Object, contents []byte) []byte {
+	switch obj.(type) {
+	case jsonDocument, pemDocument:
+		return contents
+	}
+
+	return append(generatedHeader(), contents...)
+}
+
+// stripGeneratedHeader removes the generated header (if present) from the rendered config.
+func stripGeneratedHeader(contents []byte) []byte {
+	if !bytes.HasPrefix(contents, []byte(generatedHeaderPrefix)) {
+		return contents
+	}
+
+	_, rest, _ := bytes.Cut(contents, []byte("\n"))
+
+	return rest
+}
@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+func TestCanonicalConfig(t *testing.T) {
+	t.Parallel()
+
+	render := func(rules []any) []byte {
+		auditCfg, err := k8sctrl.AuditPolicyConfig(&k8s.AuditPolicyConfigSpec{
+			Config: map[string]any{
+				"apiVersion": "audit.k8s.io/v1",
+				"kind":       "Policy",
+				"rules":      rules,
+			},
+		}, k8sctrl.AuditPolicyFieldPath, zap.NewNop())()
+		require.NoError(t, err)
+
+		contents, err := k8sctrl.CanonicalConfig(auditCfg)
+		require.NoError(t, err)
+
+		return contents
+	}
+
+	rules := []any{
+		map[string]any{
+			"level":     "RequestResponse",
+			"resources": []any{map[string]any{"group": "", "resources": []any{"pods"}}},
+		},
+		map[string]any{
+			"level": "Metadata",
+		},
+	}
+
+	canonical := render(rules)
+	assert.Equal(t, string(canonical), string(render(rules)))
+
+	assert.Equal(t, `apiVersion: audit.k8s.io/v1
+kind: Policy
+metadata:
+  creationTimestamp: null
+rules:
+- level: RequestResponse
+  resources:
+  - resources:
+    - pods
+- level: Metadata
+`, string(canonical))
+}
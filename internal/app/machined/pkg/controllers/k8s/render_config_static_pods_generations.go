@@ -0,0 +1,289 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+// ActiveGenerationLink is the name of the symlink to the active generation in the pod generations directory.
+const ActiveGenerationLink = "active"
+
+// defaultRetainGenerations is the number of the retained generations if not set.
+const defaultRetainGenerations = 5
+
+// retainGeneration stores the configs applied to the pod config directories as a new generation, if any of them changed.
+//
+// The generation is the next one after both the last reported and the last retained one, so that it keeps increasing
+// across the restarts. The generation of the configs currently applied is returned.
+func (ctrl *RenderConfigsStaticPodController) retainGeneration(
+	pods []staticPodConfigs, appliedConfigs map[string]k8s.AppliedConfigFileSpec, previous uint64, changed bool,
+) (uint64, error) {
+	generation := previous
+
+	for _, pod := range pods {
+		generations, err := retainedGenerations(filepath.Join(ctrl.GenerationsDir, pod.name))
+		if err != nil {
+			return 0, fmt.Errorf("error listing config generations for %q: %w", pod.name, err)
+		}
+
+		if len(generations) > 0 {
+			generation = max(generation, generations[len(generations)-1])
+		}
+	}
+
+	if !changed && generation > 0 {
+		return generation, nil
+	}
+
+	generation++
+
+	for _, pod := range pods {
+		if err := ctrl.writeGeneration(pod, appliedConfigs, generation); err != nil {
+			return 0, fmt.Errorf("error writing config generation %d for %q: %w", generation, pod.name, err)
+		}
+	}
+
+	return generation, nil
+}
+
+// writeGeneration copies the configs applied to the pod config directory to the generation directory, and activates it.
+//
+// Generations are only readable by root, as the configs might contain secrets.
+func (ctrl *RenderConfigsStaticPodController) writeGeneration(pod staticPodConfigs, appliedConfigs map[string]k8s.AppliedConfigFileSpec, generation uint64) error {
+	podDir := filepath.Join(ctrl.GenerationsDir, pod.name)
+	generationDir := filepath.Join(podDir, strconv.FormatUint(generation, 10))
+
+	// the generation is written to the temporary directory first, so that it's never retained half-written
+	tmpDir := generationDir + ".tmp"
+
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(tmpDir, 0o700); err != nil {
+		return err
+	}
+
+	for _, configFile := range pod.configs {
+		spec, ok := appliedConfigs[configFile.filename]
+		if !ok {
+			continue
+		}
+
+		contents, err := os.ReadFile(spec.Path)
+		if err != nil {
+			return err
+		}
+
+		if err = os.WriteFile(filepath.Join(tmpDir, filepath.Base(spec.Path)), contents, 0o600); err != nil {
+			return err
+		}
+	}
+
+	if err := os.RemoveAll(generationDir); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpDir, generationDir); err != nil {
+		return err
+	}
+
+	if err := activateGeneration(podDir, generation); err != nil {
+		return err
+	}
+
+	return pruneGenerations(podDir, generation, cmp.Or(ctrl.RetainGenerations, defaultRetainGenerations))
+}
+
+// activateGeneration atomically points the active generation symlink to the generation.
+func activateGeneration(podDir string, generation uint64) error {
+	tmpLink := filepath.Join(podDir, "."+ActiveGenerationLink+".tmp")
+
+	if err := os.Remove(tmpLink); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if err := os.Symlink(strconv.FormatUint(generation, 10), tmpLink); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpLink, filepath.Join(podDir, ActiveGenerationLink))
+}
+
+// pruneGenerations removes all but the latest retained generations, the active generation is always kept.
+func pruneGenerations(podDir string, active uint64, retain int) error {
+	generations, err := retainedGenerations(podDir)
+	if err != nil {
+		return err
+	}
+
+	for _, generation := range generations[:max(len(generations)-retain, 0)] {
+		if generation == active {
+			continue
+		}
+
+		if err = os.RemoveAll(filepath.Join(podDir, strconv.FormatUint(generation, 10))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// retainedGenerations returns the generations retained in the pod generations directory in the ascending order.
+func retainedGenerations(podDir string) ([]uint64, error) {
+	entries, err := os.ReadDir(podDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var generations []uint64
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		// leftovers of the interrupted writes are skipped, as they are not valid numbers
+		generation, err := strconv.ParseUint(entry.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		generations = append(generations, generation)
+	}
+
+	slices.Sort(generations)
+
+	return generations, nil
+}
+
+// activeGeneration returns the generation the active generation symlink points to, or zero if there is none.
+func activeGeneration(podDir string) (uint64, error) {
+	target, err := os.Readlink(filepath.Join(podDir, ActiveGenerationLink))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return strconv.ParseUint(target, 10, 64)
+}
+
+// RollbackConfigs reapplies the configs of the retained generation, and points the active generation symlinks to it.
+//
+// The configs are written the same way as they are rendered, i.e. staged and swapped in,
+// and the configs which are not part of the generation are removed.
+// The rolled back configs are overwritten on the next render if they don't match the inputs, so the inputs should be
+// rolled back as well.
+func (ctrl *RenderConfigsStaticPodController) RollbackConfigs(ctx context.Context, generation uint64) error {
+	if ctrl.GenerationsDir == "" {
+		return errors.New("config generations are not retained")
+	}
+
+	apiServer, scheduler := ctrl.configPods()
+
+	var (
+		updates    []configUpdate
+		rolledBack []staticPodConfigs
+	)
+
+	for _, pod := range []staticPodConfigs{apiServer, scheduler} {
+		podDir := filepath.Join(ctrl.GenerationsDir, pod.name)
+		generationDir := filepath.Join(podDir, strconv.FormatUint(generation, 10))
+
+		entries, err := os.ReadDir(generationDir)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return fmt.Errorf("error reading config generation %d for %q: %w", generation, pod.name, err)
+		}
+
+		active, err := activeGeneration(podDir)
+		if err != nil {
+			return fmt.Errorf("error reading active config generation for %q: %w", pod.name, err)
+		}
+
+		filenames := map[string]struct{}{}
+
+		for _, entry := range entries {
+			contents, err := os.ReadFile(filepath.Join(generationDir, entry.Name()))
+			if err != nil {
+				return fmt.Errorf("error reading configuration %q of generation %d for %q: %w", entry.Name(), generation, pod.name, err)
+			}
+
+			configDir := pod.configDir(sensitiveConfig(entry.Name()))
+
+			// ownership follows the pod, as the user IDs might have changed since the generation was written
+			updates = append(updates, configUpdate{
+				filename:     entry.Name(),
+				pod:          pod.name,
+				path:         filepath.Join(configDir, entry.Name()),
+				stagingPath:  stagingConfigPath(ctrl.stagingDir(pod, configDir), entry.Name()),
+				uid:          pod.uid,
+				gid:          pod.gid,
+				selinuxLabel: pod.fileSELinuxLabel,
+				contents:     contents,
+			})
+
+			filenames[entry.Name()] = struct{}{}
+		}
+
+		if active != 0 {
+			activeEntries, err := os.ReadDir(filepath.Join(podDir, strconv.FormatUint(active, 10)))
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("error reading config generation %d for %q: %w", active, pod.name, err)
+			}
+
+			for _, entry := range activeEntries {
+				if _, ok := filenames[entry.Name()]; ok {
+					continue
+				}
+
+				updates = append(updates, configUpdate{
+					filename: entry.Name(),
+					pod:      pod.name,
+					path:     filepath.Join(pod.configDir(sensitiveConfig(entry.Name())), entry.Name()),
+				})
+			}
+		}
+
+		rolledBack = append(rolledBack, pod)
+	}
+
+	if len(rolledBack) == 0 {
+		return fmt.Errorf("config generation %d is not retained", generation)
+	}
+
+	if err := ctrl.reapplyConfigs(ctx, rolledBack, updates); err != nil {
+		return err
+	}
+
+	for _, pod := range rolledBack {
+		if err := activateGeneration(filepath.Join(ctrl.GenerationsDir, pod.name), generation); err != nil {
+			return fmt.Errorf("error activating config generation %d for %q: %w", generation, pod.name, err)
+		}
+	}
+
+	return nil
+}
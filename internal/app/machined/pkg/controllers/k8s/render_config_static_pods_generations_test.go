@@ -0,0 +1,108 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+// retainGenerations is the number of config generations the suite controller retains.
+const retainGenerations = 2
+
+func (suite *RenderConfigsStaticPodSuite) TestConfigGenerations() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	suite.Assert().EqualValues(1, configStatus.TypedSpec().Generation)
+	suite.assertActiveGeneration("1")
+
+	auditPolicies := map[string]string{}
+
+	for i := range 3 {
+		auditPolicyConfig, err := safe.StateGetByID[*k8s.AuditPolicyConfig](suite.Ctx(), suite.State(), k8s.AuditPolicyConfigID)
+		suite.Require().NoError(err)
+
+		auditPolicyConfig.TypedSpec().Config = map[string]any{
+			"apiVersion": "audit.k8s.io/v1",
+			"kind":       "Policy",
+			"rules": []any{
+				map[string]any{
+					"level":      "Metadata",
+					"namespaces": []any{fmt.Sprintf("tenant-%d", i)},
+				},
+			},
+		}
+		suite.Update(auditPolicyConfig)
+
+		configStatus = suite.assertConfigStatusUpdated(configStatus)
+
+		contents, err := os.ReadFile(filepath.Join(suite.apiServerConfigDir, "auditpolicy.yaml"))
+		suite.Require().NoError(err)
+
+		auditPolicies[strconv.FormatUint(configStatus.TypedSpec().Generation, 10)] = string(contents)
+	}
+
+	suite.Assert().EqualValues(4, configStatus.TypedSpec().Generation)
+
+	// the active generation is the latest one, and only the latest generations are retained
+	suite.assertActiveGeneration("4")
+
+	for _, pod := range []string{k8s.APIServerID, k8s.SchedulerID} {
+		entries, err := os.ReadDir(filepath.Join(suite.generationsDir, pod))
+		suite.Require().NoError(err)
+
+		names := make([]string, 0, len(entries))
+
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+
+		suite.Assert().Equal([]string{"3", "4", k8sctrl.ActiveGenerationLink}, names, pod)
+	}
+
+	for _, generation := range []string{"3", "4"} {
+		contents, err := os.ReadFile(filepath.Join(suite.generationsDir, k8s.APIServerID, generation, "auditpolicy.yaml"))
+		suite.Require().NoError(err)
+
+		suite.Assert().Equal(auditPolicies[generation], string(contents), generation)
+	}
+
+	// the configs are rolled back on the "rebuilt" node with empty config directories,
+	// as the controller would correct the drift in the config directories it renders to
+	rollback := &k8sctrl.RenderConfigsStaticPodController{
+		APIServerConfigDir: filepath.Join(suite.T().TempDir(), "kube-apiserver"),
+		SchedulerConfigDir: filepath.Join(suite.T().TempDir(), "kube-scheduler"),
+		GenerationsDir:     suite.generationsDir,
+	}
+
+	suite.Require().NoError(rollback.RollbackConfigs(suite.Ctx(), 3))
+
+	contents, err := os.ReadFile(filepath.Join(rollback.APIServerConfigDir, "auditpolicy.yaml"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(auditPolicies["3"], string(contents))
+
+	suite.Assert().FileExists(filepath.Join(rollback.SchedulerConfigDir, "scheduler-config.yaml"))
+
+	suite.assertActiveGeneration("3")
+
+	suite.Require().EqualError(rollback.RollbackConfigs(suite.Ctx(), 1), "config generation 1 is not retained")
+}
+
+func (suite *RenderConfigsStaticPodSuite) assertActiveGeneration(expected string) {
+	for _, pod := range []string{k8s.APIServerID, k8s.SchedulerID} {
+		target, err := os.Readlink(filepath.Join(suite.generationsDir, pod, k8sctrl.ActiveGenerationLink))
+		suite.Require().NoError(err)
+
+		suite.Assert().Equal(expected, target, pod)
+	}
+}
@@ -0,0 +1,220 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+// updateGolden rewrites the golden files with the rendered configs, e.g. after the intended serialization change:
+//
+//	go test ./internal/app/machined/pkg/controllers/k8s/ -run TestGoldenConfigs -update-golden
+var updateGolden = flag.Bool("update-golden", false, "update the golden files of the rendered configs")
+
+// assertGolden compares the rendered config with the golden file in testdata/golden.
+//
+// The configs rendered with the options machined runs the controller with are compared with the golden files in testdata/golden/production.
+func assertGolden(t *testing.T, filename string, contents []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", "golden", filename)
+
+	if *updateGolden {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, contents, 0o644))
+
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err, "golden file is missing, run the test with -update-golden to create it")
+
+	assert.Equal(t, string(expected), string(contents), "rendered config doesn't match %q, run the test with -update-golden if the change is intended", path)
+}
+
+func TestGoldenConfigs(t *testing.T) {
+	t.Parallel()
+
+	kubeAPIServerVersion := compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:v1.33.0")
+
+	for _, test := range []struct {
+		filename string
+		render   func() (runtime.Object, error)
+	}{
+		{
+			filename: "admission-control-config.yaml",
+			render: k8sctrl.AdmissionControlConfig(&k8s.AdmissionControlConfigSpec{
+				Config: []k8s.AdmissionPluginSpec{
+					{
+						Name: "PodSecurity",
+						Configuration: map[string]any{
+							"apiVersion": "pod-security.admission.config.k8s.io/v1",
+							"kind":       "PodSecurityConfiguration",
+							"defaults": map[string]any{
+								"enforce":         "baseline",
+								"enforce-version": "latest",
+								"audit":           "restricted",
+								"audit-version":   "latest",
+								"warn":            "restricted",
+								"warn-version":    "latest",
+							},
+							"exemptions": map[string]any{
+								"usernames":      []any{},
+								"runtimeClasses": []any{},
+								"namespaces":     []any{"kube-system"},
+							},
+						},
+					},
+				},
+			}, kubeAPIServerVersion, true, nil, zap.NewNop()),
+		},
+		{
+			filename: "auditpolicy.yaml",
+			render: k8sctrl.AuditPolicyConfig(&k8s.AuditPolicyConfigSpec{
+				Config: map[string]any{
+					"apiVersion": "audit.k8s.io/v1",
+					"kind":       "Policy",
+					"omitStages": []any{"RequestReceived"},
+					"rules": []any{
+						map[string]any{
+							"level": "RequestResponse",
+							"resources": []any{
+								map[string]any{
+									"group":     "",
+									"resources": []any{"secrets", "configmaps"},
+								},
+							},
+						},
+						map[string]any{
+							"level": "Metadata",
+						},
+					},
+				},
+			}, k8sctrl.AuditPolicyFieldPath, zap.NewNop()),
+		},
+		{
+			filename: "scheduler-config.yaml",
+			render: k8sctrl.SchedulerConfig(&k8s.SchedulerConfigSpec{
+				Config: map[string]any{
+					"apiVersion": "kubescheduler.config.k8s.io/v1",
+					"kind":       "KubeSchedulerConfiguration",
+					"profiles": []any{
+						map[string]any{
+							"schedulerName": "default-scheduler",
+							"plugins": map[string]any{
+								"score": map[string]any{
+									"disabled": []any{
+										map[string]any{"name": "ImageLocality"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}, constants.KubernetesSchedulerConfigDir, constants.KubernetesSchedulerSecretConfigDir, nil, zap.NewNop()),
+		},
+		{
+			filename: "authentication-config.yaml",
+			render: k8sctrl.AuthenticationConfig(&k8s.AuthenticationConfigSpec{
+				Config: map[string]any{
+					"jwt": []any{
+						map[string]any{
+							"issuer": map[string]any{
+								"url":       "https://issuer.example.com",
+								"audiences": []any{"talos"},
+							},
+							"claimMappings": map[string]any{
+								"username": map[string]any{
+									"claim":  "email",
+									"prefix": "oidc:",
+								},
+								"groups": map[string]any{
+									"claim":  "groups",
+									"prefix": "oidc:",
+								},
+							},
+							"claimValidationRules": []any{
+								map[string]any{
+									"claim":         "hd",
+									"requiredValue": "example.com",
+								},
+							},
+						},
+					},
+				},
+			}, nil, kubeAPIServerVersion, nil, nil, zap.NewNop()),
+		},
+		{
+			filename: "authorization-config.yaml",
+			render: k8sctrl.AuthorizationConfig(&k8s.AuthorizationConfigSpec{
+				Config: []k8s.AuthorizationAuthorizersSpec{
+					{
+						Type: "Node",
+						Name: "node",
+					},
+					{
+						Type: "RBAC",
+						Name: "rbac",
+					},
+					{
+						Type: "Webhook",
+						Name: "webhook",
+						Webhook: map[string]any{
+							"timeout":                    "3s",
+							"failurePolicy":              "NoOpinion",
+							"subjectAccessReviewVersion": "v1",
+							"matchConditionSubjectAccessReviewVersion": "v1",
+							"authorizedTTL":   "5m",
+							"unauthorizedTTL": "30s",
+							"connectionInfo": map[string]any{
+								"type": "InClusterConfig",
+							},
+							"matchConditions": []any{
+								map[string]any{
+									"expression": "has(request.resourceAttributes)",
+								},
+							},
+						},
+					},
+				},
+			}, k8sctrl.AuthorizationFieldPath, kubeAPIServerVersion, nil, zap.NewNop()),
+		},
+	} {
+		t.Run(test.filename, func(t *testing.T) {
+			t.Parallel()
+
+			obj, err := test.render()
+			require.NoError(t, err)
+
+			contents, err := k8sctrl.EncodeConfig(obj)
+			require.NoError(t, err)
+
+			assertGolden(t, test.filename, contents)
+
+			contents, err = k8sctrl.ProductionConfig(obj)
+			require.NoError(t, err)
+
+			// the generated header carries the Talos version, which changes with each release
+			contents = bytes.ReplaceAll(contents, []byte(" at "+version.Tag+"\n"), []byte(" at VERSION\n"))
+
+			assertGolden(t, filepath.Join("production", test.filename), contents)
+		})
+	}
+}
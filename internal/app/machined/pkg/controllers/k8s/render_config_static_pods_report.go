@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"errors"
+	"slices"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// ValidationSeverity is the severity of the validation report entry.
+type ValidationSeverity string
+
+// Validation report entry severities.
+const (
+	// ValidationSeverityError makes the config invalid, the render fails.
+	ValidationSeverityError ValidationSeverity = "error"
+	// ValidationSeverityWarning doesn't make the config invalid, it's only logged when rendering.
+	ValidationSeverityWarning ValidationSeverity = "warning"
+)
+
+// ValidationReport is the machine-readable report of ValidateAll, e.g. for the CI pipelines.
+//
+// The JSON schema is stable: fields might be added, but never renamed or removed.
+type ValidationReport struct {
+	// Valid is false if any of the entries is an error.
+	Valid   bool                    `json:"valid"`
+	Entries []ValidationReportEntry `json:"entries"`
+}
+
+// ValidationReportEntry is a single issue of the validation report.
+type ValidationReportEntry struct {
+	File     string             `json:"file"`
+	Severity ValidationSeverity `json:"severity"`
+	// Field is the path of the field which should be fixed, omitted if the issue is not about a single field.
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// NewValidationReport returns the validation report of the ValidateAll results.
+//
+// Entries are ordered by file as in the results, errors before warnings.
+func NewValidationReport(results []FileValidationResult) ValidationReport {
+	report := ValidationReport{
+		Valid:   true,
+		Entries: []ValidationReportEntry{},
+	}
+
+	for _, result := range results {
+		for _, err := range result.Errors {
+			entry := ValidationReportEntry{
+				File:     result.Filename,
+				Severity: ValidationSeverityError,
+				Message:  err.Error(),
+			}
+
+			// the field path is only split from the message if the error is not wrapped with more context
+			var fieldErr *fieldPathError
+
+			if errors.As(err, &fieldErr) {
+				entry.Field = fieldErr.path.String()
+
+				if err.Error() == fieldErr.Error() {
+					entry.Message = fieldErr.err.Error()
+				}
+			}
+
+			report.Entries = append(report.Entries, entry)
+			report.Valid = false
+		}
+
+		for _, warning := range result.Warnings {
+			report.Entries = append(report.Entries, ValidationReportEntry{
+				File:     result.Filename,
+				Severity: ValidationSeverityWarning,
+				Field:    warning.Field,
+				Message:  warning.Message,
+			})
+		}
+	}
+
+	return report
+}
+
+// warningCollector is a zap core collecting the warnings logged while rendering the configs.
+type warningCollector struct {
+	fields   []zapcore.Field
+	warnings *[]ValidationWarning
+}
+
+// newWarningCollector returns a new empty warningCollector.
+func newWarningCollector() *warningCollector {
+	return &warningCollector{
+		warnings: new([]ValidationWarning),
+	}
+}
+
+// take returns the warnings collected so far, and resets the collector.
+func (collector *warningCollector) take() []ValidationWarning {
+	warnings := *collector.warnings
+	*collector.warnings = nil
+
+	return warnings
+}
+
+// Enabled implements zapcore.Core interface.
+func (collector *warningCollector) Enabled(level zapcore.Level) bool {
+	return level >= zapcore.WarnLevel
+}
+
+// With implements zapcore.Core interface.
+func (collector *warningCollector) With(fields []zapcore.Field) zapcore.Core {
+	return &warningCollector{
+		fields:   append(slices.Clip(collector.fields), fields...),
+		warnings: collector.warnings,
+	}
+}
+
+// Check implements zapcore.Core interface.
+func (collector *warningCollector) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if collector.Enabled(entry.Level) {
+		return checked.AddCore(entry, collector)
+	}
+
+	return checked
+}
+
+// Write implements zapcore.Core interface.
+//
+// The "field" and "error" fields are reported as the field path and appended to the message, as logged for the overridden errors.
+func (collector *warningCollector) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	warning := ValidationWarning{
+		Message: entry.Message,
+	}
+
+	for _, field := range append(slices.Clip(collector.fields), fields...) {
+		if field.Type != zapcore.StringType {
+			continue
+		}
+
+		switch field.Key {
+		case "field":
+			warning.Field = field.String
+		case "error":
+			warning.Message += ": " + field.String
+		}
+	}
+
+	*collector.warnings = append(*collector.warnings, warning)
+
+	return nil
+}
+
+// Sync implements zapcore.Core interface.
+func (collector *warningCollector) Sync() error {
+	return nil
+}
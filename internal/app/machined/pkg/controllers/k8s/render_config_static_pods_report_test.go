@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+func TestValidationReport(t *testing.T) {
+	t.Parallel()
+
+	results, err := k8sctrl.ValidateAll(k8sctrl.ValidationSpecs{
+		Audit: &k8s.AuditPolicyConfigSpec{
+			Config: map[string]any{
+				"apiVersion": "audit.k8s.io/v1",
+				"kind":       "Policy",
+				"rules": []any{
+					map[string]any{
+						"level": "Everything",
+					},
+				},
+			},
+		},
+		Authentication: &k8s.AuthenticationConfigSpec{
+			Config: map[string]any{
+				"jwt": []any{
+					map[string]any{
+						"issuer": map[string]any{
+							"url":       "https://issuer.example.com",
+							"audiences": []any{"talos"},
+						},
+						"claimMappings": map[string]any{
+							"username": map[string]any{
+								"claim":  "email",
+								"prefix": "",
+							},
+						},
+					},
+					map[string]any{
+						"issuer": map[string]any{
+							"url": "https://dev.example.com",
+						},
+						"claimMappings": map[string]any{
+							"username": map[string]any{
+								"claim":  "sub",
+								"prefix": "dev:",
+							},
+						},
+					},
+				},
+			},
+			ValidationOverrides: []string{"jwt[1].issuer.audiences"},
+		},
+		Scheduler: &k8s.SchedulerConfigSpec{
+			Enabled: true,
+			Config:  map[string]any{},
+		},
+	})
+	require.Error(t, err)
+
+	encoded, err := json.Marshal(k8sctrl.NewValidationReport(results))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"valid": false,
+		"entries": [
+			{
+				"file": "auditpolicy.yaml",
+				"severity": "error",
+				"field": "cluster.apiServer.auditPolicy.rules[0].level",
+				"message": "unknown audit level \"Everything\", should be one of [\"None\" \"Metadata\" \"Request\" \"RequestResponse\"]"
+			},
+			{
+				"file": "authentication-config.yaml",
+				"severity": "warning",
+				"field": "jwt[1].issuer.audiences",
+				"message": "config validation error is overridden: JWT authenticator for issuer \"https://dev.example.com\": at least one audience should be set"
+			},
+			{
+				"file": "authentication-config.yaml",
+				"severity": "warning",
+				"message": "JWT authenticators might map different identities to the same username"
+			}
+		]
+	}`, string(encoded))
+}
+
+func TestValidationReportValid(t *testing.T) {
+	t.Parallel()
+
+	results, err := k8sctrl.ValidateAll(k8sctrl.ValidationSpecs{
+		Scheduler: &k8s.SchedulerConfigSpec{
+			Enabled: true,
+			Config:  map[string]any{},
+		},
+	})
+	require.NoError(t, err)
+
+	encoded, err := json.Marshal(k8sctrl.NewValidationReport(results))
+	require.NoError(t, err)
+
+	// entries are always a list, so that the report can be parsed without checking for null
+	assert.JSONEq(t, `{"valid":true,"entries":[]}`, string(encoded))
+}
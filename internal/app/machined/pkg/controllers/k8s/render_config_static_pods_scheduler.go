@@ -0,0 +1,318 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	schedulerv1 "k8s.io/kube-scheduler/config/v1"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+// schedulerConfig renders the scheduler config.
+func schedulerConfig(
+	spec *k8s.SchedulerConfigSpec, configDir, secretConfigDir string, naming *k8s.ConfigNamingPolicySpec, logger *zap.Logger,
+) func() (runtime.Object, error) {
+	return func() (runtime.Object, error) {
+		var cfg schedulerv1.KubeSchedulerConfiguration
+
+		config := normalizeIntegers(spec.Config)
+
+		if err := validateConfigSchema(config, &cfg); err != nil {
+			return nil, fmt.Errorf("error unmarshaling scheduler configuration: %w", err)
+		}
+
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(config, &cfg, false); err != nil {
+			return nil, fmt.Errorf("error unmarshaling scheduler configuration: %w", err)
+		}
+
+		for i, extender := range cfg.Extenders {
+			if err := validateSchedulerExtender(extender); err != nil {
+				return nil, fmt.Errorf("error validating scheduler extender %d (%q): %w", i, extender.URLPrefix, err)
+			}
+
+			if err := validateSchedulerExtenderManagedResources(extender, i, logger); err != nil {
+				return nil, fmt.Errorf("error validating scheduler extender %d (%q): %w", i, extender.URLPrefix, err)
+			}
+
+			if extender.TLSConfig == nil {
+				continue
+			}
+
+			if err := validateSchedulerExtenderTLS(extender.TLSConfig); err != nil {
+				return nil, fmt.Errorf("error validating scheduler extender %d (%q): %w", i, extender.URLPrefix, err)
+			}
+
+			for _, tlsField := range schedulerExtenderTLSFields(i, extender.TLSConfig) {
+				if len(*tlsField.data) == 0 {
+					continue
+				}
+
+				dir := configDir
+
+				if tlsField.secret {
+					dir = secretConfigDir
+				}
+
+				*tlsField.file = filepath.Join(dir, naming.Filename(tlsField.filename))
+				*tlsField.data = nil
+			}
+		}
+
+		if err := validateSchedulerBackoff(&cfg); err != nil {
+			return nil, err
+		}
+
+		if err := validateSchedulerProfiles(&cfg); err != nil {
+			return nil, err
+		}
+
+		cfg.APIVersion = "kubescheduler.config.k8s.io/v1"
+		cfg.Kind = "KubeSchedulerConfiguration"
+		cfg.ClientConnection.Kubeconfig = filepath.Join(constants.KubernetesSchedulerSecretsDir, "kubeconfig")
+
+		return &cfg, nil
+	}
+}
+
+// Default kube-scheduler pod backoff, used if only one of the backoff fields is set.
+const (
+	defaultPodInitialBackoffSeconds = 1
+	defaultPodMaxBackoffSeconds     = 10
+)
+
+// validateSchedulerBackoff checks that the initial pod backoff doesn't exceed the max backoff,
+// as kube-scheduler refuses to start otherwise.
+func validateSchedulerBackoff(cfg *schedulerv1.KubeSchedulerConfiguration) error {
+	initial, maxBackoff := int64(defaultPodInitialBackoffSeconds), int64(defaultPodMaxBackoffSeconds)
+
+	if cfg.PodInitialBackoffSeconds != nil {
+		initial = *cfg.PodInitialBackoffSeconds
+	}
+
+	if cfg.PodMaxBackoffSeconds != nil {
+		maxBackoff = *cfg.PodMaxBackoffSeconds
+	}
+
+	switch {
+	case initial <= 0:
+		return fmt.Errorf("podInitialBackoffSeconds should be positive, got %d", initial)
+	case initial > maxBackoff:
+		return fmt.Errorf("podInitialBackoffSeconds (%d) should not be greater than podMaxBackoffSeconds (%d)", initial, maxBackoff)
+	}
+
+	return nil
+}
+
+// defaultSchedulerName is the scheduler name of the profiles which don't set it.
+const defaultSchedulerName = "default-scheduler"
+
+// validateSchedulerProfiles checks that the scheduler names of the profiles are unique, as kube-scheduler refuses to start otherwise.
+func validateSchedulerProfiles(cfg *schedulerv1.KubeSchedulerConfiguration) error {
+	profiles := map[string]int{}
+
+	for i, profile := range cfg.Profiles {
+		schedulerName := cmp.Or(pointer.SafeDeref(profile.SchedulerName), defaultSchedulerName)
+
+		if previous, ok := profiles[schedulerName]; ok {
+			return fmt.Errorf("scheduler profiles %d and %d have the same schedulerName %q", previous, i, schedulerName)
+		}
+
+		profiles[schedulerName] = i
+	}
+
+	return nil
+}
+
+// validateSchedulerExtender checks that the extender URL prefix is well-formed, and the verbs and weight are coherent.
+func validateSchedulerExtender(extender schedulerv1.Extender) error {
+	u, err := url.Parse(extender.URLPrefix)
+	if err != nil {
+		return fmt.Errorf("malformed urlPrefix: %w", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("urlPrefix should have http or https scheme, got %q", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return errors.New("urlPrefix should have a host")
+	}
+
+	if extender.FilterVerb == "" && extender.PrioritizeVerb == "" && extender.PreemptVerb == "" && extender.BindVerb == "" {
+		return errors.New("at least one of filterVerb, prioritizeVerb, preemptVerb or bindVerb should be set")
+	}
+
+	switch {
+	case extender.PrioritizeVerb != "" && extender.Weight <= 0:
+		return fmt.Errorf("weight should be positive when prioritizeVerb is set, got %d", extender.Weight)
+	case extender.PrioritizeVerb == "" && extender.Weight != 0:
+		return errors.New("weight is only used with prioritizeVerb")
+	}
+
+	return nil
+}
+
+// schedulerExtenderTLSField is the inline TLS material field of the scheduler extender, with the field referencing the file instead.
+type schedulerExtenderTLSField struct {
+	dataField string
+	fileField string
+	// filename is the name of the file in the scheduler config directory the inline material is extracted to
+	filename string
+	// secret is set for the private key, which is extracted to the scheduler secret config directory
+	secret bool
+
+	data *[]byte
+	file *string
+}
+
+// schedulerExtenderTLSFields returns the inline TLS material fields of the extender with the index.
+func schedulerExtenderTLSFields(index int, tlsConfig *schedulerv1.ExtenderTLSConfig) []schedulerExtenderTLSField {
+	return []schedulerExtenderTLSField{
+		{
+			dataField: "caData",
+			fileField: "caFile",
+			filename:  fmt.Sprintf("extender-%d-ca.crt", index),
+			data:      &tlsConfig.CAData,
+			file:      &tlsConfig.CAFile,
+		},
+		{
+			dataField: "certData",
+			fileField: "certFile",
+			filename:  fmt.Sprintf("extender-%d-client.crt", index),
+			data:      &tlsConfig.CertData,
+			file:      &tlsConfig.CertFile,
+		},
+		{
+			dataField: "keyData",
+			fileField: "keyFile",
+			filename:  fmt.Sprintf("extender-%d-client.key", index),
+			secret:    true,
+			data:      &tlsConfig.KeyData,
+			file:      &tlsConfig.KeyFile,
+		},
+	}
+}
+
+// schedulerExtenderTLSConfigs returns the files the inline TLS material of the scheduler extenders is extracted to.
+func schedulerExtenderTLSConfigs(spec *k8s.SchedulerConfigSpec) []configFile {
+	var cfg schedulerv1.KubeSchedulerConfiguration
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(normalizeIntegers(spec.Config), &cfg, false); err != nil {
+		return nil
+	}
+
+	var configs []configFile
+
+	for i, extender := range cfg.Extenders {
+		if extender.TLSConfig == nil {
+			continue
+		}
+
+		for _, tlsField := range schedulerExtenderTLSFields(i, extender.TLSConfig) {
+			if len(*tlsField.data) == 0 {
+				continue
+			}
+
+			contents := *tlsField.data
+
+			configs = append(configs, configFile{
+				filename: tlsField.filename,
+				secret:   tlsField.secret,
+				f: func() (runtime.Object, error) {
+					if !bytes.HasSuffix(contents, []byte("\n")) {
+						contents = append(slices.Clone(contents), '\n')
+					}
+
+					return pemDocument(contents), nil
+				},
+			})
+		}
+	}
+
+	return configs
+}
+
+// validateSchedulerExtenderTLS checks that the inline TLS material of the extender parses.
+func validateSchedulerExtenderTLS(tlsConfig *schedulerv1.ExtenderTLSConfig) error {
+	for _, tlsField := range schedulerExtenderTLSFields(0, tlsConfig) {
+		if len(*tlsField.data) > 0 && *tlsField.file != "" {
+			return fmt.Errorf("tlsConfig.%s and tlsConfig.%s are mutually exclusive", tlsField.dataField, tlsField.fileField)
+		}
+	}
+
+	if len(tlsConfig.CAData) > 0 {
+		if err := validateCertificateAuthority(string(tlsConfig.CAData)); err != nil {
+			return fmt.Errorf("error parsing tlsConfig.caData: %w", err)
+		}
+	}
+
+	if (len(tlsConfig.CertData) > 0) != (len(tlsConfig.KeyData) > 0) {
+		return errors.New("tlsConfig.certData and tlsConfig.keyData should be set together")
+	}
+
+	if len(tlsConfig.CertData) > 0 {
+		if _, err := tls.X509KeyPair(tlsConfig.CertData, tlsConfig.KeyData); err != nil {
+			return fmt.Errorf("error parsing tlsConfig.certData and tlsConfig.keyData: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateSchedulerExtenderManagedResources checks that the extender managed resources are well-formed resource names.
+func validateSchedulerExtenderManagedResources(extender schedulerv1.Extender, index int, logger *zap.Logger) error {
+	seen := make(map[string]struct{}, len(extender.ManagedResources))
+
+	for i, managed := range extender.ManagedResources {
+		if managed.Name == "" {
+			return fmt.Errorf("managedResources[%d] name should not be empty", i)
+		}
+
+		if errs := validation.IsQualifiedName(managed.Name); len(errs) > 0 {
+			return fmt.Errorf("managedResources[%d] name %q is malformed: %s", i, managed.Name, strings.Join(errs, "; "))
+		}
+
+		var problem string
+
+		domain, _, prefixed := strings.Cut(managed.Name, "/")
+
+		switch _, duplicate := seen[managed.Name]; {
+		case duplicate:
+			problem = "resource is listed more than once"
+		case !prefixed:
+			problem = "extended resource names should be prefixed with a domain"
+		case domain == "kubernetes.io" || strings.HasSuffix(domain, ".kubernetes.io"):
+			problem = "resources in the kubernetes.io domain are not extended resources"
+		}
+
+		seen[managed.Name] = struct{}{}
+
+		if problem != "" {
+			logger.Warn("scheduler extender references suspicious managed resource",
+				zap.Int("extender", index),
+				zap.String("urlPrefix", extender.URLPrefix),
+				zap.String("resource", managed.Name),
+				zap.String("problem", problem),
+			)
+		}
+	}
+
+	return nil
+}
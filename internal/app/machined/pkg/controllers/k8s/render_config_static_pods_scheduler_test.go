@@ -0,0 +1,412 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"encoding/base64"
+	"path/filepath"
+	"testing"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	schedulerv1 "k8s.io/kube-scheduler/config/v1"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+func TestSchedulerConfigBackoff(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		config map[string]any
+
+		expectedError string
+	}{
+		{
+			name:   "defaults",
+			config: map[string]any{},
+		},
+		{
+			name: "valid",
+			config: map[string]any{
+				"podInitialBackoffSeconds": 2,
+				"podMaxBackoffSeconds":     30,
+			},
+		},
+		{
+			name: "equal",
+			config: map[string]any{
+				"podInitialBackoffSeconds": 5,
+				"podMaxBackoffSeconds":     5,
+			},
+		},
+		{
+			name: "inverted",
+			config: map[string]any{
+				"podInitialBackoffSeconds": 30,
+				"podMaxBackoffSeconds":     2,
+			},
+
+			expectedError: "podInitialBackoffSeconds (30) should not be greater than podMaxBackoffSeconds (2)",
+		},
+		{
+			name: "inverted with default max",
+			config: map[string]any{
+				"podInitialBackoffSeconds": 20,
+			},
+
+			expectedError: "podInitialBackoffSeconds (20) should not be greater than podMaxBackoffSeconds (10)",
+		},
+		{
+			name: "zero initial",
+			config: map[string]any{
+				"podInitialBackoffSeconds": 0,
+			},
+
+			expectedError: "podInitialBackoffSeconds should be positive, got 0",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := k8sctrl.SchedulerConfig(&k8s.SchedulerConfigSpec{Config: test.config}, constants.KubernetesSchedulerConfigDir, constants.KubernetesSchedulerSecretConfigDir, nil, zap.NewNop())()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSchedulerConfigProfiles(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		profiles []any
+
+		expectedError string
+	}{
+		{
+			name: "unique",
+			profiles: []any{
+				map[string]any{"schedulerName": "default-scheduler"},
+				map[string]any{"schedulerName": "batch-scheduler"},
+			},
+		},
+		{
+			name: "unique with default",
+			profiles: []any{
+				map[string]any{},
+				map[string]any{"schedulerName": "batch-scheduler"},
+			},
+		},
+		{
+			name: "duplicate",
+			profiles: []any{
+				map[string]any{"schedulerName": "default-scheduler"},
+				map[string]any{"schedulerName": "batch-scheduler"},
+				map[string]any{"schedulerName": "batch-scheduler"},
+			},
+
+			expectedError: `scheduler profiles 1 and 2 have the same schedulerName "batch-scheduler"`,
+		},
+		{
+			name: "duplicate default",
+			profiles: []any{
+				map[string]any{},
+				map[string]any{"schedulerName": "default-scheduler"},
+			},
+
+			expectedError: `scheduler profiles 0 and 1 have the same schedulerName "default-scheduler"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := k8sctrl.SchedulerConfig(&k8s.SchedulerConfigSpec{Config: map[string]any{"profiles": test.profiles}}, constants.KubernetesSchedulerConfigDir, constants.KubernetesSchedulerSecretConfigDir, nil, zap.NewNop())()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSchedulerConfigExtenders(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		extender map[string]any
+
+		expectedError string
+	}{
+		{
+			name: "valid",
+			extender: map[string]any{
+				"urlPrefix":      "https://extender.kube-system.svc:8443/scheduler",
+				"filterVerb":     "filter",
+				"prioritizeVerb": "prioritize",
+				"weight":         5,
+			},
+		},
+		{
+			name: "malformed url",
+			extender: map[string]any{
+				"urlPrefix":  "extender.kube-system.svc:8443",
+				"filterVerb": "filter",
+			},
+
+			expectedError: `error validating scheduler extender 0 ("extender.kube-system.svc:8443"): urlPrefix should have http or https scheme`,
+		},
+		{
+			name: "missing weight",
+			extender: map[string]any{
+				"urlPrefix":      "http://127.0.0.1:8888",
+				"prioritizeVerb": "prioritize",
+			},
+
+			expectedError: "weight should be positive when prioritizeVerb is set, got 0",
+		},
+		{
+			name: "weight without prioritize",
+			extender: map[string]any{
+				"urlPrefix":  "http://127.0.0.1:8888",
+				"filterVerb": "filter",
+				"weight":     1,
+			},
+
+			expectedError: "weight is only used with prioritizeVerb",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.SchedulerConfigSpec{
+				Config: map[string]any{
+					"extenders": []any{test.extender},
+				},
+			}
+
+			_, err := k8sctrl.SchedulerConfig(spec, constants.KubernetesSchedulerConfigDir, constants.KubernetesSchedulerSecretConfigDir, nil, zap.NewNop())()
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSchedulerConfigExtenderTLS(t *testing.T) {
+	t.Parallel()
+
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("extender"))
+	require.NoError(t, err)
+
+	otherCA, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("other"))
+	require.NoError(t, err)
+
+	encode := func(data []byte) string { return base64.StdEncoding.EncodeToString(data) }
+
+	for _, test := range []struct {
+		name      string
+		tlsConfig map[string]any
+
+		expectedTLSConfig schedulerv1.ExtenderTLSConfig
+		expectedError     string
+	}{
+		{
+			name: "inline",
+			tlsConfig: map[string]any{
+				"caData":   encode(ca.CrtPEM),
+				"certData": encode(ca.CrtPEM),
+				"keyData":  encode(ca.KeyPEM),
+			},
+
+			expectedTLSConfig: schedulerv1.ExtenderTLSConfig{
+				CAFile:   filepath.Join(constants.KubernetesSchedulerConfigDir, "extender-0-ca.crt"),
+				CertFile: filepath.Join(constants.KubernetesSchedulerConfigDir, "extender-0-client.crt"),
+				KeyFile:  filepath.Join(constants.KubernetesSchedulerSecretConfigDir, "extender-0-client.key"),
+			},
+		},
+		{
+			name: "files",
+			tlsConfig: map[string]any{
+				"caFile": "/var/lib/extender/ca.crt",
+			},
+
+			expectedTLSConfig: schedulerv1.ExtenderTLSConfig{
+				CAFile: "/var/lib/extender/ca.crt",
+			},
+		},
+		{
+			name: "data and file",
+			tlsConfig: map[string]any{
+				"caData": encode(ca.CrtPEM),
+				"caFile": "/var/lib/extender/ca.crt",
+			},
+
+			expectedError: "tlsConfig.caData and tlsConfig.caFile are mutually exclusive",
+		},
+		{
+			name: "malformed CA",
+			tlsConfig: map[string]any{
+				"caData": encode([]byte("not a certificate")),
+			},
+
+			expectedError: "error parsing tlsConfig.caData",
+		},
+		{
+			name: "cert without key",
+			tlsConfig: map[string]any{
+				"certData": encode(ca.CrtPEM),
+			},
+
+			expectedError: "tlsConfig.certData and tlsConfig.keyData should be set together",
+		},
+		{
+			name: "mismatched key",
+			tlsConfig: map[string]any{
+				"certData": encode(ca.CrtPEM),
+				"keyData":  encode(otherCA.KeyPEM),
+			},
+
+			expectedError: "error parsing tlsConfig.certData and tlsConfig.keyData",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := &k8s.SchedulerConfigSpec{
+				Config: map[string]any{
+					"extenders": []any{
+						map[string]any{
+							"urlPrefix":  "https://extender.kube-system.svc:8443/scheduler",
+							"filterVerb": "filter",
+							"tlsConfig":  test.tlsConfig,
+						},
+					},
+				},
+			}
+
+			obj, err := k8sctrl.SchedulerConfig(spec, constants.KubernetesSchedulerConfigDir, constants.KubernetesSchedulerSecretConfigDir, nil, zap.NewNop())()
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			cfg, ok := obj.(*schedulerv1.KubeSchedulerConfiguration)
+			require.True(t, ok)
+			require.Len(t, cfg.Extenders, 1)
+			assert.Equal(t, &test.expectedTLSConfig, cfg.Extenders[0].TLSConfig)
+		})
+	}
+}
+
+func TestSchedulerConfigExtenderManagedResources(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name             string
+		managedResources []any
+
+		expectedError    string
+		expectedProblems []string
+	}{
+		{
+			name: "valid",
+			managedResources: []any{
+				map[string]any{"name": "example.com/gpu", "ignoredByScheduler": true},
+				map[string]any{"name": "example.com/fpga"},
+			},
+		},
+		{
+			name: "empty name",
+			managedResources: []any{
+				map[string]any{"name": "example.com/gpu"},
+				map[string]any{"ignoredByScheduler": true},
+			},
+
+			expectedError: "managedResources[1] name should not be empty",
+		},
+		{
+			name: "malformed",
+			managedResources: []any{
+				map[string]any{"name": "example.com/gpu/a100"},
+			},
+
+			expectedError: `managedResources[0] name "example.com/gpu/a100" is malformed`,
+		},
+		{
+			name: "suspicious",
+			managedResources: []any{
+				map[string]any{"name": "gpu"},
+				map[string]any{"name": "kubernetes.io/gpu"},
+				map[string]any{"name": "example.com/gpu"},
+				map[string]any{"name": "example.com/gpu"},
+			},
+
+			expectedProblems: []string{
+				"extended resource names should be prefixed with a domain",
+				"resources in the kubernetes.io domain are not extended resources",
+				"resource is listed more than once",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			core, logs := observer.New(zapcore.WarnLevel)
+
+			spec := &k8s.SchedulerConfigSpec{
+				Config: map[string]any{
+					"extenders": []any{
+						map[string]any{
+							"urlPrefix":        "https://extender.kube-system.svc:8443/scheduler",
+							"filterVerb":       "filter",
+							"managedResources": test.managedResources,
+						},
+					},
+				},
+			}
+
+			_, err := k8sctrl.SchedulerConfig(spec, constants.KubernetesSchedulerConfigDir, constants.KubernetesSchedulerSecretConfigDir, nil, zap.New(core))()
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			problems := xslices.Map(logs.All(), func(entry observer.LoggedEntry) string {
+				return entry.ContextMap()["problem"].(string) //nolint:forcetypeassert
+			})
+
+			assert.ElementsMatch(t, test.expectedProblems, problems)
+		})
+	}
+}
@@ -0,0 +1,227 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Schema types of the config values, as in the OpenAPI schema.
+const (
+	schemaString  = "string"
+	schemaInteger = "integer"
+	schemaNumber  = "number"
+	schemaBoolean = "boolean"
+	schemaObject  = "object"
+	schemaArray   = "array"
+)
+
+// openAPISchemaTyped is implemented by the types with the custom JSON encoding, e.g. metav1.Duration.
+type openAPISchemaTyped interface {
+	OpenAPISchemaType() []string
+}
+
+// openAPIV3OneOfTyped is implemented by the types which might be encoded in several ways, e.g. intstr.IntOrString.
+type openAPIV3OneOfTyped interface {
+	OpenAPIV3OneOfTypes() []string
+}
+
+// validateConfigSchema checks the types of the config values against the schema of the config type.
+//
+// Kubernetes doesn't embed the OpenAPI schemas of the component configs, as they are not served by the API server,
+// so the schema is derived from the Go types, the same way the OpenAPI schemas are generated.
+// The unstructured converter stops at the first type mismatch without reporting its field path, so the config is
+// validated before it's decoded. Unknown fields are not checked, as they are rejected by the strict decoding.
+func validateConfigSchema(config map[string]any, obj any) error {
+	return validateSchemaValue(nil, config, reflect.TypeOf(obj))
+}
+
+//nolint:gocyclo,cyclop
+func validateSchemaValue(fldPath *field.Path, value any, typ reflect.Type) error {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if value == nil {
+		return nil
+	}
+
+	if expected, ok := customSchemaTypes(typ); ok {
+		actual := schemaTypeOf(value)
+
+		// integers are valid numbers
+		if actual == schemaInteger && slices.Contains(expected, schemaNumber) {
+			actual = schemaNumber
+		}
+
+		if len(expected) > 0 && !slices.Contains(expected, actual) {
+			return schemaTypeError(fldPath, value, expected...)
+		}
+
+		return nil
+	}
+
+	switch typ.Kind() { //nolint:exhaustive
+	case reflect.Interface:
+		return nil
+	case reflect.String:
+		return expectSchemaType(fldPath, value, schemaString)
+	case reflect.Bool:
+		return expectSchemaType(fldPath, value, schemaBoolean)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return expectSchemaType(fldPath, value, schemaInteger)
+	case reflect.Float32, reflect.Float64:
+		if schemaTypeOf(value) == schemaInteger {
+			return nil
+		}
+
+		return expectSchemaType(fldPath, value, schemaNumber)
+	case reflect.Slice, reflect.Array:
+		// byte slices are base64-encoded strings, e.g. the inline TLS material
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return expectSchemaType(fldPath, value, schemaString)
+		}
+
+		items, ok := value.([]any)
+		if !ok {
+			return schemaTypeError(fldPath, value, schemaArray)
+		}
+
+		for i, item := range items {
+			if err := validateSchemaValue(fldPath.Index(i), item, typ.Elem()); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		entries, ok := value.(map[string]any)
+		if !ok {
+			return schemaTypeError(fldPath, value, schemaObject)
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(entries)) {
+			if err := validateSchemaValue(fldPath.Key(key), entries[key], typ.Elem()); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		entries, ok := value.(map[string]any)
+		if !ok {
+			return schemaTypeError(fldPath, value, schemaObject)
+		}
+
+		return validateSchemaFields(fldPath, entries, typ)
+	}
+
+	return nil
+}
+
+// validateSchemaFields checks the config values of the struct fields, the inlined structs are checked against the same values.
+func validateSchemaFields(fldPath *field.Path, entries map[string]any, typ reflect.Type) error {
+	for i := range typ.NumField() {
+		structField := typ.Field(i)
+
+		if !structField.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(structField.Tag.Get("json"), ",")
+
+		if name == "-" {
+			continue
+		}
+
+		if name == "" && (structField.Anonymous || slices.Contains(strings.Split(opts, ","), "inline")) {
+			fieldType := structField.Type
+
+			for fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+
+			if fieldType.Kind() == reflect.Struct {
+				if err := validateSchemaFields(fldPath, entries, fieldType); err != nil {
+					return err
+				}
+
+				continue
+			}
+		}
+
+		if name == "" {
+			name = structField.Name
+		}
+
+		value, ok := entries[name]
+		if !ok {
+			continue
+		}
+
+		if err := validateSchemaValue(fldPath.Child(name), value, structField.Type); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// customSchemaTypes returns the schema types of the types with the custom JSON encoding.
+//
+// Types which decode themselves without declaring the schema types, e.g. runtime.RawExtension, accept any value.
+func customSchemaTypes(typ reflect.Type) ([]string, bool) {
+	zero := reflect.New(typ).Interface()
+
+	if oneOf, ok := zero.(openAPIV3OneOfTyped); ok {
+		return oneOf.OpenAPIV3OneOfTypes(), true
+	}
+
+	if schemaTyped, ok := zero.(openAPISchemaTyped); ok {
+		return schemaTyped.OpenAPISchemaType(), true
+	}
+
+	if _, ok := zero.(json.Unmarshaler); ok {
+		return nil, true
+	}
+
+	return nil, false
+}
+
+func expectSchemaType(fldPath *field.Path, value any, expected string) error {
+	if schemaTypeOf(value) != expected {
+		return schemaTypeError(fldPath, value, expected)
+	}
+
+	return nil
+}
+
+func schemaTypeError(fldPath *field.Path, value any, expected ...string) error {
+	return &fieldPathError{path: fldPath, err: fmt.Errorf("expected %s, got %s", strings.Join(expected, " or "), schemaTypeOf(value))}
+}
+
+// schemaTypeOf returns the schema type of the config value, the config is expected to be normalized with normalizeIntegers.
+func schemaTypeOf(value any) string {
+	switch value.(type) {
+	case string:
+		return schemaString
+	case bool:
+		return schemaBoolean
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return schemaInteger
+	case float32, float64:
+		return schemaNumber
+	case []any:
+		return schemaArray
+	case map[string]any:
+		return schemaObject
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
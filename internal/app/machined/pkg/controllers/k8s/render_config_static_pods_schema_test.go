@@ -0,0 +1,175 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+func TestConfigSchemaValidation(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		render func() (runtime.Object, error)
+
+		expectedError string
+	}{
+		{
+			name: "valid scheduler config",
+			render: k8sctrl.SchedulerConfig(&k8s.SchedulerConfigSpec{
+				Config: map[string]any{
+					"leaderElection": map[string]any{
+						"leaderElect":   true,
+						"leaseDuration": "15s",
+					},
+					"percentageOfNodesToScore": 50,
+					"profiles": []any{
+						map[string]any{
+							"schedulerName": "default-scheduler",
+							"pluginConfig": []any{
+								map[string]any{
+									"name": "NodeResourcesFit",
+									"args": map[string]any{
+										"scoringStrategy": map[string]any{
+											"type": "MostAllocated",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}, constants.KubernetesSchedulerConfigDir, constants.KubernetesSchedulerSecretConfigDir, nil, zap.NewNop()),
+		},
+		{
+			name: "scheduler plugin name",
+			render: k8sctrl.SchedulerConfig(&k8s.SchedulerConfigSpec{
+				Config: map[string]any{
+					"profiles": []any{
+						map[string]any{
+							"pluginConfig": []any{
+								map[string]any{
+									"name": 5,
+								},
+							},
+						},
+					},
+				},
+			}, constants.KubernetesSchedulerConfigDir, constants.KubernetesSchedulerSecretConfigDir, nil, zap.NewNop()),
+
+			expectedError: "error unmarshaling scheduler configuration: profiles[0].pluginConfig[0].name: expected string, got integer",
+		},
+		{
+			name: "scheduler lease duration",
+			render: k8sctrl.SchedulerConfig(&k8s.SchedulerConfigSpec{
+				Config: map[string]any{
+					"leaderElection": map[string]any{
+						"leaseDuration": 15,
+					},
+				},
+			}, constants.KubernetesSchedulerConfigDir, constants.KubernetesSchedulerSecretConfigDir, nil, zap.NewNop()),
+
+			expectedError: "error unmarshaling scheduler configuration: leaderElection.leaseDuration: expected string, got integer",
+		},
+		{
+			name: "scheduler extender tls config",
+			render: k8sctrl.SchedulerConfig(&k8s.SchedulerConfigSpec{
+				Config: map[string]any{
+					"extenders": []any{
+						map[string]any{
+							"urlPrefix": "https://extender.kube-system.svc:8443/scheduler",
+							"tlsConfig": map[string]any{
+								"insecure": "true",
+							},
+						},
+					},
+				},
+			}, constants.KubernetesSchedulerConfigDir, constants.KubernetesSchedulerSecretConfigDir, nil, zap.NewNop()),
+
+			expectedError: "error unmarshaling scheduler configuration: extenders[0].tlsConfig.insecure: expected boolean, got string",
+		},
+		{
+			name: "authentication audiences",
+			render: k8sctrl.AuthenticationConfig(&k8s.AuthenticationConfigSpec{
+				Config: map[string]any{
+					"jwt": []any{
+						map[string]any{
+							"issuer": map[string]any{
+								"url":       "https://issuer.example.com",
+								"audiences": "talos",
+							},
+						},
+					},
+				},
+			}, nil, testKubeAPIServerVersion, nil, nil, zap.NewNop()),
+
+			expectedError: "error unmarshaling authentication configuration: jwt[0].issuer.audiences: expected array, got string",
+		},
+		{
+			name: "authentication claim mapping",
+			render: k8sctrl.AuthenticationConfig(&k8s.AuthenticationConfigSpec{
+				Config: map[string]any{
+					"jwt": []any{
+						map[string]any{
+							"issuer": map[string]any{
+								"url":       "https://issuer.example.com",
+								"audiences": []any{"talos"},
+							},
+							"claimMappings": map[string]any{
+								"username": []any{"email"},
+							},
+						},
+					},
+				},
+			}, nil, testKubeAPIServerVersion, nil, nil, zap.NewNop()),
+
+			expectedError: "error unmarshaling authentication configuration: jwt[0].claimMappings.username: expected object, got array",
+		},
+		{
+			name: "audit policy rule level",
+			render: k8sctrl.AuditPolicyConfig(&k8s.AuditPolicyConfigSpec{
+				Config: map[string]any{
+					"apiVersion": "audit.k8s.io/v1",
+					"kind":       "Policy",
+					"rules": []any{
+						map[string]any{
+							"level": "Metadata",
+							"resources": []any{
+								map[string]any{
+									"group":     "",
+									"resources": []any{"secrets", 5},
+								},
+							},
+						},
+					},
+				},
+			}, k8sctrl.AuditPolicyFieldPath, zap.NewNop()),
+
+			expectedError: "cluster.apiServer.auditPolicy: error unmarshaling audit policy configuration: rules[0].resources[0].resources[1]: expected string, got integer",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.render()
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
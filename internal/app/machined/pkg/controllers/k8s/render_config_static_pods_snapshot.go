@@ -0,0 +1,209 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+
+	"github.com/siderolabs/talos/internal/pkg/selinux"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+// PAX records of the config snapshot entries.
+const (
+	// SnapshotSHA256Record is the hash of the config contents.
+	SnapshotSHA256Record = "TALOS.sha256"
+	// SnapshotSensitiveRecord is "true" if the config might contain secrets, so that the snapshot should be stored encrypted.
+	SnapshotSensitiveRecord = "TALOS.sensitive"
+)
+
+// sensitiveConfigs are the configs which might contain secrets resolved from the secret references.
+var sensitiveConfigs = []string{"authentication-config.yaml", "authorization-config.yaml"}
+
+// sensitiveConfig returns true if the config might contain secrets, including the extracted private keys.
+func sensitiveConfig(filename string) bool {
+	return slices.Contains(sensitiveConfigs, filename) || strings.HasSuffix(filename, ".key")
+}
+
+// SnapshotConfigs writes the tar archive of all configs as they are applied to disk.
+//
+// Each config is stored as <pod>/<filename> with its mode, ownership and hash, and configs which might contain secrets
+// are flagged with the SnapshotSensitiveRecord, as the archive itself is not encrypted.
+func (ctrl *RenderConfigsStaticPodController) SnapshotConfigs(ctx context.Context, r controller.Reader, w io.Writer) error {
+	appliedConfigs, err := safe.ReaderListAll[*k8s.AppliedConfigFile](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing applied configs: %w", err)
+	}
+
+	apiServer, scheduler := ctrl.configPods()
+	pods := map[string]string{}
+
+	for _, pod := range []staticPodConfigs{apiServer, scheduler} {
+		for _, dir := range pod.directories() {
+			pods[dir] = pod.name
+		}
+	}
+
+	tw := tar.NewWriter(w)
+
+	for appliedConfig := range appliedConfigs.All() {
+		spec := appliedConfig.TypedSpec()
+		filename := filepath.Base(spec.Path)
+
+		pod, ok := pods[filepath.Dir(spec.Path)]
+		if !ok {
+			return fmt.Errorf("configuration %q is not in any of the config directories", spec.Path)
+		}
+
+		contents, err := os.ReadFile(spec.Path)
+		if err != nil {
+			return fmt.Errorf("error reading configuration %q for %q: %w", filename, pod, err)
+		}
+
+		// the snapshot should only capture the configs as they were applied, not the drift which is about to be corrected
+		if contentsHash(contents) != spec.SHA256 {
+			return fmt.Errorf("configuration %q for %q doesn't match the applied one", filename, pod)
+		}
+
+		if err = tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     path.Join(pod, filename),
+			Mode:     int64(spec.Mode),
+			Uid:      spec.UID,
+			Gid:      spec.GID,
+			Size:     int64(len(contents)),
+			Format:   tar.FormatPAX,
+			PAXRecords: map[string]string{
+				SnapshotSHA256Record:    spec.SHA256,
+				SnapshotSensitiveRecord: strconv.FormatBool(sensitiveConfig(appliedConfig.Metadata().ID())),
+			},
+		}); err != nil {
+			return fmt.Errorf("error writing snapshot of %q for %q: %w", filename, pod, err)
+		}
+
+		if _, err = tw.Write(contents); err != nil {
+			return fmt.Errorf("error writing snapshot of %q for %q: %w", filename, pod, err)
+		}
+	}
+
+	return tw.Close()
+}
+
+// RestoreConfigs reapplies the configs from the archive written by SnapshotConfigs.
+//
+// The configs are written the same way as they are rendered, i.e. staged and swapped in.
+// Nothing is written if any of the archive entries is invalid, the entries can't replace the files the controller
+// keeps next to the configs (the lock, the index, the backups and the unmanaged markers).
+// The restored configs are overwritten on the next render if they don't match the inputs.
+func (ctrl *RenderConfigsStaticPodController) RestoreConfigs(ctx context.Context, archive io.Reader) error {
+	apiServer, scheduler := ctrl.configPods()
+	pods := map[string]staticPodConfigs{
+		apiServer.name: apiServer,
+		scheduler.name: scheduler,
+	}
+
+	var (
+		updates      []configUpdate
+		restoredPods = map[string]struct{}{}
+	)
+
+	tr := tar.NewReader(archive)
+
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading snapshot: %w", err)
+		}
+
+		podName, filename := path.Split(hdr.Name)
+		podName = path.Clean(podName)
+
+		pod, ok := pods[podName]
+		if !ok || hdr.Typeflag != tar.TypeReg || reservedConfigFilename(filename) {
+			return fmt.Errorf("unexpected snapshot entry %q", hdr.Name)
+		}
+
+		contents, err := io.ReadAll(tr)
+		if err != nil {
+			return fmt.Errorf("error reading snapshot of %q for %q: %w", filename, pod.name, err)
+		}
+
+		if contentsHash(contents) != hdr.PAXRecords[SnapshotSHA256Record] {
+			return fmt.Errorf("snapshot of %q for %q is corrupted", filename, pod.name)
+		}
+
+		configDir := pod.configDir(hdr.PAXRecords[SnapshotSensitiveRecord] == "true")
+
+		// ownership follows the pod, as the user IDs might have changed since the snapshot
+		updates = append(updates, configUpdate{
+			filename:     filename,
+			pod:          pod.name,
+			path:         filepath.Join(configDir, filename),
+			stagingPath:  stagingConfigPath(ctrl.stagingDir(pod, configDir), filename),
+			uid:          pod.uid,
+			gid:          pod.gid,
+			selinuxLabel: pod.fileSELinuxLabel,
+			contents:     contents,
+		})
+
+		restoredPods[pod.name] = struct{}{}
+	}
+
+	var restored []staticPodConfigs
+
+	for _, pod := range []staticPodConfigs{apiServer, scheduler} {
+		if _, ok := restoredPods[pod.name]; ok {
+			restored = append(restored, pod)
+		}
+	}
+
+	return ctrl.reapplyConfigs(ctx, restored, updates)
+}
+
+// reapplyConfigs applies the config updates of the restore or the rollback, holding the locks of the pod config directories.
+func (ctrl *RenderConfigsStaticPodController) reapplyConfigs(ctx context.Context, pods []staticPodConfigs, updates []configUpdate) error {
+	for _, pod := range pods {
+		if err := ensureConfigDir(pod); err != nil {
+			return fmt.Errorf("error creating config directory for %q: %w", pod.name, err)
+		}
+
+		if err := ctrl.ensureStagingDir(pod); err != nil {
+			return fmt.Errorf("error creating staging directory for %q: %w", pod.name, err)
+		}
+
+		for _, dir := range pod.directories() {
+			if err := selinux.SetLabel(dir, pod.selinuxLabel); err != nil {
+				return err
+			}
+		}
+	}
+
+	// configs might be reapplied while the controller is rendering them
+	unlock, err := lockConfigDirs(ctx, pods)
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	return applyConfigUpdates(updates, ctrl.backupOptions())
+}
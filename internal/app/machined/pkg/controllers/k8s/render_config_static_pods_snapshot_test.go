@@ -0,0 +1,201 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+func (suite *RenderConfigsStaticPodSuite) TestSnapshotConfigs() {
+	suite.createInputs()
+	suite.assertConfigStatusReady()
+
+	ctrl := &k8sctrl.RenderConfigsStaticPodController{
+		APIServerConfigDir: suite.apiServerConfigDir,
+		SchedulerConfigDir: suite.schedulerConfigDir,
+	}
+
+	var snapshot bytes.Buffer
+
+	suite.Require().NoError(ctrl.SnapshotConfigs(suite.Ctx(), suite.State(), &snapshot))
+
+	sensitive := map[string]string{}
+
+	tr := tar.NewReader(bytes.NewReader(snapshot.Bytes()))
+
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		suite.Require().NoError(err)
+
+		sensitive[hdr.Name] = hdr.PAXRecords[k8sctrl.SnapshotSensitiveRecord]
+	}
+
+	suite.Assert().Equal(map[string]string{
+		"kube-apiserver/admission-control-config.yaml": "false",
+		"kube-apiserver/auditpolicy.yaml":              "false",
+		"kube-apiserver/authorization-config.yaml":     "true",
+		"kube-scheduler/scheduler-config.yaml":         "false",
+	}, sensitive)
+
+	// restore on the "rebuilt" node with empty config directories
+	restored := &k8sctrl.RenderConfigsStaticPodController{
+		APIServerConfigDir: filepath.Join(suite.T().TempDir(), "kube-apiserver"),
+		SchedulerConfigDir: filepath.Join(suite.T().TempDir(), "kube-scheduler"),
+		StagingDir:         filepath.Join(suite.T().TempDir(), "staging"),
+	}
+
+	suite.Require().NoError(restored.RestoreConfigs(suite.Ctx(), bytes.NewReader(snapshot.Bytes())))
+
+	for filename, dirs := range map[string][2]string{
+		"admission-control-config.yaml": {suite.apiServerConfigDir, restored.APIServerConfigDir},
+		"auditpolicy.yaml":              {suite.apiServerConfigDir, restored.APIServerConfigDir},
+		"authorization-config.yaml":     {suite.apiServerConfigDir, restored.APIServerConfigDir},
+		"scheduler-config.yaml":         {suite.schedulerConfigDir, restored.SchedulerConfigDir},
+	} {
+		original, err := os.ReadFile(filepath.Join(dirs[0], filename))
+		suite.Require().NoError(err)
+
+		restoredContents, err := os.ReadFile(filepath.Join(dirs[1], filename))
+		suite.Require().NoError(err)
+
+		suite.Assert().Equal(string(original), string(restoredContents), filename)
+
+		originalInfo, err := os.Stat(filepath.Join(dirs[0], filename))
+		suite.Require().NoError(err)
+
+		restoredInfo, err := os.Stat(filepath.Join(dirs[1], filename))
+		suite.Require().NoError(err)
+
+		suite.Assert().Equal(originalInfo.Mode(), restoredInfo.Mode(), filename)
+	}
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestSnapshotConfigsUnknownPath() {
+	suite.createInputs()
+	suite.assertConfigStatusReady()
+
+	ctrl := &k8sctrl.RenderConfigsStaticPodController{
+		APIServerConfigDir: suite.apiServerConfigDir,
+		SchedulerConfigDir: suite.schedulerConfigDir,
+	}
+
+	appliedConfig := k8s.NewAppliedConfigFile("kubeconfig")
+	appliedConfig.TypedSpec().Path = filepath.Join(suite.T().TempDir(), "kubeconfig")
+	suite.Create(appliedConfig)
+
+	suite.Require().ErrorContains(ctrl.SnapshotConfigs(suite.Ctx(), suite.State(), io.Discard), "is not in any of the config directories")
+}
+
+func TestRestoreConfigsInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		entry    string
+		contents string
+		sha256   string
+
+		expectedError string
+	}{
+		{
+			name:     "unknown pod",
+			entry:    "kube-controller-manager/config.yaml",
+			contents: "foo",
+			sha256:   "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+
+			expectedError: `unexpected snapshot entry "kube-controller-manager/config.yaml"`,
+		},
+		{
+			name:     "path traversal",
+			entry:    "kube-apiserver/../../etc/passwd",
+			contents: "foo",
+			sha256:   "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+
+			expectedError: `unexpected snapshot entry "kube-apiserver/../../etc/passwd"`,
+		},
+		{
+			name:     "lock",
+			entry:    "kube-apiserver/" + k8sctrl.ConfigLockFilename,
+			contents: "foo",
+			sha256:   "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+
+			expectedError: `unexpected snapshot entry "kube-apiserver/talos-config.lock"`,
+		},
+		{
+			name:     "index",
+			entry:    "kube-scheduler/" + k8sctrl.ConfigIndexFilename,
+			contents: "foo",
+			sha256:   "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+
+			expectedError: `unexpected snapshot entry "kube-scheduler/talos-config-index.json"`,
+		},
+		{
+			name:     "backup",
+			entry:    "kube-apiserver/auditpolicy.yaml.bak",
+			contents: "foo",
+			sha256:   "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+
+			expectedError: `unexpected snapshot entry "kube-apiserver/auditpolicy.yaml.bak"`,
+		},
+		{
+			name:     "corrupted",
+			entry:    "kube-apiserver/auditpolicy.yaml",
+			contents: "bar",
+			sha256:   "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+
+			expectedError: `snapshot of "auditpolicy.yaml" for "kube-apiserver" is corrupted`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var snapshot bytes.Buffer
+
+			tw := tar.NewWriter(&snapshot)
+
+			require.NoError(t, tw.WriteHeader(&tar.Header{
+				Typeflag: tar.TypeReg,
+				Name:     test.entry,
+				Mode:     0o400,
+				Size:     int64(len(test.contents)),
+				Format:   tar.FormatPAX,
+				PAXRecords: map[string]string{
+					k8sctrl.SnapshotSHA256Record: test.sha256,
+				},
+			}))
+
+			_, err := tw.Write([]byte(test.contents))
+			require.NoError(t, err)
+
+			require.NoError(t, tw.Close())
+
+			ctrl := &k8sctrl.RenderConfigsStaticPodController{
+				APIServerConfigDir: filepath.Join(t.TempDir(), "kube-apiserver"),
+				SchedulerConfigDir: filepath.Join(t.TempDir(), "kube-scheduler"),
+			}
+
+			require.EqualError(t, ctrl.RestoreConfigs(t.Context(), &snapshot), test.expectedError)
+
+			// nothing is written if the snapshot is invalid
+			assert.NoDirExists(t, ctrl.APIServerConfigDir)
+		})
+	}
+}
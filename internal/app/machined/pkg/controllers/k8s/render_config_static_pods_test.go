@@ -0,0 +1,2463 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	cosiruntime "github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/pkg/xattr"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/runtime"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	apiserverv1beta1 "k8s.io/apiserver/pkg/apis/apiserver/v1beta1"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/internal/pkg/selinux"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+type RenderConfigsStaticPodSuite struct {
+	ctest.DefaultSuite
+
+	apiServerConfigDir       string
+	apiServerSecretConfigDir string
+	schedulerConfigDir       string
+	schedulerSecretConfigDir string
+	generationsDir           string
+	events                   *renderEvents
+
+	traceLogs *observer.ObservedLogs
+	traceWG   sync.WaitGroup
+}
+
+// renderEvents collects the published events.
+type renderEvents struct {
+	mu     sync.Mutex
+	events []*machine.StaticPodConfigRenderEvent
+}
+
+func (events *renderEvents) Publish(_ context.Context, m proto.Message) {
+	events.mu.Lock()
+	defer events.mu.Unlock()
+
+	events.events = append(events.events, m.(*machine.StaticPodConfigRenderEvent)) //nolint:forcetypeassert
+}
+
+func (events *renderEvents) all() []*machine.StaticPodConfigRenderEvent {
+	events.mu.Lock()
+	defer events.mu.Unlock()
+
+	return slices.Clone(events.events)
+}
+
+func TestRenderConfigsStaticPodSuite(t *testing.T) {
+	t.Parallel()
+
+	var s RenderConfigsStaticPodSuite
+
+	s.AfterSetup = func(suite *ctest.DefaultSuite) {
+		s.apiServerConfigDir = suite.T().TempDir()
+		s.schedulerConfigDir = suite.T().TempDir()
+		s.generationsDir = suite.T().TempDir()
+		s.events = &renderEvents{}
+
+		ctrl := &k8sctrl.RenderConfigsStaticPodController{
+			APIServerConfigDir: s.apiServerConfigDir,
+			SchedulerConfigDir: s.schedulerConfigDir,
+
+			APIServerConfigDirMode: 0o700,
+
+			GeneratedHeader:       true,
+			VersionXattrs:         true,
+			CanonicalOutput:       true,
+			BackupPreviousConfigs: true,
+			CompressBackupsAbove:  largeConfigSize,
+			GenerationsDir:        s.generationsDir,
+			RetainGenerations:     retainGenerations,
+			CorrectDrift:          true,
+
+			V1Alpha1Events: s.events,
+		}
+
+		option, ok := renderConfigsStaticPodOptions[path.Base(suite.T().Name())]
+		if !ok {
+			suite.Require().NoError(suite.Runtime().RegisterController(ctrl))
+
+			return
+		}
+
+		ctrl = option.controller(&s)
+
+		if !option.traced {
+			suite.Require().NoError(suite.Runtime().RegisterController(ctrl))
+
+			return
+		}
+
+		core, logs := observer.New(zapcore.DebugLevel)
+		s.traceLogs = logs
+
+		// the controller runs in a separate runtime on the same state, so that its debug logs are captured
+		rt, err := cosiruntime.NewRuntime(suite.State(), zap.New(core))
+		suite.Require().NoError(err)
+
+		suite.Require().NoError(rt.RegisterController(ctrl))
+
+		s.traceWG.Add(1)
+
+		go func() {
+			defer s.traceWG.Done()
+
+			suite.Assert().NoError(rt.Run(suite.Ctx()))
+		}()
+	}
+
+	s.AfterTearDown = func(*ctest.DefaultSuite) {
+		s.traceWG.Wait()
+	}
+
+	suite.Run(t, &s)
+}
+
+const minRenderInterval = 3 * time.Second
+
+// renderConfigsStaticPodOptions are the controller options under test, by the test name.
+//
+// The tests run the controller with only the options under test, instead of the suite defaults.
+var renderConfigsStaticPodOptions = map[string]struct {
+	controller func(s *RenderConfigsStaticPodSuite) *k8sctrl.RenderConfigsStaticPodController
+	// traced captures the debug logs of the controller
+	traced bool
+}{
+	"TestValidateOnly": {
+		controller: func(s *RenderConfigsStaticPodSuite) *k8sctrl.RenderConfigsStaticPodController {
+			// directories are not created upfront, so any write would make them appear
+			s.apiServerConfigDir = filepath.Join(s.T().TempDir(), "kube-apiserver")
+			s.schedulerConfigDir = filepath.Join(s.T().TempDir(), "kube-scheduler")
+
+			return &k8sctrl.RenderConfigsStaticPodController{
+				APIServerConfigDir: s.apiServerConfigDir,
+				SchedulerConfigDir: s.schedulerConfigDir,
+			}
+		},
+	},
+	"TestUnhealthyRenderFailures": {
+		controller: func(s *RenderConfigsStaticPodSuite) *k8sctrl.RenderConfigsStaticPodController {
+			return &k8sctrl.RenderConfigsStaticPodController{
+				APIServerConfigDir: s.apiServerConfigDir,
+				SchedulerConfigDir: s.schedulerConfigDir,
+
+				UnhealthyRenderFailures: 3,
+			}
+		},
+	},
+	"TestSecretConfigDir": {
+		controller: func(s *RenderConfigsStaticPodSuite) *k8sctrl.RenderConfigsStaticPodController {
+			s.apiServerSecretConfigDir = filepath.Join(s.T().TempDir(), "kube-apiserver")
+
+			return &k8sctrl.RenderConfigsStaticPodController{
+				APIServerConfigDir:       s.apiServerConfigDir,
+				APIServerSecretConfigDir: s.apiServerSecretConfigDir,
+				SchedulerConfigDir:       s.schedulerConfigDir,
+			}
+		},
+	},
+	"TestStagingDir": {
+		controller: func(s *RenderConfigsStaticPodSuite) *k8sctrl.RenderConfigsStaticPodController {
+			return &k8sctrl.RenderConfigsStaticPodController{
+				APIServerConfigDir: s.apiServerConfigDir,
+				SchedulerConfigDir: s.schedulerConfigDir,
+				StagingDir:         filepath.Join(s.T().TempDir(), "staging"),
+			}
+		},
+	},
+	"TestSchedulerExtenderTLSSecretConfigDir": {
+		controller: func(s *RenderConfigsStaticPodSuite) *k8sctrl.RenderConfigsStaticPodController {
+			s.schedulerSecretConfigDir = filepath.Join(s.T().TempDir(), "kube-scheduler")
+
+			return &k8sctrl.RenderConfigsStaticPodController{
+				APIServerConfigDir:       s.apiServerConfigDir,
+				SchedulerConfigDir:       s.schedulerConfigDir,
+				SchedulerSecretConfigDir: s.schedulerSecretConfigDir,
+			}
+		},
+	},
+	"TestMinRenderInterval": {
+		controller: func(s *RenderConfigsStaticPodSuite) *k8sctrl.RenderConfigsStaticPodController {
+			return &k8sctrl.RenderConfigsStaticPodController{
+				APIServerConfigDir: s.apiServerConfigDir,
+				SchedulerConfigDir: s.schedulerConfigDir,
+				MinRenderInterval:  minRenderInterval,
+			}
+		},
+	},
+	"TestTraceDecisions": {
+		controller: func(s *RenderConfigsStaticPodSuite) *k8sctrl.RenderConfigsStaticPodController {
+			return &k8sctrl.RenderConfigsStaticPodController{
+				APIServerConfigDir: s.apiServerConfigDir,
+				SchedulerConfigDir: s.schedulerConfigDir,
+				CorrectDrift:       true,
+			}
+		},
+		traced: true,
+	},
+}
+
+func (suite *RenderConfigsStaticPodSuite) createInputs() *k8s.SchedulerConfig {
+	return createRenderConfigsStaticPodInputs(&suite.DefaultSuite)
+}
+
+// createRenderConfigsStaticPodInputs creates all required inputs of the controller, returning the scheduler config.
+func createRenderConfigsStaticPodInputs(suite *ctest.DefaultSuite) *k8s.SchedulerConfig {
+	// kube-apiserver config is created first, so that it's reflected once the config status is ready
+	apiServerConfig := k8s.NewAPIServerConfig()
+	apiServerConfig.TypedSpec().Image = "registry.k8s.io/kube-apiserver:v1.33.0"
+	suite.Create(apiServerConfig)
+
+	return createRenderConfigsStaticPodConfigInputs(suite)
+}
+
+// createRenderConfigsStaticPodConfigInputs creates the config inputs of the controller, without kube-apiserver config.
+func createRenderConfigsStaticPodConfigInputs(suite *ctest.DefaultSuite) *k8s.SchedulerConfig {
+	admissionConfig := k8s.NewAdmissionControlConfig()
+	admissionConfig.TypedSpec().Config = []k8s.AdmissionPluginSpec{
+		{
+			Name: "PodSecurity",
+			Configuration: map[string]any{
+				"apiVersion": "pod-security.admission.config.k8s.io/v1alpha1",
+				"kind":       "PodSecurityConfiguration",
+				"defaults": map[string]any{
+					"enforce": "baseline",
+				},
+			},
+		},
+	}
+	suite.Create(admissionConfig)
+
+	auditPolicyConfig := k8s.NewAuditPolicyConfig()
+	auditPolicyConfig.TypedSpec().Config = map[string]any{
+		"apiVersion": "audit.k8s.io/v1",
+		"kind":       "Policy",
+		"rules": []any{
+			map[string]any{
+				"level": "Metadata",
+			},
+		},
+	}
+	suite.Create(auditPolicyConfig)
+
+	authorizationConfig := k8s.NewAuthorizationConfig()
+	authorizationConfig.TypedSpec().Image = "registry.k8s.io/kube-apiserver:v1.33.0"
+	authorizationConfig.TypedSpec().Config = []k8s.AuthorizationAuthorizersSpec{
+		{
+			Type: "Node",
+			Name: "node",
+		},
+		{
+			Type: "RBAC",
+			Name: "rbac",
+		},
+	}
+	suite.Create(authorizationConfig)
+
+	schedulerConfig := k8s.NewSchedulerConfig()
+	schedulerConfig.TypedSpec().Enabled = true
+	schedulerConfig.TypedSpec().Config = map[string]any{}
+	suite.Create(schedulerConfig)
+
+	return schedulerConfig
+}
+
+func (suite *RenderConfigsStaticPodSuite) assertConfigStatusReady() *k8s.ConfigStatus {
+	var configStatus *k8s.ConfigStatus
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Ready)
+
+		configStatus = status
+	})
+
+	return configStatus
+}
+
+// assertConfigStatusUpdated waits for the ConfigStatus version to change from the previous version.
+func (suite *RenderConfigsStaticPodSuite) assertConfigStatusUpdated(previous *k8s.ConfigStatus) *k8s.ConfigStatus {
+	var configStatus *k8s.ConfigStatus
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.NotEqual(previous.TypedSpec().Version, status.TypedSpec().Version)
+
+		configStatus = status
+	})
+
+	return configStatus
+}
+
+// renderedFile is a snapshot of the rendered config file.
+type renderedFile struct {
+	contents []byte
+	inode    uint64
+}
+
+func (suite *RenderConfigsStaticPodSuite) snapshotRenderedFiles() map[string]renderedFile {
+	snapshot := map[string]renderedFile{}
+
+	for _, dir := range []string{suite.apiServerConfigDir, suite.schedulerConfigDir} {
+		entries, err := os.ReadDir(dir)
+		suite.Require().NoError(err)
+
+		for _, entry := range entries {
+			// the index lists the input resource versions, so it's rewritten on any input update
+			if entry.Name() == k8sctrl.ConfigIndexFilename || entry.Name() == k8sctrl.ConfigLockFilename {
+				continue
+			}
+
+			path := filepath.Join(dir, entry.Name())
+
+			contents, err := os.ReadFile(path)
+			suite.Require().NoError(err)
+
+			info, err := entry.Info()
+			suite.Require().NoError(err)
+
+			snapshot[path] = renderedFile{
+				contents: contents,
+				inode:    info.Sys().(*syscall.Stat_t).Ino, //nolint:forcetypeassert
+			}
+		}
+	}
+
+	return snapshot
+}
+
+// assertRenderIdempotent creates the default inputs and the extra inputs, and then reconciles once again with the unchanged inputs.
+//
+// It asserts that the second pass renders byte-identical configs, and that no configs are rewritten.
+func (suite *RenderConfigsStaticPodSuite) assertRenderIdempotent(extraInputs ...resource.Resource) {
+	// extra inputs are created first, so that they are already reflected once the config status is ready
+	for _, input := range extraInputs {
+		suite.Create(input)
+	}
+
+	inputs := append([]resource.Resource{suite.createInputs()}, extraInputs...)
+
+	configStatus := suite.assertConfigStatusReady()
+
+	firstPass := suite.snapshotRenderedFiles()
+	suite.Require().NotEmpty(firstPass)
+
+	for _, input := range inputs {
+		suite.Update(input)
+	}
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	suite.Assert().Equal(firstPass, suite.snapshotRenderedFiles())
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestIdempotentRender() {
+	suite.assertRenderIdempotent()
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestIdempotentRenderOptionalConfigs() {
+	authenticationConfig := k8s.NewAuthenticationConfig(k8s.AuthenticationConfigID)
+	authenticationConfig.TypedSpec().Config = map[string]any{
+		"jwt": []any{
+			map[string]any{
+				"issuer": map[string]any{
+					"url":       "https://issuer.example.com",
+					"audiences": []any{"talos"},
+				},
+				"claimMappings": map[string]any{
+					"username": map[string]any{
+						"claim":  "email",
+						"prefix": "",
+					},
+				},
+			},
+		},
+	}
+
+	konnectivityConfig := k8s.NewKonnectivityServerConfig()
+	konnectivityConfig.TypedSpec().ListenAddress = "/etc/kubernetes/konnectivity-server/konnectivity-server.socket"
+	konnectivityConfig.TypedSpec().AgentNamespace = "kube-system"
+	konnectivityConfig.TypedSpec().AgentServiceAccount = "konnectivity-agent"
+
+	suite.assertRenderIdempotent(authenticationConfig, konnectivityConfig)
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestVersionXattrs() {
+	// user xattrs are not supported on all filesystems, e.g. on older tmpfs
+	probe := filepath.Join(suite.T().TempDir(), "probe")
+	suite.Require().NoError(os.WriteFile(probe, nil, 0o600))
+
+	if err := xattr.Set(probe, k8sctrl.ConfigVersionXattr, []byte("probe")); err != nil {
+		suite.T().Skipf("extended attributes are not supported: %s", err)
+	}
+
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	path := filepath.Join(suite.apiServerConfigDir, "auditpolicy.yaml")
+
+	version, err := xattr.Get(path, k8sctrl.ConfigVersionXattr)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(configStatus.TypedSpec().Version, string(version))
+
+	renderTime, err := xattr.Get(path, k8sctrl.ConfigRenderTimeXattr)
+	suite.Require().NoError(err)
+
+	parsed, err := time.Parse(time.RFC3339Nano, string(renderTime))
+	suite.Require().NoError(err)
+
+	suite.Assert().WithinDuration(time.Now(), parsed, time.Minute)
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestRenderOne() {
+	ctrl := &k8sctrl.RenderConfigsStaticPodController{
+		APIServerConfigDir: suite.apiServerConfigDir,
+		SchedulerConfigDir: suite.schedulerConfigDir,
+		GeneratedHeader:    true,
+	}
+
+	_, _, err := ctrl.RenderOne(suite.Ctx(), suite.State(), "authentication-config.yaml")
+	suite.Require().EqualError(err, "static pod config inputs are not ready")
+
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	_, _, err = ctrl.RenderOne(suite.Ctx(), suite.State(), "authentication-config.yaml")
+	suite.Require().EqualError(err, `configuration "authentication-config.yaml" for "kube-apiserver" is not enabled`)
+
+	_, _, err = ctrl.RenderOne(suite.Ctx(), suite.State(), "kubeconfig")
+	suite.Require().EqualError(err, `unknown configuration "kubeconfig"`)
+
+	authenticationConfig := k8s.NewAuthenticationConfig(k8s.AuthenticationConfigID)
+	authenticationConfig.TypedSpec().Config = map[string]any{
+		"jwt": []any{
+			map[string]any{
+				"issuer": map[string]any{
+					"url":       "https://issuer.example.com",
+					"audiences": []any{"talos"},
+				},
+				"claimMappings": map[string]any{
+					"username": map[string]any{
+						"claim":  "email",
+						"prefix": "",
+					},
+				},
+			},
+		},
+	}
+	suite.Create(authenticationConfig)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	obj, contents, err := ctrl.RenderOne(suite.Ctx(), suite.State(), "authentication-config.yaml")
+	suite.Require().NoError(err)
+	suite.Assert().IsType(&apiserverv1beta1.AuthenticationConfiguration{}, obj)
+
+	onDisk, err := os.ReadFile(filepath.Join(suite.apiServerConfigDir, "authentication-config.yaml"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(string(onDisk), string(contents))
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestAuditPolicySelection() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	policy := func(level string) map[string]any {
+		return map[string]any{
+			"apiVersion": "audit.k8s.io/v1",
+			"kind":       "Policy",
+			"rules": []any{
+				map[string]any{
+					"level": level,
+				},
+			},
+		}
+	}
+
+	for _, test := range []struct {
+		activePolicy  string
+		expectedLevel auditv1.Level
+	}{
+		{
+			activePolicy:  "verbose",
+			expectedLevel: auditv1.LevelRequestResponse,
+		},
+		{
+			activePolicy:  "quiet",
+			expectedLevel: auditv1.LevelMetadata,
+		},
+		{
+			// no selector renders the config as is
+			expectedLevel: auditv1.LevelNone,
+		},
+	} {
+		auditPolicyConfig, err := safe.StateGetByID[*k8s.AuditPolicyConfig](suite.Ctx(), suite.State(), k8s.AuditPolicyConfigID)
+		suite.Require().NoError(err)
+
+		auditPolicyConfig.TypedSpec().Config = policy(string(auditv1.LevelNone))
+		auditPolicyConfig.TypedSpec().Policies = []k8s.AuditPolicyNamedSpec{
+			{
+				Name:   "verbose",
+				Config: policy(string(auditv1.LevelRequestResponse)),
+			},
+			{
+				Name:   "quiet",
+				Config: policy(string(auditv1.LevelMetadata)),
+			},
+		}
+		auditPolicyConfig.TypedSpec().ActivePolicy = test.activePolicy
+		suite.Update(auditPolicyConfig)
+
+		configStatus = suite.assertConfigStatusUpdated(configStatus)
+
+		contents, err := os.ReadFile(filepath.Join(suite.apiServerConfigDir, "auditpolicy.yaml"))
+		suite.Require().NoError(err)
+
+		var rendered auditv1.Policy
+
+		suite.Require().NoError(yaml.Unmarshal(contents, &rendered))
+		suite.Require().Len(rendered.Rules, 1)
+		suite.Assert().Equal(test.expectedLevel, rendered.Rules[0].Level, test.activePolicy)
+	}
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestStructuredAuthUnsupported() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	// kube-apiserver 1.29 only supports v1alpha1 structured authentication config
+	authorizationConfig, err := safe.StateGetByID[*k8s.AuthorizationConfig](suite.Ctx(), suite.State(), k8s.AuthorizationConfigID)
+	suite.Require().NoError(err)
+
+	authorizationConfig.TypedSpec().Image = "registry.k8s.io/kube-apiserver:v1.29.0"
+	suite.Update(authorizationConfig)
+
+	configStatus = suite.assertConfigStatusUpdated(configStatus)
+
+	authenticationConfig := k8s.NewAuthenticationConfig(k8s.AuthenticationConfigID)
+	authenticationConfig.TypedSpec().Config = map[string]any{
+		"jwt": []any{
+			map[string]any{
+				"issuer": map[string]any{
+					"url":       "https://issuer.example.com",
+					"audiences": []any{"talos"},
+				},
+				"claimMappings": map[string]any{
+					"username": map[string]any{
+						"claim":  "email",
+						"prefix": "",
+					},
+				},
+			},
+		},
+	}
+	suite.Create(authenticationConfig)
+
+	configStatus = suite.assertConfigStatusUpdated(configStatus)
+
+	// the controller skips the config it can't honor
+	suite.Assert().NoFileExists(filepath.Join(suite.apiServerConfigDir, "authentication-config.yaml"))
+	suite.Assert().FileExists(filepath.Join(suite.apiServerConfigDir, "authorization-config.yaml"))
+
+	for _, test := range []struct {
+		name   string
+		strict bool
+
+		expectedError string
+	}{
+		{
+			name: "warn and skip",
+
+			expectedError: `configuration "authentication-config.yaml" for "kube-apiserver" is not enabled`,
+		},
+		{
+			name:   "fail hard",
+			strict: true,
+
+			expectedError: `error generating configuration "authentication-config.yaml" for "kube-apiserver": kube-apiserver 1.29.0 doesn't support authentication-config.yaml, at least 1.30 is required`,
+		},
+	} {
+		suite.Run(test.name, func() {
+			ctrl := &k8sctrl.RenderConfigsStaticPodController{
+				APIServerConfigDir:   suite.apiServerConfigDir,
+				SchedulerConfigDir:   suite.schedulerConfigDir,
+				StrictStructuredAuth: test.strict,
+			}
+
+			_, _, err := ctrl.RenderOne(suite.Ctx(), suite.State(), "authentication-config.yaml")
+			suite.Require().EqualError(err, test.expectedError)
+		})
+	}
+
+	// webhook authorizers can't fall back to the authorization mode flag
+	authorizationConfig.TypedSpec().Image = "registry.k8s.io/kube-apiserver:v1.28.0"
+	authorizationConfig.TypedSpec().Config = append(authorizationConfig.TypedSpec().Config, k8s.AuthorizationAuthorizersSpec{
+		Type: "Webhook",
+		Name: "webhook",
+		Webhook: map[string]any{
+			"timeout":                    "3s",
+			"subjectAccessReviewVersion": "v1",
+			"matchConditionSubjectAccessReviewVersion": "v1",
+			"failurePolicy": "NoOpinion",
+			"connectionInfo": map[string]any{
+				"type": "InClusterConfig",
+			},
+		},
+	})
+	suite.Update(authorizationConfig)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	suite.Assert().NoFileExists(filepath.Join(suite.apiServerConfigDir, "authorization-config.yaml"))
+
+	_, _, err = (&k8sctrl.RenderConfigsStaticPodController{StrictStructuredAuth: true}).RenderOne(suite.Ctx(), suite.State(), "authorization-config.yaml")
+	suite.Require().ErrorContains(err, "kube-apiserver 1.28.0 doesn't support authorization-config.yaml, at least 1.29 is required")
+
+	// the strict mode is also enabled by the machine config
+	renderPolicy := k8s.NewConfigRenderPolicy()
+	renderPolicy.TypedSpec().StrictStructuredAuth = true
+	suite.Create(renderPolicy)
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.Contains(status.TypedSpec().LastRenderError, "kube-apiserver 1.28.0 doesn't support authentication-config.yaml, at least 1.30 is required")
+	})
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestTrailingNewline() {
+	discovery := k8s.NewServiceAccountIssuerDiscovery()
+	*discovery.TypedSpec() = testServiceAccountIssuerDiscovery(suite.T())
+
+	// the bundle has extra trailing newlines which should be trimmed
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("kubernetes"))
+	suite.Require().NoError(err)
+
+	clientCAConfig := k8s.NewAPIServerClientCAConfig()
+	clientCAConfig.TypedSpec().ClientCA = string(ca.CrtPEM) + "\n\n"
+
+	suite.Create(discovery)
+	suite.Create(clientCAConfig)
+
+	suite.createInputs()
+	suite.assertConfigStatusReady()
+
+	appliedConfigs, err := safe.StateListAll[*k8s.AppliedConfigFile](suite.Ctx(), suite.State())
+	suite.Require().NoError(err)
+	suite.Require().Equal(7, appliedConfigs.Len())
+
+	for appliedConfig := range appliedConfigs.All() {
+		contents, err := os.ReadFile(appliedConfig.TypedSpec().Path)
+		suite.Require().NoError(err)
+
+		suite.Assert().True(bytes.HasSuffix(contents, []byte("\n")), "no trailing newline in %q", appliedConfig.Metadata().ID())
+		suite.Assert().False(bytes.HasSuffix(contents, []byte("\n\n")), "multiple trailing newlines in %q", appliedConfig.Metadata().ID())
+	}
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestAPIServerDisabled() {
+	createRenderConfigsStaticPodConfigInputs(&suite.DefaultSuite)
+
+	ctest.AssertResources(suite, []resource.ID{"scheduler-config.yaml"}, func(*k8s.AppliedConfigFile, *assert.Assertions) {})
+	ctest.AssertNoResource[*k8s.AppliedConfigFile](suite, "auditpolicy.yaml")
+
+	suite.Assert().FileExists(filepath.Join(suite.schedulerConfigDir, "scheduler-config.yaml"))
+	suite.Assert().NoFileExists(filepath.Join(suite.apiServerConfigDir, "auditpolicy.yaml"))
+
+	// the configs are ready for kube-scheduler only
+	var schedulerVersion string
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Ready)
+		asrt.NotEmpty(status.TypedSpec().Version)
+		asrt.Equal([]string{k8s.SchedulerID}, slices.Collect(maps.Keys(status.TypedSpec().PodVersions)))
+
+		schedulerVersion = status.TypedSpec().PodVersions[k8s.SchedulerID]
+	})
+
+	apiServerConfig := k8s.NewAPIServerConfig()
+	apiServerConfig.TypedSpec().Image = "registry.k8s.io/kube-apiserver:v1.33.0"
+	suite.Create(apiServerConfig)
+
+	// kube-scheduler configs are not changed, so its version is kept
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Ready)
+		asrt.NotEmpty(status.TypedSpec().PodVersions[k8s.APIServerID])
+		asrt.Equal(schedulerVersion, status.TypedSpec().PodVersions[k8s.SchedulerID])
+	})
+
+	suite.Assert().FileExists(filepath.Join(suite.apiServerConfigDir, "auditpolicy.yaml"))
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestAppliedConfigFiles() {
+	suite.createInputs()
+	suite.assertConfigStatusReady()
+
+	appliedConfigs, err := safe.StateListAll[*k8s.AppliedConfigFile](suite.Ctx(), suite.State())
+	suite.Require().NoError(err)
+
+	var filenames []string
+
+	for appliedConfig := range appliedConfigs.All() {
+		spec := appliedConfig.TypedSpec()
+		filenames = append(filenames, appliedConfig.Metadata().ID())
+
+		suite.Assert().Equal(appliedConfig.Metadata().ID(), filepath.Base(spec.Path))
+
+		info, err := os.Stat(spec.Path)
+		suite.Require().NoError(err)
+
+		suite.Assert().Equal(spec.Mode, info.Mode())
+		suite.Assert().EqualValues(spec.UID, info.Sys().(*syscall.Stat_t).Uid) //nolint:forcetypeassert
+		suite.Assert().EqualValues(spec.GID, info.Sys().(*syscall.Stat_t).Gid) //nolint:forcetypeassert
+
+		contents, err := os.ReadFile(spec.Path)
+		suite.Require().NoError(err)
+
+		hash := sha256.Sum256(contents)
+		suite.Assert().Equal(hex.EncodeToString(hash[:]), spec.SHA256)
+	}
+
+	suite.Assert().ElementsMatch([]string{
+		"admission-control-config.yaml",
+		"auditpolicy.yaml",
+		"authorization-config.yaml",
+		"scheduler-config.yaml",
+	}, filenames)
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestConfigIndex() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	type indexFile struct {
+		Filename string `json:"filename"`
+		SHA256   string `json:"sha256"`
+	}
+
+	for dir, expectedFiles := range map[string][]string{
+		suite.apiServerConfigDir: {"admission-control-config.yaml", "auditpolicy.yaml", "authorization-config.yaml"},
+		suite.schedulerConfigDir: {"scheduler-config.yaml"},
+	} {
+		contents, err := os.ReadFile(filepath.Join(dir, k8sctrl.ConfigIndexFilename))
+		suite.Require().NoError(err)
+
+		var index struct {
+			Version   string      `json:"version"`
+			Files     []indexFile `json:"files"`
+			Resources []struct {
+				Type    string `json:"type"`
+				ID      string `json:"id"`
+				Version string `json:"version"`
+			} `json:"resources"`
+		}
+
+		suite.Require().NoError(json.Unmarshal(contents, &index))
+
+		suite.Assert().Equal(configStatus.TypedSpec().Version, index.Version)
+		suite.Assert().NotEmpty(index.Resources)
+
+		suite.Assert().Equal(expectedFiles, xslices.Map(index.Files, func(file indexFile) string { return file.Filename }))
+
+		for _, file := range index.Files {
+			rendered, err := os.ReadFile(filepath.Join(dir, file.Filename))
+			suite.Require().NoError(err)
+
+			hash := sha256.Sum256(rendered)
+			suite.Assert().Equal(hex.EncodeToString(hash[:]), file.SHA256, file.Filename)
+		}
+	}
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestRenderEvents() {
+	schedulerConfig := suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	suite.Assert().EventuallyWithT(func(collect *assert.CollectT) {
+		events := suite.events.all()
+		if !assert.NotEmpty(collect, events) {
+			return
+		}
+
+		// the first render writes all the configs, while the following ones have nothing to change
+		assert.True(collect, events[0].GetSuccess())
+		assert.EqualValues(collect, 4, events[0].GetChangedFiles())
+		assert.Equal(collect, configStatus.TypedSpec().Version, events[0].GetVersion())
+		assert.Empty(collect, events[0].GetError())
+
+		for _, event := range events[1:] {
+			assert.True(collect, event.GetSuccess())
+			assert.Zero(collect, event.GetChangedFiles())
+		}
+	}, 10*time.Second, 10*time.Millisecond)
+
+	schedulerConfig.TypedSpec().Config = map[string]any{
+		"parallelism": 2.5,
+	}
+	suite.Update(schedulerConfig)
+
+	suite.Assert().EventuallyWithT(func(collect *assert.CollectT) {
+		events := suite.events.all()
+		event := events[len(events)-1]
+
+		assert.False(collect, event.GetSuccess())
+		assert.Contains(collect, event.GetError(), `error generating configuration "scheduler-config.yaml" for "kube-scheduler"`)
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestConfigStatusHealth() {
+	schedulerConfig := suite.createInputs()
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Ready)
+		asrt.True(status.TypedSpec().Healthy)
+		asrt.Empty(status.TypedSpec().LastRenderError)
+		asrt.False(status.TypedSpec().LastRenderTime.IsZero())
+	})
+
+	schedulerConfig.TypedSpec().Config = map[string]any{
+		"parallelism": 2.5,
+	}
+	suite.Update(schedulerConfig)
+
+	// the previously rendered configs are kept, so the status stays ready
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Ready)
+		asrt.False(status.TypedSpec().Healthy)
+		asrt.Contains(status.TypedSpec().LastRenderError, `error generating configuration "scheduler-config.yaml" for "kube-scheduler"`)
+	})
+
+	schedulerConfig.TypedSpec().Config = map[string]any{
+		"parallelism": 16,
+	}
+	suite.Update(schedulerConfig)
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Ready)
+		asrt.True(status.TypedSpec().Healthy)
+		asrt.Empty(status.TypedSpec().LastRenderError)
+	})
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestComputeConfigVersion() {
+	suite.createInputs()
+
+	konnectivityConfig := k8s.NewKonnectivityServerConfig()
+	konnectivityConfig.TypedSpec().ListenAddress = "127.0.0.1:8131"
+	konnectivityConfig.TypedSpec().AgentNamespace = "kube-system"
+	konnectivityConfig.TypedSpec().AgentServiceAccount = "konnectivity-agent"
+	suite.Create(konnectivityConfig)
+
+	var inputs []resource.Resource
+
+	for _, resourceType := range append(k8s.StaticPodConfigInputTypes(), k8s.APIServerConfigType) {
+		list, err := suite.State().List(suite.Ctx(), resource.NewMetadata(k8s.ControlPlaneNamespaceName, resourceType, "", resource.VersionUndefined))
+		suite.Require().NoError(err)
+
+		inputs = append(inputs, list.Items...)
+	}
+
+	suite.Require().Len(inputs, 6)
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Ready)
+		asrt.Equal(k8sctrl.ComputeConfigVersion(inputs...), status.TypedSpec().Version)
+	})
+
+	slices.Reverse(inputs)
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.Equal(k8sctrl.ComputeConfigVersion(inputs...), status.TypedSpec().Version)
+	})
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestCorrectDrift() {
+	suite.createInputs()
+	suite.assertConfigStatusReady()
+
+	path := filepath.Join(suite.apiServerConfigDir, "auditpolicy.yaml")
+
+	rendered, err := os.ReadFile(path)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(os.WriteFile(path, []byte("apiVersion: audit.k8s.io/v1\nkind: Policy\nrules: []\n"), 0o400))
+
+	suite.AssertWithin(10*time.Second, 100*time.Millisecond, func() error {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(rendered, contents) {
+			return retry.ExpectedErrorf("config drift is not corrected yet")
+		}
+
+		return nil
+	})
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestUnmanagedConfigs() {
+	schedulerConfig := suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("extender"))
+	suite.Require().NoError(err)
+
+	schedulerConfig.TypedSpec().Config["extenders"] = []any{
+		map[string]any{
+			"urlPrefix":  "https://extender.kube-system.svc:8443/scheduler",
+			"filterVerb": "filter",
+			"tlsConfig": map[string]any{
+				"caData": base64.StdEncoding.EncodeToString(ca.CrtPEM),
+			},
+		},
+	}
+	suite.Update(schedulerConfig)
+
+	configStatus = suite.assertConfigStatusUpdated(configStatus)
+
+	auditPolicyPath := filepath.Join(suite.apiServerConfigDir, "auditpolicy.yaml")
+	extenderCAPath := filepath.Join(suite.schedulerConfigDir, "extender-0-ca.crt")
+
+	for _, path := range []string{auditPolicyPath, extenderCAPath} {
+		suite.Require().NoError(os.WriteFile(path+k8sctrl.UnmanagedMarkerSuffix, nil, 0o600))
+	}
+
+	operatorPolicy := []byte("apiVersion: audit.k8s.io/v1\nkind: Policy\nrules: []\n")
+
+	suite.Require().NoError(os.WriteFile(auditPolicyPath, operatorPolicy, 0o400))
+
+	// the extender is removed, so that its CA is not rendered anymore
+	delete(schedulerConfig.TypedSpec().Config, "extenders")
+	suite.Update(schedulerConfig)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	contents, err := os.ReadFile(auditPolicyPath)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(string(operatorPolicy), string(contents))
+
+	contents, err = os.ReadFile(extenderCAPath)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(string(ca.CrtPEM), string(contents))
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestConfigValidationWarnings() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	ctest.AssertNoResource[*k8s.ConfigValidationWarnings](suite, "authorization-config.yaml")
+
+	authorizationConfig, err := safe.ReaderGetByID[*k8s.AuthorizationConfig](suite.Ctx(), suite.State(), k8s.AuthorizationConfigID)
+	suite.Require().NoError(err)
+
+	webhook := map[string]any{
+		"timeout":                    "20s",
+		"subjectAccessReviewVersion": "v1",
+		"matchConditionSubjectAccessReviewVersion": "v1",
+		"failurePolicy": "NoOpinion",
+		"connectionInfo": map[string]any{
+			"type": "InClusterConfig",
+		},
+	}
+
+	authorizationConfig.TypedSpec().Config = []k8s.AuthorizationAuthorizersSpec{
+		{
+			Type: "Node",
+			Name: "node",
+		},
+		{
+			Type:    "Webhook",
+			Name:    "webhook",
+			Webhook: webhook,
+		},
+		{
+			Type: "RBAC",
+			Name: "rbac",
+		},
+	}
+	suite.Update(authorizationConfig)
+
+	configStatus = suite.assertConfigStatusUpdated(configStatus)
+
+	// the suspicious timeout is valid, so the config is still rendered
+	contents, err := os.ReadFile(filepath.Join(suite.apiServerConfigDir, "authorization-config.yaml"))
+	suite.Require().NoError(err)
+	suite.Assert().Contains(string(contents), "timeout: 20s")
+
+	ctest.AssertResource(suite, "authorization-config.yaml", func(res *k8s.ConfigValidationWarnings, asrt *assert.Assertions) {
+		asrt.Equal([]k8s.ConfigValidationWarningSpec{
+			{
+				Field:   "cluster.apiServer.authorizationConfig[1].webhook.timeout",
+				Message: "authorizer webhook timeout is outside of the recommended range",
+			},
+		}, res.TypedSpec().Warnings)
+	})
+
+	ctest.AssertNoResource[*k8s.ConfigValidationWarnings](suite, "auditpolicy.yaml")
+
+	// warnings are removed once the config is fixed
+	webhook["timeout"] = "3s"
+	suite.Update(authorizationConfig)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	ctest.AssertNoResource[*k8s.ConfigValidationWarnings](suite, "authorization-config.yaml")
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestEffectiveAdmissionPlugins() {
+	suite.createInputs()
+	suite.assertConfigStatusReady()
+
+	admissionConfig, err := safe.StateGetByID[*k8s.AdmissionControlConfig](suite.Ctx(), suite.State(), k8s.AdmissionControlConfigID)
+	suite.Require().NoError(err)
+
+	admissionConfig.TypedSpec().Config = append(admissionConfig.TypedSpec().Config, k8s.AdmissionPluginSpec{
+		Name: "EventRateLimit",
+	})
+	suite.Update(admissionConfig)
+
+	ctest.AssertResource(suite, k8s.EffectiveAdmissionPluginsID, func(plugins *k8s.EffectiveAdmissionPlugins, asrt *assert.Assertions) {
+		asrt.Equal([]k8s.EffectiveAdmissionPluginSpec{
+			{
+				Name:       "PodSecurity",
+				Configured: true,
+			},
+			{
+				Name: "EventRateLimit",
+			},
+		}, plugins.TypedSpec().Plugins)
+	})
+
+	contents, err := os.ReadFile(filepath.Join(suite.apiServerConfigDir, "admission-control-config.yaml"))
+	suite.Require().NoError(err)
+
+	var rendered apiserverv1.AdmissionConfiguration
+
+	suite.Require().NoError(yaml.Unmarshal(contents, &rendered))
+	suite.Assert().Equal([]string{"PodSecurity", "EventRateLimit"}, xslices.Map(rendered.Plugins, func(plugin apiserverv1.AdmissionPluginConfiguration) string {
+		return plugin.Name
+	}))
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestStrictAdmissionPlugins() {
+	suite.createInputs()
+	suite.assertConfigStatusReady()
+
+	admissionConfig, err := safe.StateGetByID[*k8s.AdmissionControlConfig](suite.Ctx(), suite.State(), k8s.AdmissionControlConfigID)
+	suite.Require().NoError(err)
+
+	// unknown plugins are only a warning by default
+	admissionConfig.TypedSpec().Config = append(admissionConfig.TypedSpec().Config, k8s.AdmissionPluginSpec{
+		Name: "PodSecurityPolicyy",
+	})
+	suite.Update(admissionConfig)
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Healthy)
+		asrt.Empty(status.TypedSpec().LastRenderError)
+	})
+
+	renderPolicy := k8s.NewConfigRenderPolicy()
+	renderPolicy.TypedSpec().StrictAdmissionPlugins = true
+	suite.Create(renderPolicy)
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.Contains(status.TypedSpec().LastRenderError, `unknown admission plugin "PodSecurityPolicyy"`)
+	})
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestAdmissionPluginRawConfiguration() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	admissionConfig, err := safe.StateGetByID[*k8s.AdmissionControlConfig](suite.Ctx(), suite.State(), k8s.AdmissionControlConfigID)
+	suite.Require().NoError(err)
+
+	rawConfiguration := `# keep in sync with the cluster policy
+apiVersion: pod-security.admission.config.k8s.io/v1
+kind: PodSecurityConfiguration
+defaults:
+  enforce: baseline # restricted breaks the CNI
+exemptions:
+  namespaces:
+    - kube-system
+`
+
+	admissionConfig.TypedSpec().Config = []k8s.AdmissionPluginSpec{
+		{
+			Name:             "PodSecurity",
+			RawConfiguration: rawConfiguration,
+		},
+	}
+	suite.Update(admissionConfig)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	contents, err := os.ReadFile(filepath.Join(suite.apiServerConfigDir, "admission-podsecurity.yaml"))
+	suite.Require().NoError(err)
+	suite.Assert().Contains(string(contents), rawConfiguration)
+
+	contents, err = os.ReadFile(filepath.Join(suite.apiServerConfigDir, "admission-control-config.yaml"))
+	suite.Require().NoError(err)
+
+	var rendered apiserverv1.AdmissionConfiguration
+
+	suite.Require().NoError(yaml.Unmarshal(contents, &rendered))
+	suite.Assert().Equal([]apiserverv1.AdmissionPluginConfiguration{
+		{
+			Name: "PodSecurity",
+			Path: "admission-podsecurity.yaml",
+		},
+	}, rendered.Plugins)
+
+	ctest.AssertResource(suite, k8s.EffectiveAdmissionPluginsID, func(plugins *k8s.EffectiveAdmissionPlugins, asrt *assert.Assertions) {
+		asrt.Equal([]k8s.EffectiveAdmissionPluginSpec{
+			{
+				Name:       "PodSecurity",
+				Configured: true,
+			},
+		}, plugins.TypedSpec().Plugins)
+	})
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestConfigDirMode() {
+	suite.createInputs()
+	suite.assertConfigStatusReady()
+
+	st, err := os.Stat(suite.apiServerConfigDir)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(os.FileMode(0o700), st.Mode().Perm())
+	suite.Assert().EqualValues(constants.KubernetesAPIServerRunUser, st.Sys().(*syscall.Stat_t).Uid) //nolint:forcetypeassert
+
+	st, err = os.Stat(suite.schedulerConfigDir)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(os.FileMode(0o755), st.Mode().Perm())
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestReconcileCount() {
+	schedulerConfig := suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	suite.Assert().NotZero(configStatus.TypedSpec().ReconcileCount)
+	suite.Assert().False(configStatus.TypedSpec().LastReconcileTime.IsZero())
+
+	suite.Update(schedulerConfig)
+
+	updated := suite.assertConfigStatusUpdated(configStatus)
+
+	suite.Assert().Greater(updated.TypedSpec().ReconcileCount, configStatus.TypedSpec().ReconcileCount)
+	suite.Assert().True(updated.TypedSpec().LastReconcileTime.After(configStatus.TypedSpec().LastReconcileTime))
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestGeneratedHeader() {
+	schedulerConfig := suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	path := filepath.Join(suite.apiServerConfigDir, "auditpolicy.yaml")
+
+	contents, err := os.ReadFile(path)
+	suite.Require().NoError(err)
+
+	header, body, _ := bytes.Cut(contents, []byte("\n"))
+	suite.Assert().Equal("# Generated by Talos RenderConfigsStaticPodController at "+version.Tag, string(header))
+	suite.Assert().Contains(string(body), "kind: Policy")
+
+	// the header is ignored when comparing with the rendered config, so a different header should not cause a rewrite
+	// the file is swapped in, as the watcher would otherwise see it truncated and correct the drift
+	contents = append([]byte("# Generated by Talos RenderConfigsStaticPodController at v1.0.0\n"), body...)
+	suite.Require().NoError(os.WriteFile(path+".tmp", contents, 0o400))
+	suite.Require().NoError(os.Rename(path+".tmp", path))
+
+	schedulerConfig.TypedSpec().Image = "registry.k8s.io/kube-scheduler:v1.33.0"
+	suite.Update(schedulerConfig)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	onDisk, err := os.ReadFile(path)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(contents, onDisk)
+
+	// staging files are swapped in
+	entries, err := os.ReadDir(suite.apiServerConfigDir)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(
+		[]string{"admission-control-config.yaml", "auditpolicy.yaml", "authorization-config.yaml", k8sctrl.ConfigIndexFilename, k8sctrl.ConfigLockFilename},
+		xslices.Map(entries, os.DirEntry.Name),
+	)
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestSELinuxLabel() {
+	if !selinux.IsEnabled() {
+		suite.T().Skip("SELinux is not enabled")
+	}
+
+	suite.createInputs()
+	suite.assertConfigStatusReady()
+
+	for path, expectedLabel := range map[string]string{
+		filepath.Join(suite.apiServerConfigDir, "auditpolicy.yaml"):      constants.KubernetesAPIServerConfigDirSELinuxLabel,
+		filepath.Join(suite.schedulerConfigDir, "scheduler-config.yaml"): constants.KubernetesSchedulerConfigDirSELinuxLabel,
+	} {
+		label, err := xattr.LGet(path, "security.selinux")
+		suite.Require().NoError(err)
+		suite.Assert().Equal(expectedLabel, string(bytes.TrimRight(label, "\x00")))
+	}
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestBackupPreviousConfigs() {
+	schedulerConfig := suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	path := filepath.Join(suite.schedulerConfigDir, "scheduler-config.yaml")
+
+	suite.Assert().NoFileExists(path + ".bak")
+
+	previous, err := os.ReadFile(path)
+	suite.Require().NoError(err)
+
+	schedulerConfig.TypedSpec().Config = map[string]any{
+		"percentageOfNodesToScore": 50,
+	}
+	suite.Update(schedulerConfig)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	contents, err := os.ReadFile(path)
+	suite.Require().NoError(err)
+	suite.Assert().Contains(string(contents), "percentageOfNodesToScore: 50")
+
+	backup, err := os.ReadFile(path + ".bak")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(previous, backup)
+
+	st, err := os.Stat(path + ".bak")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(os.FileMode(0o400), st.Mode().Perm())
+}
+
+// largeConfigSize is the config size above which the suite controller compresses the backups.
+const largeConfigSize = 16 << 10
+
+func (suite *RenderConfigsStaticPodSuite) TestCompressedBackups() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	path := filepath.Join(suite.apiServerConfigDir, "auditpolicy.yaml")
+
+	updateAuditPolicy := func(rules int) []byte {
+		auditPolicyConfig, err := safe.StateGetByID[*k8s.AuditPolicyConfig](suite.Ctx(), suite.State(), k8s.AuditPolicyConfigID)
+		suite.Require().NoError(err)
+
+		policyRules := make([]any, 0, rules)
+
+		for i := range rules {
+			policyRules = append(policyRules, map[string]any{
+				"level":      "Metadata",
+				"namespaces": []any{fmt.Sprintf("tenant-%d", i)},
+				"resources": []any{
+					map[string]any{
+						"group":     "",
+						"resources": []any{"configmaps", "secrets"},
+					},
+				},
+			})
+		}
+
+		auditPolicyConfig.TypedSpec().Config = map[string]any{
+			"apiVersion": "audit.k8s.io/v1",
+			"kind":       "Policy",
+			"rules":      policyRules,
+		}
+		suite.Update(auditPolicyConfig)
+
+		configStatus = suite.assertConfigStatusUpdated(configStatus)
+
+		contents, err := os.ReadFile(path)
+		suite.Require().NoError(err)
+
+		return contents
+	}
+
+	large := updateAuditPolicy(1000)
+	suite.Require().Greater(len(large), largeConfigSize)
+
+	// the backup of the large policy is compressed
+	updateAuditPolicy(999)
+
+	suite.Assert().NoFileExists(path + ".bak")
+
+	f, err := os.Open(path + ".bak.gz")
+	suite.Require().NoError(err)
+
+	defer f.Close() //nolint:errcheck
+
+	zr, err := gzip.NewReader(f)
+	suite.Require().NoError(err)
+
+	backup, err := io.ReadAll(zr)
+	suite.Require().NoError(err)
+	suite.Require().NoError(zr.Close())
+
+	suite.Assert().Equal(string(large), string(backup))
+	suite.Assert().Equal("auditpolicy.yaml", zr.Name)
+
+	st, err := os.Stat(path + ".bak.gz")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(os.FileMode(0o400), st.Mode().Perm())
+	suite.Assert().Less(st.Size(), int64(len(large)))
+
+	// small configs are backed up as is, replacing the compressed backup
+	small := updateAuditPolicy(1)
+	updateAuditPolicy(2)
+
+	suite.Assert().NoFileExists(path + ".bak.gz")
+
+	backup, err = os.ReadFile(path + ".bak")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(string(small), string(backup))
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestConfigNamingPolicy() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	original, err := os.ReadFile(filepath.Join(suite.apiServerConfigDir, "auditpolicy.yaml"))
+	suite.Require().NoError(err)
+
+	naming := k8s.NewConfigNamingPolicy()
+	naming.TypedSpec().Filenames = map[string]string{
+		"auditpolicy.yaml": "audit-policy-v2.yaml",
+	}
+	suite.Create(naming)
+
+	configStatus = suite.assertConfigStatusUpdated(configStatus)
+
+	// the config is moved to the new name
+	renamed, err := os.ReadFile(filepath.Join(suite.apiServerConfigDir, "audit-policy-v2.yaml"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(string(original), string(renamed))
+
+	suite.Assert().NoFileExists(filepath.Join(suite.apiServerConfigDir, "auditpolicy.yaml"))
+
+	ctest.AssertResource(suite, "auditpolicy.yaml", func(appliedConfig *k8s.AppliedConfigFile, asrt *assert.Assertions) {
+		asrt.Equal(filepath.Join(suite.apiServerConfigDir, "audit-policy-v2.yaml"), appliedConfig.TypedSpec().Path)
+	})
+
+	// the config can't be renamed out of the config directory
+	naming.TypedSpec().Filenames["auditpolicy.yaml"] = "../auditpolicy.yaml"
+	suite.Update(naming)
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.False(status.TypedSpec().Healthy)
+		asrt.Contains(status.TypedSpec().LastRenderError, `config naming policy: invalid filename "../auditpolicy.yaml" for configuration "auditpolicy.yaml"`)
+	})
+
+	// nor clash with another config
+	naming.TypedSpec().Filenames["auditpolicy.yaml"] = "authorization-config.yaml"
+	suite.Update(naming)
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.False(status.TypedSpec().Healthy)
+		asrt.Contains(status.TypedSpec().LastRenderError, `are both named "authorization-config.yaml"`)
+	})
+
+	// once the policy is removed, the default name is restored
+	suite.Destroy(naming)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	suite.Assert().FileExists(filepath.Join(suite.apiServerConfigDir, "auditpolicy.yaml"))
+	suite.Assert().NoFileExists(filepath.Join(suite.apiServerConfigDir, "audit-policy-v2.yaml"))
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestConfigNamingPolicyAdmissionPluginRawConfiguration() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	admissionConfig, err := safe.StateGetByID[*k8s.AdmissionControlConfig](suite.Ctx(), suite.State(), k8s.AdmissionControlConfigID)
+	suite.Require().NoError(err)
+
+	admissionConfig.TypedSpec().Config = []k8s.AdmissionPluginSpec{
+		{
+			Name:             "PodSecurity",
+			RawConfiguration: "apiVersion: pod-security.admission.config.k8s.io/v1\nkind: PodSecurityConfiguration\n",
+		},
+	}
+	suite.Update(admissionConfig)
+
+	naming := k8s.NewConfigNamingPolicy()
+	naming.TypedSpec().Filenames = map[string]string{
+		"admission-podsecurity.yaml": "pod-security.yaml",
+	}
+	suite.Create(naming)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	suite.Assert().FileExists(filepath.Join(suite.apiServerConfigDir, "pod-security.yaml"))
+	suite.Assert().NoFileExists(filepath.Join(suite.apiServerConfigDir, "admission-podsecurity.yaml"))
+
+	// the admission control config references the raw plugin configuration by its new name
+	contents, err := os.ReadFile(filepath.Join(suite.apiServerConfigDir, "admission-control-config.yaml"))
+	suite.Require().NoError(err)
+
+	var rendered apiserverv1.AdmissionConfiguration
+
+	suite.Require().NoError(yaml.Unmarshal(contents, &rendered))
+	suite.Assert().Equal([]apiserverv1.AdmissionPluginConfiguration{
+		{
+			Name: "PodSecurity",
+			Path: "pod-security.yaml",
+		},
+	}, rendered.Plugins)
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestStagingDir() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	// the configs of different pods can have the same filename, so they can't share the staging file
+	naming := k8s.NewConfigNamingPolicy()
+	naming.TypedSpec().Filenames = map[string]string{
+		"auditpolicy.yaml":      "config.yaml",
+		"scheduler-config.yaml": "config.yaml",
+	}
+	suite.Create(naming)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	contents, err := os.ReadFile(filepath.Join(suite.apiServerConfigDir, "config.yaml"))
+	suite.Require().NoError(err)
+	suite.Assert().Contains(string(contents), "kind: Policy")
+
+	contents, err = os.ReadFile(filepath.Join(suite.schedulerConfigDir, "config.yaml"))
+	suite.Require().NoError(err)
+	suite.Assert().Contains(string(contents), "kind: KubeSchedulerConfiguration")
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestServiceAccountSigner() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	signerConfig := k8s.NewServiceAccountSignerConfig()
+	signerConfig.TypedSpec().Endpoint = "https://signer.example.com"
+	suite.Create(signerConfig)
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.False(status.TypedSpec().Healthy)
+		asrt.Contains(status.TypedSpec().LastRenderError, `invalid service account signer endpoint "https://signer.example.com"`)
+	})
+
+	signerConfig.TypedSpec().Endpoint = "/var/run/signer/signer.sock"
+	suite.Update(signerConfig)
+
+	configStatus = suite.assertConfigStatusUpdated(configStatus)
+	suite.Assert().True(configStatus.TypedSpec().Healthy)
+
+	ctest.AssertResource(suite, k8s.APIServerID, func(featureGates *k8s.RequiredFeatureGates, asrt *assert.Assertions) {
+		asrt.Equal([]string{"ExternalServiceAccountTokenSigner", "StructuredAuthorizationConfiguration"}, featureGates.TypedSpec().FeatureGates)
+	})
+
+	suite.Destroy(signerConfig)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	ctest.AssertResource(suite, k8s.APIServerID, func(featureGates *k8s.RequiredFeatureGates, asrt *assert.Assertions) {
+		asrt.Equal([]string{"StructuredAuthorizationConfiguration"}, featureGates.TypedSpec().FeatureGates)
+	})
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestSchedulerExtenderTLS() {
+	schedulerConfig := suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("extender"))
+	suite.Require().NoError(err)
+
+	schedulerConfig.TypedSpec().Config["extenders"] = []any{
+		map[string]any{
+			"urlPrefix":  "https://extender.kube-system.svc:8443/scheduler",
+			"filterVerb": "filter",
+			"tlsConfig": map[string]any{
+				"caData":   base64.StdEncoding.EncodeToString(ca.CrtPEM),
+				"certData": base64.StdEncoding.EncodeToString(ca.CrtPEM),
+				"keyData":  base64.StdEncoding.EncodeToString(ca.KeyPEM),
+			},
+		},
+	}
+	suite.Update(schedulerConfig)
+
+	configStatus = suite.assertConfigStatusUpdated(configStatus)
+
+	for filename, expected := range map[string][]byte{
+		"extender-0-ca.crt":     ca.CrtPEM,
+		"extender-0-client.crt": ca.CrtPEM,
+		"extender-0-client.key": ca.KeyPEM,
+	} {
+		contents, err := os.ReadFile(filepath.Join(suite.schedulerConfigDir, filename))
+		suite.Require().NoError(err)
+		suite.Assert().Equal(string(expected), string(contents), filename)
+	}
+
+	contents, err := os.ReadFile(filepath.Join(suite.schedulerConfigDir, "scheduler-config.yaml"))
+	suite.Require().NoError(err)
+
+	suite.Assert().Contains(string(contents), "caFile: "+filepath.Join(suite.schedulerConfigDir, "extender-0-ca.crt"))
+	suite.Assert().Contains(string(contents), "certFile: "+filepath.Join(suite.schedulerConfigDir, "extender-0-client.crt"))
+	suite.Assert().Contains(string(contents), "keyFile: "+filepath.Join(suite.schedulerConfigDir, "extender-0-client.key"))
+	suite.Assert().NotContains(string(contents), "caData")
+	suite.Assert().NotContains(string(contents), "keyData")
+
+	// the extracted files are removed with the extender
+	delete(schedulerConfig.TypedSpec().Config, "extenders")
+	suite.Update(schedulerConfig)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	suite.Assert().NoFileExists(filepath.Join(suite.schedulerConfigDir, "extender-0-ca.crt"))
+	suite.Assert().NoFileExists(filepath.Join(suite.schedulerConfigDir, "extender-0-client.crt"))
+	suite.Assert().NoFileExists(filepath.Join(suite.schedulerConfigDir, "extender-0-client.key"))
+
+	ctest.AssertNoResource[*k8s.AppliedConfigFile](suite, "extender-0-client.key")
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestSchedulerExtenderTLSSecretConfigDir() {
+	schedulerConfig := suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("extender"))
+	suite.Require().NoError(err)
+
+	schedulerConfig.TypedSpec().Config["extenders"] = []any{
+		map[string]any{
+			"urlPrefix":  "https://extender.kube-system.svc:8443/scheduler",
+			"filterVerb": "filter",
+			"tlsConfig": map[string]any{
+				"certData": base64.StdEncoding.EncodeToString(ca.CrtPEM),
+				"keyData":  base64.StdEncoding.EncodeToString(ca.KeyPEM),
+			},
+		},
+	}
+	suite.Update(schedulerConfig)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	// only the private key goes to the secret config directory
+	ctest.AssertResource(suite, "extender-0-client.key", func(appliedConfig *k8s.AppliedConfigFile, asrt *assert.Assertions) {
+		asrt.Equal(filepath.Join(suite.schedulerSecretConfigDir, "extender-0-client.key"), appliedConfig.TypedSpec().Path)
+	})
+
+	suite.Assert().NoFileExists(filepath.Join(suite.schedulerConfigDir, "extender-0-client.key"))
+	suite.Assert().FileExists(filepath.Join(suite.schedulerConfigDir, "extender-0-client.crt"))
+	suite.Assert().NoFileExists(filepath.Join(suite.schedulerSecretConfigDir, "extender-0-client.crt"))
+
+	contents, err := os.ReadFile(filepath.Join(suite.schedulerConfigDir, "scheduler-config.yaml"))
+	suite.Require().NoError(err)
+
+	suite.Assert().Contains(string(contents), "certFile: "+filepath.Join(suite.schedulerConfigDir, "extender-0-client.crt"))
+	suite.Assert().Contains(string(contents), "keyFile: "+filepath.Join(suite.schedulerSecretConfigDir, "extender-0-client.key"))
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestClientCAConfig() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	clientCA, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("kubernetes"))
+	suite.Require().NoError(err)
+
+	frontProxyCA, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("front-proxy"))
+	suite.Require().NoError(err)
+
+	clientCAConfig := k8s.NewAPIServerClientCAConfig()
+	clientCAConfig.TypedSpec().ClientCA = string(clientCA.CrtPEM)
+	clientCAConfig.TypedSpec().RequestHeaderClientCA = string(frontProxyCA.CrtPEM)
+	suite.Create(clientCAConfig)
+
+	configStatus = suite.assertConfigStatusUpdated(configStatus)
+
+	for filename, expected := range map[string][]byte{
+		"client-ca.crt":               clientCA.CrtPEM,
+		"requestheader-client-ca.crt": frontProxyCA.CrtPEM,
+	} {
+		path := filepath.Join(suite.apiServerConfigDir, filename)
+
+		// PEM bundles are written as is, without the generated header
+		contents, err := os.ReadFile(path)
+		suite.Require().NoError(err)
+		suite.Assert().Equal(string(expected), string(contents))
+
+		info, err := os.Stat(path)
+		suite.Require().NoError(err)
+		suite.Assert().Equal(os.FileMode(0o400), info.Mode())
+	}
+
+	// the malformed bundle fails the render, the previously rendered configs are kept
+	clientCAConfig.TypedSpec().RequestHeaderClientCA = "-----BEGIN CERTIFICATE-----\nbm90IGEgY2VydA==\n-----END CERTIFICATE-----\n"
+	suite.Update(clientCAConfig)
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.False(status.TypedSpec().Healthy)
+		asrt.Contains(status.TypedSpec().LastRenderError,
+			`error generating configuration "requestheader-client-ca.crt" for "kube-apiserver": error parsing CA bundle: x509: malformed certificate`)
+	})
+
+	contents, err := os.ReadFile(filepath.Join(suite.apiServerConfigDir, "requestheader-client-ca.crt"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(string(frontProxyCA.CrtPEM), string(contents))
+
+	// the bundle is removed once it's not set
+	clientCAConfig.TypedSpec().RequestHeaderClientCA = ""
+	suite.Update(clientCAConfig)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	suite.Assert().FileExists(filepath.Join(suite.apiServerConfigDir, "client-ca.crt"))
+	suite.Assert().NoFileExists(filepath.Join(suite.apiServerConfigDir, "requestheader-client-ca.crt"))
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestServiceAccountIssuerDiscovery() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	discoveryPath := filepath.Join(suite.apiServerConfigDir, "openid-configuration.json")
+	jwksPath := filepath.Join(suite.apiServerConfigDir, "jwks.json")
+
+	suite.Assert().NoFileExists(discoveryPath)
+	suite.Assert().NoFileExists(jwksPath)
+
+	discovery := k8s.NewServiceAccountIssuerDiscovery()
+	*discovery.TypedSpec() = testServiceAccountIssuerDiscovery(suite.T())
+	suite.Create(discovery)
+
+	configStatus = suite.assertConfigStatusUpdated(configStatus)
+
+	for _, path := range []string{discoveryPath, jwksPath} {
+		contents, err := os.ReadFile(path)
+		suite.Require().NoError(err)
+
+		// JSON documents don't get the generated header
+		suite.Assert().True(json.Valid(contents), "invalid JSON in %q", path)
+	}
+
+	suite.Destroy(discovery)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	suite.Assert().NoFileExists(discoveryPath)
+	suite.Assert().NoFileExists(jwksPath)
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestSecretReferences() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	path := filepath.Join(suite.apiServerConfigDir, "authentication-config.yaml")
+
+	authenticationConfig := k8s.NewAuthenticationConfig(k8s.AuthenticationConfigID)
+	authenticationConfig.TypedSpec().Config = map[string]any{
+		"jwt": []any{
+			map[string]any{
+				"issuer": map[string]any{
+					"url":       "https://issuer.example.com",
+					"audiences": []any{"${secret:oidc/audience}"},
+				},
+				"claimMappings": map[string]any{
+					"username": map[string]any{
+						"claim":  "email",
+						"prefix": "",
+					},
+				},
+			},
+		},
+	}
+
+	// the secret is created first, as dangling references fail the render
+	secret := secrets.NewConfigSecret("oidc")
+	secret.TypedSpec().Data = map[string]string{
+		"audience": "talos-cluster",
+	}
+	suite.Create(secret)
+	suite.Create(authenticationConfig)
+
+	configStatus = suite.assertConfigStatusUpdated(configStatus)
+
+	contents, err := os.ReadFile(path)
+	suite.Require().NoError(err)
+	suite.Assert().Contains(string(contents), "- talos-cluster")
+	suite.Assert().NotContains(string(contents), "${secret:")
+
+	// the secret value is not stored in the config resource
+	ctest.AssertResource(suite, k8s.AuthenticationConfigID, func(res *k8s.AuthenticationConfig, asrt *assert.Assertions) {
+		asrt.Equal([]any{"${secret:oidc/audience}"}, res.TypedSpec().Config["jwt"].([]any)[0].(map[string]any)["issuer"].(map[string]any)["audiences"]) //nolint:forcetypeassert
+	})
+
+	secret.TypedSpec().Data["audience"] = "talos-cluster-2"
+	suite.Update(secret)
+
+	suite.assertConfigStatusUpdated(configStatus)
+
+	contents, err = os.ReadFile(path)
+	suite.Require().NoError(err)
+	suite.Assert().Contains(string(contents), "- talos-cluster-2")
+}
+
+// testJWTAuthenticator returns the unstructured JWT authenticator of the issuer without the audiences.
+func testJWTAuthenticator(issuerURL, usernamePrefix string) map[string]any {
+	return map[string]any{
+		"issuer": map[string]any{
+			"url": issuerURL,
+		},
+		"claimMappings": map[string]any{
+			"username": map[string]any{
+				"claim":  "email",
+				"prefix": usernamePrefix,
+			},
+		},
+	}
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestMergeAuthenticationConfigs() {
+	suite.createInputs()
+	configStatus := suite.assertConfigStatusReady()
+
+	platformAuthenticator := testJWTAuthenticator("https://platform.example.com", "platform:")
+	platformAuthenticator["issuer"].(map[string]any)["audiences"] = []any{"talos"} //nolint:forcetypeassert
+
+	platformConfig := k8s.NewAuthenticationConfig(k8s.AuthenticationConfigID)
+	platformConfig.TypedSpec().Config = map[string]any{
+		"jwt": []any{platformAuthenticator},
+	}
+
+	// the authenticator of the second config only renders with the override, which should follow it to the merged index
+	teamConfig := k8s.NewAuthenticationConfig("team-a")
+	teamConfig.TypedSpec().Config = map[string]any{
+		"jwt": []any{testJWTAuthenticator("https://team-a.example.com", "team-a:")},
+	}
+	teamConfig.TypedSpec().ValidationOverrides = []string{"jwt[0].issuer.audiences"}
+
+	suite.Create(platformConfig)
+	suite.Create(teamConfig)
+
+	// the configs are merged in the order of their IDs
+	suite.Assert().EventuallyWithT(func(collect *assert.CollectT) {
+		contents, err := os.ReadFile(filepath.Join(suite.apiServerConfigDir, "authentication-config.yaml"))
+		if !assert.NoError(collect, err) {
+			return
+		}
+
+		var cfg apiserverv1beta1.AuthenticationConfiguration
+
+		if !assert.NoError(collect, yaml.Unmarshal(contents, &cfg)) {
+			return
+		}
+
+		assert.Equal(collect,
+			[]string{"https://platform.example.com", "https://team-a.example.com"},
+			xslices.Map(cfg.JWT, func(jwt apiserverv1beta1.JWTAuthenticator) string { return jwt.Issuer.URL }),
+		)
+	}, 10*time.Second, 10*time.Millisecond)
+
+	suite.Assert().True(suite.assertConfigStatusUpdated(configStatus).TypedSpec().Healthy)
+
+	// each JWT issuer should only be defined in one of the configs
+	conflictingAuthenticator := testJWTAuthenticator("https://team-a.example.com", "team-b:")
+	conflictingAuthenticator["issuer"].(map[string]any)["audiences"] = []any{"talos"} //nolint:forcetypeassert
+
+	conflictingConfig := k8s.NewAuthenticationConfig("team-b")
+	conflictingConfig.TypedSpec().Config = map[string]any{
+		"jwt": []any{conflictingAuthenticator},
+	}
+	suite.Create(conflictingConfig)
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.False(status.TypedSpec().Healthy)
+		asrt.Contains(status.TypedSpec().LastRenderError,
+			`error merging authentication configs: JWT issuer "https://team-a.example.com" is defined in both authentication configs "team-a" and "team-b"`)
+	})
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestRequiredFeatureGates() {
+	suite.createInputs()
+
+	ctest.AssertResource(suite, k8s.APIServerID, func(featureGates *k8s.RequiredFeatureGates, asrt *assert.Assertions) {
+		asrt.Equal([]string{"StructuredAuthorizationConfiguration"}, featureGates.TypedSpec().FeatureGates)
+	})
+
+	ctest.AssertResource(suite, k8s.SchedulerID, func(featureGates *k8s.RequiredFeatureGates, asrt *assert.Assertions) {
+		asrt.Empty(featureGates.TypedSpec().FeatureGates)
+	})
+
+	authenticationConfig := k8s.NewAuthenticationConfig(k8s.AuthenticationConfigID)
+	authenticationConfig.TypedSpec().Config = map[string]any{
+		"jwt": []any{
+			map[string]any{
+				"issuer": map[string]any{
+					"url":       "https://issuer.example.com",
+					"audiences": []any{"talos"},
+				},
+				"claimMappings": map[string]any{
+					"username": map[string]any{
+						"claim":  "email",
+						"prefix": "",
+					},
+				},
+			},
+		},
+	}
+	suite.Create(authenticationConfig)
+
+	ctest.AssertResource(suite, k8s.APIServerID, func(featureGates *k8s.RequiredFeatureGates, asrt *assert.Assertions) {
+		asrt.Equal([]string{"StructuredAuthenticationConfiguration", "StructuredAuthorizationConfiguration"}, featureGates.TypedSpec().FeatureGates)
+	})
+
+	suite.Destroy(authenticationConfig)
+
+	ctest.AssertResource(suite, k8s.APIServerID, func(featureGates *k8s.RequiredFeatureGates, asrt *assert.Assertions) {
+		asrt.Equal([]string{"StructuredAuthorizationConfiguration"}, featureGates.TypedSpec().FeatureGates)
+	})
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestValidateOnly() {
+	renderPolicy := k8s.NewConfigRenderPolicy()
+	renderPolicy.TypedSpec().ValidateOnly = true
+	suite.Create(renderPolicy)
+
+	schedulerConfig := suite.createInputs()
+
+	var version string
+
+	// nothing is written, so the configs are not ready
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.False(status.TypedSpec().Ready)
+		asrt.Empty(status.TypedSpec().PodVersions)
+		asrt.NotEmpty(status.TypedSpec().Version)
+
+		version = status.TypedSpec().Version
+	})
+
+	schedulerConfig.TypedSpec().Image = "registry.k8s.io/kube-scheduler:v1.33.0"
+	suite.Update(schedulerConfig)
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.NotEqual(version, status.TypedSpec().Version)
+	})
+
+	suite.Assert().NoDirExists(suite.apiServerConfigDir)
+	suite.Assert().NoDirExists(suite.schedulerConfigDir)
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestUnhealthyRenderFailures() {
+	schedulerConfig := suite.createInputs()
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Healthy)
+	})
+
+	schedulerConfig.TypedSpec().Config = map[string]any{
+		"parallelism": 2.5,
+	}
+	suite.Update(schedulerConfig)
+
+	// the failed render is retried by the controller runtime, the failures before the threshold keep the status healthy
+	for failures := range uint64(2) {
+		ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+			asrt.Equal(failures+1, status.TypedSpec().ConsecutiveRenderFailures)
+			asrt.True(status.TypedSpec().Healthy)
+			asrt.Contains(status.TypedSpec().LastRenderError, `error generating configuration "scheduler-config.yaml" for "kube-scheduler"`)
+		})
+	}
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.EqualValues(3, status.TypedSpec().ConsecutiveRenderFailures)
+		asrt.False(status.TypedSpec().Healthy)
+	})
+
+	schedulerConfig.TypedSpec().Config = map[string]any{
+		"parallelism": 16,
+	}
+	suite.Update(schedulerConfig)
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.Zero(status.TypedSpec().ConsecutiveRenderFailures)
+		asrt.True(status.TypedSpec().Healthy)
+		asrt.Empty(status.TypedSpec().LastRenderError)
+	})
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestInitialRenderFailure() {
+	authenticationConfig := k8s.NewAuthenticationConfig(k8s.AuthenticationConfigID)
+	authenticationConfig.TypedSpec().Config = map[string]any{
+		"jwt": "invalid",
+	}
+	suite.Create(authenticationConfig)
+
+	suite.createInputs()
+
+	suite.Assert().EventuallyWithT(func(collect *assert.CollectT) {
+		events := suite.events.all()
+		if !assert.NotEmpty(collect, events) {
+			return
+		}
+
+		assert.False(collect, events[len(events)-1].GetSuccess())
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// the static pods are started once the ConfigStatus exists, so the failed render doesn't create it
+	ctest.AssertNoResource[*k8s.ConfigStatus](suite, k8s.ConfigStatusStaticPodID)
+
+	suite.Destroy(authenticationConfig)
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Ready)
+		asrt.True(status.TypedSpec().Healthy)
+		asrt.Zero(status.TypedSpec().ConsecutiveRenderFailures)
+	})
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestSecretConfigDir() {
+	suite.createInputs()
+
+	authenticationConfig := k8s.NewAuthenticationConfig(k8s.AuthenticationConfigID)
+	authenticationConfig.TypedSpec().Config = map[string]any{
+		"jwt": []any{
+			map[string]any{
+				"issuer": map[string]any{
+					"url":       "https://issuer.example.com",
+					"audiences": []any{"talos"},
+				},
+				"claimMappings": map[string]any{
+					"username": map[string]any{
+						"claim":  "email",
+						"prefix": "",
+					},
+				},
+			},
+		},
+	}
+	suite.Create(authenticationConfig)
+
+	ctest.AssertResource(suite, "authentication-config.yaml", func(appliedConfig *k8s.AppliedConfigFile, asrt *assert.Assertions) {
+		asrt.Equal(filepath.Join(suite.apiServerSecretConfigDir, "authentication-config.yaml"), appliedConfig.TypedSpec().Path)
+	})
+
+	suite.Assert().FileExists(filepath.Join(suite.apiServerSecretConfigDir, "authentication-config.yaml"))
+	suite.Assert().NoFileExists(filepath.Join(suite.apiServerConfigDir, "authentication-config.yaml"))
+
+	for _, filename := range []string{"admission-control-config.yaml", "auditpolicy.yaml"} {
+		suite.Assert().FileExists(filepath.Join(suite.apiServerConfigDir, filename))
+		suite.Assert().NoFileExists(filepath.Join(suite.apiServerSecretConfigDir, filename))
+	}
+
+	type indexFile struct {
+		Filename string `json:"filename"`
+	}
+
+	// each directory has its own lock, and its own index listing only the configs rendered to it
+	for dir, expectedFiles := range map[string][]string{
+		suite.apiServerConfigDir:       {"admission-control-config.yaml", "auditpolicy.yaml"},
+		suite.apiServerSecretConfigDir: {"authentication-config.yaml", "authorization-config.yaml"},
+	} {
+		suite.Assert().FileExists(filepath.Join(dir, k8sctrl.ConfigLockFilename))
+
+		contents, err := os.ReadFile(filepath.Join(dir, k8sctrl.ConfigIndexFilename))
+		suite.Require().NoError(err)
+
+		var index struct {
+			Files []indexFile `json:"files"`
+		}
+
+		suite.Require().NoError(json.Unmarshal(contents, &index))
+
+		suite.Assert().Equal(expectedFiles, xslices.Map(index.Files, func(file indexFile) string { return file.Filename }), dir)
+	}
+
+	suite.Destroy(authenticationConfig)
+
+	ctest.AssertNoResource[*k8s.AppliedConfigFile](suite, "authentication-config.yaml")
+
+	suite.Assert().NoFileExists(filepath.Join(suite.apiServerSecretConfigDir, "authentication-config.yaml"))
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestMinRenderInterval() {
+	// the configs are written after the start, so the next write is not due before the interval passes since the start
+	start := time.Now()
+
+	schedulerConfig := suite.createInputs()
+
+	var configStatus *k8s.ConfigStatus
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Ready)
+		asrt.Empty(status.TypedSpec().PendingVersion)
+
+		configStatus = status
+	})
+
+	path := filepath.Join(suite.schedulerConfigDir, "scheduler-config.yaml")
+
+	initial, err := os.ReadFile(path)
+	suite.Require().NoError(err)
+
+	for parallelism := range 5 {
+		schedulerConfig.TypedSpec().Config = map[string]any{
+			"parallelism": 10 + parallelism,
+		}
+		suite.Update(schedulerConfig)
+
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	// the renders within the interval only record the pending version
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.NotEmpty(status.TypedSpec().PendingVersion)
+		asrt.Equal(configStatus.TypedSpec().Version, status.TypedSpec().Version)
+		asrt.Greater(status.TypedSpec().ReconcileCount, configStatus.TypedSpec().ReconcileCount)
+	})
+
+	contents, err := os.ReadFile(path)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(string(initial), string(contents))
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.Empty(status.TypedSpec().PendingVersion)
+		asrt.NotEqual(configStatus.TypedSpec().Version, status.TypedSpec().Version)
+	})
+
+	suite.Assert().GreaterOrEqual(time.Since(start), minRenderInterval)
+
+	// the intermediate changes are never written
+	contents, err = os.ReadFile(path)
+	suite.Require().NoError(err)
+	suite.Assert().Contains(string(contents), "parallelism: 14\n")
+}
+
+// decisions returns the traced decisions as the message and the file or input the decision is about.
+func (suite *RenderConfigsStaticPodSuite) decisions() [][2]string {
+	return xslices.Map(suite.traceLogs.FilterField(zap.Bool("trace", true)).All(), func(entry observer.LoggedEntry) [2]string {
+		fields := entry.ContextMap()
+
+		subject, _ := fields["filename"].(string)
+		if subject == "" {
+			subject, _ = fields["type"].(string)
+		}
+
+		return [2]string{entry.Message, subject}
+	})
+}
+
+func (suite *RenderConfigsStaticPodSuite) TestTraceDecisions() {
+	apiServerConfig := k8s.NewAPIServerConfig()
+	apiServerConfig.TypedSpec().Image = "registry.k8s.io/kube-apiserver:v1.33.0"
+	suite.Create(apiServerConfig)
+
+	suite.Require().Eventually(func() bool {
+		return slices.Contains(suite.decisions(), [2]string{"required input is missing", k8s.AdmissionControlConfigType})
+	}, 10*time.Second, 10*time.Millisecond)
+
+	schedulerConfig := createRenderConfigsStaticPodConfigInputs(&suite.DefaultSuite)
+
+	ctest.AssertResource(suite, k8s.ConfigStatusStaticPodID, func(status *k8s.ConfigStatus, asrt *assert.Assertions) {
+		asrt.True(status.TypedSpec().Ready)
+	})
+
+	suite.Assert().Subset(suite.decisions(), [][2]string{
+		{"input found", k8s.SchedulerConfigType},
+		{"optional input is missing", k8s.AuthenticationConfigType},
+		{"config is missing, writing", "auditpolicy.yaml"},
+		{"config is missing, writing", "scheduler-config.yaml"},
+	})
+
+	suite.traceLogs.TakeAll()
+
+	schedulerConfig.TypedSpec().Config = map[string]any{
+		"percentageOfNodesToScore": 50,
+	}
+	suite.Update(schedulerConfig)
+
+	suite.Require().Eventually(func() bool {
+		return slices.Contains(suite.decisions(), [2]string{"config changed, writing", "scheduler-config.yaml"})
+	}, 10*time.Second, 10*time.Millisecond)
+
+	suite.Assert().Subset(suite.decisions(), [][2]string{
+		{"input changed", k8s.SchedulerConfigType},
+		{"input unchanged", k8s.AuditPolicyConfigType},
+		{"config is up to date, skipping", "auditpolicy.yaml"},
+	})
+
+	suite.traceLogs.TakeAll()
+
+	suite.Require().NoError(os.Remove(filepath.Join(suite.schedulerConfigDir, "scheduler-config.yaml")))
+
+	suite.Require().Eventually(func() bool {
+		return slices.Contains(suite.decisions(), [2]string{"config is missing, writing", "scheduler-config.yaml"})
+	}, 10*time.Second, 10*time.Millisecond)
+
+	suite.Assert().Contains(suite.decisions(), [2]string{"reconcile triggered by config change", ""})
+
+	// only the metadata and the paths are traced, never the config contents
+	for _, entry := range suite.traceLogs.All() {
+		for _, value := range entry.ContextMap() {
+			suite.Assert().NotContains(fmt.Sprint(value), "percentageOfNodesToScore", entry.Message)
+		}
+	}
+}
+
+func TestValidateConfigNaming(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		filename string
+
+		expectedError string
+	}{
+		{
+			name:     "valid",
+			filename: "audit-policy-v2.yaml",
+		},
+		{
+			name:          "path",
+			filename:      "../auditpolicy.yaml",
+			expectedError: `config naming policy: invalid filename "../auditpolicy.yaml" for configuration "auditpolicy.yaml"`,
+		},
+		{
+			name:          "hidden",
+			filename:      ".auditpolicy.yaml",
+			expectedError: `config naming policy: invalid filename ".auditpolicy.yaml" for configuration "auditpolicy.yaml"`,
+		},
+		{
+			name:          "index",
+			filename:      k8sctrl.ConfigIndexFilename,
+			expectedError: `config naming policy: invalid filename "talos-config-index.json" for configuration "auditpolicy.yaml"`,
+		},
+		{
+			name:          "lock",
+			filename:      k8sctrl.ConfigLockFilename,
+			expectedError: `config naming policy: invalid filename "talos-config.lock" for configuration "auditpolicy.yaml"`,
+		},
+		{
+			name:          "backup",
+			filename:      "authorization-config.yaml.bak",
+			expectedError: `config naming policy: invalid filename "authorization-config.yaml.bak" for configuration "auditpolicy.yaml"`,
+		},
+		{
+			name:          "compressed backup",
+			filename:      "authorization-config.yaml.bak.gz",
+			expectedError: `config naming policy: invalid filename "authorization-config.yaml.bak.gz" for configuration "auditpolicy.yaml"`,
+		},
+		{
+			name:          "unmanaged marker",
+			filename:      "authorization-config.yaml" + k8sctrl.UnmanagedMarkerSuffix,
+			expectedError: `config naming policy: invalid filename "authorization-config.yaml.unmanaged" for configuration "auditpolicy.yaml"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := k8sctrl.ValidateConfigNaming(
+				[]string{"auditpolicy.yaml", "authorization-config.yaml"},
+				&k8s.ConfigNamingPolicySpec{Filenames: map[string]string{"auditpolicy.yaml": test.filename}},
+				zaptest.NewLogger(t),
+			)
+
+			if test.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedError)
+			}
+		})
+	}
+}
+
+func TestRenderConfigsStaticPodControllerInputs(t *testing.T) {
+	t.Parallel()
+
+	inputs := (&k8sctrl.RenderConfigsStaticPodController{}).Inputs()
+
+	// config secrets referenced from the structured auth configs, the nodename and kube-apiserver config come last
+	configInputs, secretInput, nodenameInput, apiServerInput := inputs[:len(inputs)-3], inputs[len(inputs)-3], inputs[len(inputs)-2], inputs[len(inputs)-1]
+
+	assert.Equal(t,
+		k8s.StaticPodConfigInputTypes(),
+		xslices.Map(configInputs, func(input controller.Input) resource.Type { return input.Type }),
+	)
+
+	for _, input := range configInputs {
+		assert.Equal(t, k8s.ControlPlaneNamespaceName, input.Namespace)
+		assert.Equal(t, controller.InputWeak, input.Kind)
+	}
+
+	assert.Equal(t, controller.Input{
+		Namespace: secrets.NamespaceName,
+		Type:      secrets.ConfigSecretType,
+		Kind:      controller.InputWeak,
+	}, secretInput)
+
+	assert.Equal(t, controller.Input{
+		Namespace: k8s.NamespaceName,
+		Type:      k8s.NodenameType,
+		ID:        optional.Some(k8s.NodenameID),
+		Kind:      controller.InputWeak,
+	}, nodenameInput)
+
+	assert.Equal(t, controller.Input{
+		Namespace: k8s.ControlPlaneNamespaceName,
+		Type:      k8s.APIServerConfigType,
+		ID:        optional.Some(k8s.APIServerConfigID),
+		Kind:      controller.InputWeak,
+	}, apiServerInput)
+}
+
+func TestApplyNodeConfigOverrides(t *testing.T) {
+	t.Parallel()
+
+	configs := func() map[string]func() (runtime.Object, error) {
+		return map[string]func() (runtime.Object, error){
+			"egress-selector-config.yaml": k8sctrl.EgressSelectorConfig(&k8s.KonnectivityServerConfigSpec{
+				ListenAddress:       "/etc/kubernetes/konnectivity-server/konnectivity-server.socket",
+				AgentNamespace:      "kube-system",
+				AgentServiceAccount: "konnectivity-agent",
+			}, testKubeAPIServerVersion),
+			"authentication-config.yaml": func() (runtime.Object, error) {
+				return &apiserverv1beta1.AuthenticationConfiguration{}, nil
+			},
+			"authorization-config.yaml": nil,
+		}
+	}
+
+	for _, test := range []struct {
+		name      string
+		overrides []k8s.NodeConfigFileOverrideSpec
+
+		expectedTransport apiserverv1beta1.Transport
+		expectedError     string
+	}{
+		{
+			name: "no overrides",
+
+			expectedTransport: apiserverv1beta1.Transport{
+				UDS: &apiserverv1beta1.UDSTransport{
+					UDSName: "/etc/kubernetes/konnectivity-server/konnectivity-server.socket",
+				},
+			},
+		},
+		{
+			name: "egress override",
+			overrides: []k8s.NodeConfigFileOverrideSpec{
+				{
+					Filename: "egress-selector-config.yaml",
+					Config: map[string]any{
+						"egressSelections": []any{
+							map[string]any{
+								"name": "cluster",
+								"connection": map[string]any{
+									"proxyProtocol": "GRPC",
+									"transport": map[string]any{
+										"uds": map[string]any{
+											"udsName": "/var/run/konnectivity-server/node-1.socket",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			expectedTransport: apiserverv1beta1.Transport{
+				UDS: &apiserverv1beta1.UDSTransport{
+					UDSName: "/var/run/konnectivity-server/node-1.socket",
+				},
+			},
+		},
+		{
+			name: "authentication override",
+			overrides: []k8s.NodeConfigFileOverrideSpec{
+				{
+					Filename: "authentication-config.yaml",
+					Config:   map[string]any{"anonymous": map[string]any{"enabled": true}},
+				},
+			},
+
+			expectedError: `node "node-1": configuration "authentication-config.yaml" should be identical across control plane nodes`,
+		},
+		{
+			name: "authorization override",
+			overrides: []k8s.NodeConfigFileOverrideSpec{
+				{
+					Filename: "authorization-config.yaml",
+					Config:   map[string]any{"authorizers": []any{}},
+				},
+			},
+
+			expectedError: `node "node-1": configuration "authorization-config.yaml" should be identical across control plane nodes`,
+		},
+		{
+			name: "unknown field",
+			overrides: []k8s.NodeConfigFileOverrideSpec{
+				{
+					Filename: "egress-selector-config.yaml",
+					Config:   map[string]any{"foo": "bar"},
+				},
+			},
+
+			expectedError: `error applying node-specific override: strict decoding error: unknown field "foo"`,
+		},
+		{
+			name: "unknown config",
+			overrides: []k8s.NodeConfigFileOverrideSpec{
+				{
+					Filename: "kubelet-config.yaml",
+				},
+			},
+
+			expectedError: `node "node-1": unknown configuration "kubelet-config.yaml"`,
+		},
+		{
+			name: "duplicate override",
+			overrides: []k8s.NodeConfigFileOverrideSpec{
+				{
+					Filename: "egress-selector-config.yaml",
+				},
+				{
+					Filename: "egress-selector-config.yaml",
+				},
+			},
+
+			expectedError: `node "node-1": duplicate override of configuration "egress-selector-config.yaml"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			override := k8s.NewNodeConfigOverride("node-1")
+			override.TypedSpec().Overrides = test.overrides
+
+			overridden, err := k8sctrl.ApplyNodeConfigOverrides(configs(), override)
+
+			var obj runtime.Object
+
+			if err == nil {
+				obj, err = overridden["egress-selector-config.yaml"]()
+			}
+
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			cfg, ok := obj.(*apiserverv1beta1.EgressSelectorConfiguration)
+			require.True(t, ok)
+			require.Len(t, cfg.EgressSelections, 1)
+			assert.Equal(t, "cluster", cfg.EgressSelections[0].Name)
+			assert.Equal(t, &test.expectedTransport, cfg.EgressSelections[0].Connection.Transport)
+		})
+	}
+}
+
+func TestConfigIntegralFloats(t *testing.T) {
+	t.Parallel()
+
+	// numbers decoded from JSON are float64
+	schedulerCfg, err := k8sctrl.SchedulerConfig(&k8s.SchedulerConfigSpec{
+		Enabled: true,
+		Config: map[string]any{
+			"parallelism":              float64(16),
+			"percentageOfNodesToScore": float64(50),
+		},
+	}, constants.KubernetesSchedulerConfigDir, constants.KubernetesSchedulerSecretConfigDir, nil, zap.NewNop())()
+	require.NoError(t, err)
+
+	contents, err := k8sctrl.EncodeConfig(schedulerCfg)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(contents), "parallelism: 16\n")
+	assert.Contains(t, string(contents), "percentageOfNodesToScore: 50\n")
+
+	admissionCfg, err := k8sctrl.AdmissionControlConfig(&k8s.AdmissionControlConfigSpec{
+		Config: []k8s.AdmissionPluginSpec{
+			{
+				Name: "EventRateLimit",
+				Configuration: map[string]any{
+					"apiVersion": "eventratelimit.admission.k8s.io/v1alpha1",
+					"kind":       "Configuration",
+					"limits": []any{
+						map[string]any{
+							"type":  "Server",
+							"qps":   float64(5),
+							"burst": float64(20),
+						},
+					},
+				},
+			},
+		},
+	}, compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:v1.33.0"), false, nil, zap.NewNop())()
+	require.NoError(t, err)
+
+	raw := admissionCfg.(*apiserverv1.AdmissionConfiguration).Plugins[0].Configuration.Raw //nolint:forcetypeassert
+
+	var limits struct {
+		Limits []map[string]json.RawMessage `json:"limits"`
+	}
+
+	require.NoError(t, json.Unmarshal(raw, &limits))
+	assert.Equal(t, json.RawMessage("5"), limits.Limits[0]["qps"])
+	assert.Equal(t, json.RawMessage("20"), limits.Limits[0]["burst"])
+
+	// non-integral values are still rejected for the integer fields
+	_, err = k8sctrl.SchedulerConfig(&k8s.SchedulerConfigSpec{
+		Enabled: true,
+		Config: map[string]any{
+			"parallelism": 2.5,
+		},
+	}, constants.KubernetesSchedulerConfigDir, constants.KubernetesSchedulerSecretConfigDir, nil, zap.NewNop())()
+	require.Error(t, err)
+}
@@ -0,0 +1,348 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// ValidationSpecs is the set of static pod config specs to validate.
+type ValidationSpecs struct {
+	Admission      *k8s.AdmissionControlConfigSpec
+	Audit          *k8s.AuditPolicyConfigSpec
+	Authentication *k8s.AuthenticationConfigSpec
+	Authorization  *k8s.AuthorizationConfigSpec
+	Scheduler      *k8s.SchedulerConfigSpec
+
+	// Secrets are used to resolve the secret references in the configs.
+	Secrets []*secrets.ConfigSecret
+}
+
+// FileValidationResult is the validation result of a single config file.
+type FileValidationResult struct {
+	Filename string
+	Errors   []error
+	// Warnings are the issues which don't make the config invalid, e.g. the overridden validation errors.
+	Warnings []ValidationWarning
+}
+
+// ValidationWarning is a warning logged while validating the config.
+type ValidationWarning struct {
+	// Field is the path of the field the warning is about, empty if it's not about a single field.
+	Field   string
+	Message string
+}
+
+// Valid returns true if there are no validation errors.
+func (result FileValidationResult) Valid() bool {
+	return len(result.Errors) == 0
+}
+
+// ValidateAll validates all provided specs the way they would be validated when rendering the configs.
+func ValidateAll(specs ValidationSpecs) ([]FileValidationResult, error) {
+	resolver := make(secretResolver, len(specs.Secrets))
+
+	for _, secret := range specs.Secrets {
+		resolver[secret.Metadata().ID()] = secret
+	}
+
+	var kubeAPIServerImage string
+
+	if specs.Authorization != nil {
+		kubeAPIServerImage = specs.Authorization.Image
+	}
+
+	// without the image, the latest Kubernetes version is assumed
+	kubeAPIServerVersion := compatibility.VersionFromImageRef(kubeAPIServerImage)
+
+	// warnings are collected per file, as the configs are rendered one by one
+	warnings := newWarningCollector()
+	logger := zap.New(warnings)
+
+	var configs []configFile
+
+	if specs.Admission != nil {
+		configs = append(configs, configFile{
+			filename: "admission-control-config.yaml",
+			f:        admissionControlConfig(specs.Admission, kubeAPIServerVersion, true, nil, logger),
+		})
+	}
+
+	if specs.Audit != nil {
+		configs = append(configs, configFile{
+			filename: "auditpolicy.yaml",
+			f:        auditPolicyConfig(specs.Audit, auditPolicyFieldPath, logger),
+		})
+	}
+
+	if specs.Authentication != nil {
+		configs = append(configs, configFile{
+			filename: "authentication-config.yaml",
+			f:        authenticationConfig(specs.Authentication, nil, kubeAPIServerVersion, resolver, nil, logger),
+		})
+	}
+
+	if specs.Authorization != nil {
+		configs = append(configs, configFile{
+			filename: "authorization-config.yaml",
+			f:        authorizationConfig(specs.Authorization, authorizationFieldPath, kubeAPIServerVersion, resolver, logger),
+		})
+	}
+
+	if specs.Scheduler != nil {
+		configs = append(configs, configFile{
+			filename: "scheduler-config.yaml",
+			f:        schedulerConfig(specs.Scheduler, constants.KubernetesSchedulerConfigDir, constants.KubernetesSchedulerSecretConfigDir, nil, logger),
+		})
+	}
+
+	results := make([]FileValidationResult, 0, len(configs))
+
+	var errs []error
+
+	for _, configFile := range configs {
+		result := FileValidationResult{
+			Filename: configFile.filename,
+		}
+
+		if _, err := configFile.render(); err != nil {
+			result.Errors = flattenErrors(err)
+
+			errs = append(errs, fmt.Errorf("%s: %w", configFile.filename, err))
+		}
+
+		result.Warnings = warnings.take()
+
+		results = append(results, result)
+	}
+
+	return results, errors.Join(errs...)
+}
+
+// flattenErrors splits the joined errors, so that each issue is reported separately.
+func flattenErrors(err error) []error {
+	joined, ok := err.(interface{ Unwrap() []error }) //nolint:errorlint
+	if !ok {
+		return []error{err}
+	}
+
+	var errs []error
+
+	for _, err := range joined.Unwrap() {
+		errs = append(errs, flattenErrors(err)...)
+	}
+
+	return errs
+}
+
+// ConfigValidator validates the generated config before it is serialized.
+type ConfigValidator func(obj runtime.Object) error
+
+var configValidators = struct {
+	sync.RWMutex
+
+	byType map[reflect.Type][]ConfigValidator
+}{
+	byType: map[reflect.Type][]ConfigValidator{},
+}
+
+// RegisterConfigValidator registers the validator for the configs of type T, e.g. *apiserverv1.AuthorizationConfiguration.
+func RegisterConfigValidator[T runtime.Object](validator func(T) error) {
+	configValidators.Lock()
+	defer configValidators.Unlock()
+
+	typ := reflect.TypeFor[T]()
+
+	configValidators.byType[typ] = append(configValidators.byType[typ], func(obj runtime.Object) error {
+		return validator(obj.(T)) //nolint:forcetypeassert
+	})
+}
+
+// runConfigValidators runs the validators registered for the config type.
+func runConfigValidators(obj runtime.Object) error {
+	configValidators.RLock()
+	validators := configValidators.byType[reflect.TypeOf(obj)]
+	configValidators.RUnlock()
+
+	for _, validator := range validators {
+		if err := validator(obj); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Machine config field paths the static pod config inputs come from, used as hints in validation errors.
+var (
+	auditPolicyFieldPath   = field.NewPath("cluster", "apiServer", "auditPolicy")
+	authorizationFieldPath = field.NewPath("cluster", "apiServer", "authorizationConfig")
+	tracingFieldPath       = field.NewPath("cluster", "apiServer", "tracing")
+)
+
+// fieldPathError is a validation error pointing to the field which should be fixed.
+type fieldPathError struct {
+	path *field.Path
+	err  error
+}
+
+func (e *fieldPathError) Error() string {
+	return e.path.String() + ": " + e.err.Error()
+}
+
+func (e *fieldPathError) Unwrap() error {
+	return e.err
+}
+
+// validationOverrides are the field paths of the validation errors which are explicitly downgraded to warnings.
+type validationOverrides []string
+
+// check returns the validation error, unless its field path is overridden, in which case the error is logged as a warning.
+func (overrides validationOverrides) check(logger *zap.Logger, err error) error {
+	var fieldErr *fieldPathError
+
+	if !errors.As(err, &fieldErr) || !slices.Contains(overrides, fieldErr.path.String()) {
+		return err
+	}
+
+	logger.Warn("config validation error is overridden",
+		zap.String("field", fieldErr.path.String()),
+		zap.String("error", fieldErr.err.Error()),
+	)
+
+	return nil
+}
+
+// fromUnstructuredStrict decodes the config rejecting the unknown fields, except for the allowlisted field paths.
+func fromUnstructuredStrict(config map[string]any, obj any, allowlist []string) error {
+	if err := validateConfigSchema(config, obj); err != nil {
+		return err
+	}
+
+	if len(allowlist) == 0 {
+		return runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(config, obj, true)
+	}
+
+	// normalizing copies the maps and lists, so that the config itself is not modified
+	stripped := normalizeIntegers(config)
+
+	for _, fieldPath := range allowlist {
+		removeFieldPath(stripped, strings.Split(fieldPath, "."))
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(stripped, obj, true); err != nil {
+		return err
+	}
+
+	// allowlisted fields might be known as well, so the config is decoded once again as is
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(config, obj)
+}
+
+func removeFieldPath(value any, fieldPath []string) {
+	switch v := value.(type) {
+	case map[string]any:
+		if len(fieldPath) == 1 {
+			delete(v, fieldPath[0])
+
+			return
+		}
+
+		removeFieldPath(v[fieldPath[0]], fieldPath[1:])
+	case []any:
+		for _, item := range v {
+			removeFieldPath(item, fieldPath)
+		}
+	}
+}
+
+// verifyRoundTrip decodes the encoded config back into its typed object, and checks that it matches the rendered one.
+func verifyRoundTrip(obj runtime.Object, contents []byte) error {
+	switch obj.(type) {
+	case jsonDocument, pemDocument, rawYAMLDocument:
+		return nil
+	}
+
+	decoded, ok := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(runtime.Object)
+	if !ok {
+		return fmt.Errorf("unexpected config type %T", obj)
+	}
+
+	if err := yaml.UnmarshalStrict(contents, decoded); err != nil {
+		return fmt.Errorf("error decoding encoded config: %w", err)
+	}
+
+	expected, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+
+	actual, err := runtime.DefaultUnstructuredConverter.ToUnstructured(decoded)
+	if err != nil {
+		return err
+	}
+
+	if path, differs := firstDifference(expected, actual, ""); differs {
+		return fmt.Errorf("encoded config doesn't decode to the rendered one at %q", cmp.Or(path, "."))
+	}
+
+	return nil
+}
+
+// firstDifference returns the path of the first difference between the unstructured values, if any.
+func firstDifference(expected, actual any, path string) (string, bool) {
+	switch expectedValue := expected.(type) {
+	case map[string]any:
+		actualValue, ok := actual.(map[string]any)
+		if !ok {
+			return path, true
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(expectedValue)) {
+			if diffPath, differs := firstDifference(expectedValue[key], actualValue[key], path+"."+key); differs {
+				return diffPath, true
+			}
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(actualValue)) {
+			if _, ok := expectedValue[key]; !ok {
+				return path + "." + key, true
+			}
+		}
+
+		return "", false
+	case []any:
+		actualValue, ok := actual.([]any)
+		if !ok || len(actualValue) != len(expectedValue) {
+			return path, true
+		}
+
+		for i := range expectedValue {
+			if diffPath, differs := firstDifference(expectedValue[i], actualValue[i], fmt.Sprintf("%s[%d]", path, i)); differs {
+				return diffPath, true
+			}
+		}
+
+		return "", false
+	default:
+		return path, !reflect.DeepEqual(expected, actual)
+	}
+}
@@ -0,0 +1,493 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	apiserverv1beta1 "k8s.io/apiserver/pkg/apis/apiserver/v1beta1"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	schedulerv1 "k8s.io/kube-scheduler/config/v1"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+func TestVerifyRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name  string
+		users []string
+
+		expectedError string
+	}{
+		{
+			name:  "lossless",
+			users: []string{"system:kube-proxy"},
+		},
+		{
+			// invalid UTF-8 is replaced with U+FFFD when encoded
+			name:  "lossy",
+			users: []string{"system:kube-proxy", "admin\xff"},
+
+			expectedError: `encoded config doesn't decode to the rendered one at ".rules[0].users[1]"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy := &auditv1.Policy{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: auditv1.SchemeGroupVersion.String(),
+					Kind:       "Policy",
+				},
+				Rules: []auditv1.PolicyRule{
+					{
+						Level: auditv1.LevelMetadata,
+						Users: test.users,
+					},
+				},
+			}
+
+			err := k8sctrl.VerifyRoundTrip(policy)
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestStrictDecodingAllowlist(t *testing.T) {
+	t.Parallel()
+
+	auditPolicy := map[string]any{
+		"apiVersion": "audit.k8s.io/v1",
+		"kind":       "Policy",
+		"newPolicyField": map[string]any{
+			"enabled": true,
+		},
+		"rules": []any{
+			map[string]any{
+				"level":         "Metadata",
+				"newRuleField":  "foo",
+				"omitStages":    []any{"RequestReceived"},
+				"userGroupsNew": []any{"system:nodes"},
+			},
+		},
+	}
+
+	authentication := map[string]any{
+		"jwt": []any{
+			map[string]any{
+				"issuer": map[string]any{
+					"url":       "https://issuer.example.com",
+					"audiences": []any{"talos"},
+					"newIssuerField": map[string]any{
+						"foo": "bar",
+					},
+				},
+				"claimMappings": map[string]any{
+					"username": map[string]any{
+						"claim":  "email",
+						"prefix": "",
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range []struct {
+		name      string
+		allowlist []string
+		render    func(allowlist []string) (runtime.Object, error)
+
+		expectedError string
+	}{
+		{
+			name: "audit policy without allowlist",
+			render: func(allowlist []string) (runtime.Object, error) {
+				return k8sctrl.AuditPolicyConfig(&k8s.AuditPolicyConfigSpec{
+					Config:                  auditPolicy,
+					StrictDecodingAllowlist: allowlist,
+				}, k8sctrl.AuditPolicyFieldPath, zap.NewNop())()
+			},
+
+			expectedError: `unknown field "newPolicyField"`,
+		},
+		{
+			name:      "audit policy partially allowlisted",
+			allowlist: []string{"newPolicyField", "rules.newRuleField"},
+			render: func(allowlist []string) (runtime.Object, error) {
+				return k8sctrl.AuditPolicyConfig(&k8s.AuditPolicyConfigSpec{
+					Config:                  auditPolicy,
+					StrictDecodingAllowlist: allowlist,
+				}, k8sctrl.AuditPolicyFieldPath, zap.NewNop())()
+			},
+
+			expectedError: `unknown field "rules[0].userGroupsNew"`,
+		},
+		{
+			name:      "audit policy allowlisted",
+			allowlist: []string{"newPolicyField", "rules.newRuleField", "rules.userGroupsNew", "rules.omitStages"},
+			render: func(allowlist []string) (runtime.Object, error) {
+				return k8sctrl.AuditPolicyConfig(&k8s.AuditPolicyConfigSpec{
+					Config:                  auditPolicy,
+					StrictDecodingAllowlist: allowlist,
+				}, k8sctrl.AuditPolicyFieldPath, zap.NewNop())()
+			},
+		},
+		{
+			name: "authentication without allowlist",
+			render: func(allowlist []string) (runtime.Object, error) {
+				return k8sctrl.AuthenticationConfig(&k8s.AuthenticationConfigSpec{
+					Config:                  authentication,
+					StrictDecodingAllowlist: allowlist,
+				}, nil, testKubeAPIServerVersion, nil, nil, zap.NewNop())()
+			},
+
+			expectedError: `unknown field "jwt[0].issuer.newIssuerField"`,
+		},
+		{
+			name:      "authentication with other field allowlisted",
+			allowlist: []string{"jwt.newIssuerField"},
+			render: func(allowlist []string) (runtime.Object, error) {
+				return k8sctrl.AuthenticationConfig(&k8s.AuthenticationConfigSpec{
+					Config:                  authentication,
+					StrictDecodingAllowlist: allowlist,
+				}, nil, testKubeAPIServerVersion, nil, nil, zap.NewNop())()
+			},
+
+			expectedError: `unknown field "jwt[0].issuer.newIssuerField"`,
+		},
+		{
+			name:      "authentication allowlisted",
+			allowlist: []string{"jwt.issuer.newIssuerField"},
+			render: func(allowlist []string) (runtime.Object, error) {
+				return k8sctrl.AuthenticationConfig(&k8s.AuthenticationConfigSpec{
+					Config:                  authentication,
+					StrictDecodingAllowlist: allowlist,
+				}, nil, testKubeAPIServerVersion, nil, nil, zap.NewNop())()
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			obj, err := test.render(test.allowlist)
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			switch cfg := obj.(type) {
+			case *auditv1.Policy:
+				// allowlisted fields known to the config types are still rendered
+				require.Len(t, cfg.Rules, 1)
+				assert.Equal(t, []auditv1.Stage{auditv1.StageRequestReceived}, cfg.Rules[0].OmitStages)
+			case *apiserverv1beta1.AuthenticationConfiguration:
+				require.Len(t, cfg.JWT, 1)
+				assert.Equal(t, "https://issuer.example.com", cfg.JWT[0].Issuer.URL)
+			default:
+				t.Fatalf("unexpected config type %T", obj)
+			}
+
+			// the config itself is not modified
+			assert.Contains(t, auditPolicy, "newPolicyField")
+			assert.Contains(t, auditPolicy["rules"].([]any)[0], "newRuleField") //nolint:forcetypeassert
+		})
+	}
+}
+
+func TestValidationOverrides(t *testing.T) {
+	t.Parallel()
+
+	kubeAPIServerVersion := compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:v1.33.0")
+
+	for _, test := range []struct {
+		name   string
+		render func(overrides []string, logger *zap.Logger) (runtime.Object, error)
+
+		override      string
+		expectedError string
+	}{
+		{
+			name: "authentication",
+			render: func(overrides []string, logger *zap.Logger) (runtime.Object, error) {
+				return k8sctrl.AuthenticationConfig(&k8s.AuthenticationConfigSpec{
+					Config: map[string]any{
+						"jwt": []any{
+							map[string]any{
+								"issuer": map[string]any{
+									"url": "https://issuer.example.com",
+								},
+								"claimMappings": map[string]any{
+									"username": map[string]any{"claim": "email", "prefix": ""},
+								},
+							},
+						},
+					},
+					ValidationOverrides: overrides,
+				}, nil, testKubeAPIServerVersion, nil, nil, logger)()
+			},
+
+			override:      "jwt[0].issuer.audiences",
+			expectedError: `jwt[0].issuer.audiences: JWT authenticator for issuer "https://issuer.example.com": at least one audience should be set`,
+		},
+		{
+			name: "audit policy",
+			render: func(overrides []string, logger *zap.Logger) (runtime.Object, error) {
+				return k8sctrl.AuditPolicyConfig(&k8s.AuditPolicyConfigSpec{
+					Config: map[string]any{
+						"apiVersion": "audit.k8s.io/v1",
+						"kind":       "Policy",
+						"rules": []any{
+							map[string]any{
+								"level": "Metadata",
+								"users": []any{"system:serviceaccounts:kube-system"},
+							},
+						},
+					},
+					ValidationOverrides: overrides,
+				}, k8sctrl.AuditPolicyFieldPath, logger)()
+			},
+
+			override:      "cluster.apiServer.auditPolicy.rules[0].users[0]",
+			expectedError: `cluster.apiServer.auditPolicy.rules[0].users[0]: "system:serviceaccounts:kube-system" is a group, it should be listed in userGroups`,
+		},
+		{
+			name: "authorization",
+			render: func(overrides []string, logger *zap.Logger) (runtime.Object, error) {
+				return k8sctrl.AuthorizationConfig(&k8s.AuthorizationConfigSpec{
+					Config: []k8s.AuthorizationAuthorizersSpec{
+						{Type: "Node", Name: "node"},
+						{Type: "RBAC", Name: "node"},
+					},
+					ValidationOverrides: overrides,
+				}, k8sctrl.AuthorizationFieldPath, kubeAPIServerVersion, nil, logger)()
+			},
+
+			override:      "cluster.apiServer.authorizationConfig[1].name",
+			expectedError: `cluster.apiServer.authorizationConfig[1].name: authorizer name "node" is not unique, it's already used by authorizer 0`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.render(nil, zap.NewNop())
+			require.ErrorContains(t, err, test.expectedError)
+
+			// overrides of the other fields don't affect the error
+			_, err = test.render([]string{"jwt[1].issuer.audiences"}, zap.NewNop())
+			require.ErrorContains(t, err, test.expectedError)
+
+			core, logs := observer.New(zapcore.WarnLevel)
+
+			obj, err := test.render([]string{test.override}, zap.New(core))
+			require.NoError(t, err)
+			assert.NotNil(t, obj)
+
+			overridden := logs.FilterMessage("config validation error is overridden").All()
+			require.Len(t, overridden, 1)
+			assert.Equal(t, test.override, overridden[0].ContextMap()["field"])
+			assert.Contains(t, test.expectedError, overridden[0].ContextMap()["error"])
+		})
+	}
+}
+
+func TestValidateAll(t *testing.T) {
+	t.Parallel()
+
+	specs := k8sctrl.ValidationSpecs{
+		Admission: &k8s.AdmissionControlConfigSpec{
+			Config: []k8s.AdmissionPluginSpec{
+				{
+					Name:          "PodSecurityPolicy",
+					Configuration: map[string]any{},
+				},
+			},
+		},
+		Audit: &k8s.AuditPolicyConfigSpec{
+			Config: map[string]any{
+				"apiVersion": "audit.k8s.io/v1",
+				"kind":       "Policy",
+				"rules": []any{
+					map[string]any{
+						"level": "Everything",
+					},
+				},
+			},
+		},
+		Authentication: &k8s.AuthenticationConfigSpec{
+			Config: map[string]any{
+				"jwt": []any{
+					map[string]any{
+						"issuer": map[string]any{
+							"url": "https://issuer.example.com",
+						},
+						"claimMappings": map[string]any{
+							"username": map[string]any{
+								"claim":  "email",
+								"prefix": "",
+							},
+						},
+					},
+				},
+			},
+		},
+		Authorization: &k8s.AuthorizationConfigSpec{
+			Image: "registry.k8s.io/kube-apiserver:v1.33.0",
+			Config: []k8s.AuthorizationAuthorizersSpec{
+				{
+					Type: "RBAC",
+					Name: "rbac",
+				},
+			},
+		},
+		Scheduler: &k8s.SchedulerConfigSpec{
+			Enabled: true,
+			Config:  map[string]any{},
+		},
+	}
+
+	results, err := k8sctrl.ValidateAll(specs)
+	require.Error(t, err)
+
+	require.Equal(t,
+		[]string{"admission-control-config.yaml", "auditpolicy.yaml", "authentication-config.yaml", "authorization-config.yaml", "scheduler-config.yaml"},
+		xslices.Map(results, func(result k8sctrl.FileValidationResult) string { return result.Filename }),
+	)
+
+	// every invalid config is reported, not just the first one
+	require.Len(t, results[0].Errors, 1)
+	assert.ErrorContains(t, results[0].Errors[0], `admission plugin "PodSecurityPolicy" is not supported by Kubernetes 1.33.0, it was removed in 1.25.0`)
+
+	require.Len(t, results[1].Errors, 1)
+	assert.ErrorContains(t, results[1].Errors[0], `cluster.apiServer.auditPolicy.rules[0].level: unknown audit level "Everything"`)
+
+	require.Len(t, results[2].Errors, 1)
+	assert.EqualError(t, results[2].Errors[0], `jwt[0].issuer.audiences: JWT authenticator for issuer "https://issuer.example.com": at least one audience should be set`)
+
+	assert.True(t, results[3].Valid())
+	assert.True(t, results[4].Valid())
+
+	for _, result := range results[:3] {
+		assert.ErrorContains(t, err, result.Filename+": "+result.Errors[0].Error())
+	}
+
+	// fix all the configs
+	specs.Admission.Config[0].Name = "PodSecurity"
+	specs.Admission.Config[0].Configuration = map[string]any{
+		"apiVersion": "pod-security.admission.config.k8s.io/v1alpha1",
+		"kind":       "PodSecurityConfiguration",
+	}
+	specs.Audit.Config["rules"] = []any{map[string]any{"level": "Metadata"}}
+	specs.Authentication.Config["jwt"].([]any)[0].(map[string]any)["issuer"].(map[string]any)["audiences"] = []any{"talos"} //nolint:forcetypeassert
+
+	results, err = k8sctrl.ValidateAll(specs)
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+
+	for _, result := range results {
+		assert.True(t, result.Valid(), result.Filename)
+	}
+
+	// missing specs are skipped
+	results, err = k8sctrl.ValidateAll(k8sctrl.ValidationSpecs{
+		Scheduler: &k8s.SchedulerConfigSpec{
+			Enabled: true,
+			Config: map[string]any{
+				"extenders": []any{
+					map[string]any{
+						"urlPrefix":  "extender:8888",
+						"filterVerb": "filter",
+					},
+				},
+			},
+		},
+	})
+	require.Error(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "scheduler-config.yaml", results[0].Filename)
+	require.Len(t, results[0].Errors, 1)
+	assert.ErrorContains(t, results[0].Errors[0], "urlPrefix should have http or https scheme")
+}
+
+func TestRegisterConfigValidator(t *testing.T) {
+	t.Parallel()
+
+	var validated []string
+
+	// the validator is registered for the whole package, so it only rejects the profile used in this test
+	k8sctrl.RegisterConfigValidator(func(cfg *schedulerv1.KubeSchedulerConfiguration) error {
+		for _, profile := range cfg.Profiles {
+			if profile.SchedulerName == nil || !strings.HasPrefix(*profile.SchedulerName, "test-registry-") {
+				continue
+			}
+
+			validated = append(validated, *profile.SchedulerName)
+
+			if *profile.SchedulerName == "test-registry-forbidden" {
+				return errors.New("scheduler name is forbidden")
+			}
+		}
+
+		return nil
+	})
+
+	for _, test := range []struct {
+		schedulerName string
+
+		expectedError string
+	}{
+		{
+			schedulerName: "test-registry-allowed",
+		},
+		{
+			schedulerName: "test-registry-forbidden",
+
+			expectedError: "scheduler-config.yaml: scheduler name is forbidden",
+		},
+	} {
+		_, err := k8sctrl.ValidateAll(k8sctrl.ValidationSpecs{
+			Scheduler: &k8s.SchedulerConfigSpec{
+				Enabled: true,
+				Config: map[string]any{
+					"profiles": []any{
+						map[string]any{
+							"schedulerName": test.schedulerName,
+						},
+					},
+				},
+			},
+		})
+
+		if test.expectedError != "" {
+			require.EqualError(t, err, test.expectedError)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+
+	assert.Equal(t, []string{"test-registry-allowed", "test-registry-forbidden"}, validated)
+}
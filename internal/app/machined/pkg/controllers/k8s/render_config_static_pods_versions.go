@@ -0,0 +1,52 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
+)
+
+// configAPIVersions return the apiVersions of the kube-apiserver configs by kind, for the target kube-apiserver version.
+//
+// The structured auth configs graduate on different minor versions, so each config is rendered with the latest apiVersion
+// served by the target kube-apiserver version, using the go-kubernetes compatibility helpers where they exist.
+// The support of the configs themselves is checked separately, see structuredAuthMinVersions.
+var configAPIVersions = map[string]func(compatibility.Version) string{
+	"AdmissionConfiguration":      fixedConfigAPIVersion("apiserver.config.k8s.io/v1"),
+	"AuthenticationConfiguration": fixedConfigAPIVersion("apiserver.config.k8s.io/v1beta1"),
+	"AuthorizationConfiguration":  compatibility.Version.KubeAPIServerAuthorizationConfigAPIVersion,
+	// unlike the other configs, the egress selector config is not in the config API group
+	"EgressSelectorConfiguration": fixedConfigAPIVersion("apiserver.k8s.io/v1beta1"),
+	"TracingConfiguration":        tracingConfigAPIVersion,
+}
+
+// fixedConfigAPIVersion returns the apiVersion of the config served by all supported kube-apiserver versions.
+func fixedConfigAPIVersion(apiVersion string) func(compatibility.Version) string {
+	return func(compatibility.Version) string {
+		return apiVersion
+	}
+}
+
+// tracingConfigAPIVersion returns the apiVersion of the tracing config, v1beta1 is served since Kubernetes 1.27.
+func tracingConfigAPIVersion(v compatibility.Version) string {
+	if semver.Version(v).GTE(semver.Version{Major: 1, Minor: 27}) {
+		return "apiserver.config.k8s.io/v1beta1"
+	}
+
+	return "apiserver.config.k8s.io/v1alpha1"
+}
+
+// configAPIVersion returns the apiVersion of the config kind rendered for the kube-apiserver version.
+func configAPIVersion(kind string, kubeAPIServerVersion compatibility.Version) (string, error) {
+	apiVersion, ok := configAPIVersions[kind]
+	if !ok {
+		return "", fmt.Errorf("unknown config kind %q", kind)
+	}
+
+	return apiVersion(kubeAPIServerVersion), nil
+}
@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/siderolabs/go-kubernetes/kubernetes/compatibility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+)
+
+// testKubeAPIServerVersion is the kube-apiserver version the configs are rendered for, unless the test is about the version.
+var testKubeAPIServerVersion = compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:v1.33.0")
+
+func TestConfigAPIVersion(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		version string
+
+		expected map[string]string
+	}{
+		{
+			version: "v1.26.3",
+
+			expected: map[string]string{
+				"EgressSelectorConfiguration": "apiserver.k8s.io/v1beta1",
+				"TracingConfiguration":        "apiserver.config.k8s.io/v1alpha1",
+			},
+		},
+		{
+			version: "v1.28.0",
+
+			expected: map[string]string{
+				"AdmissionConfiguration":      "apiserver.config.k8s.io/v1",
+				"AuthenticationConfiguration": "apiserver.config.k8s.io/v1beta1",
+				"AuthorizationConfiguration":  "apiserver.config.k8s.io/v1alpha1",
+				"EgressSelectorConfiguration": "apiserver.k8s.io/v1beta1",
+				"TracingConfiguration":        "apiserver.config.k8s.io/v1beta1",
+			},
+		},
+		{
+			version: "v1.29.5",
+
+			expected: map[string]string{
+				"AdmissionConfiguration":      "apiserver.config.k8s.io/v1",
+				"AuthenticationConfiguration": "apiserver.config.k8s.io/v1beta1",
+				"AuthorizationConfiguration":  "apiserver.config.k8s.io/v1alpha1",
+				"EgressSelectorConfiguration": "apiserver.k8s.io/v1beta1",
+				"TracingConfiguration":        "apiserver.config.k8s.io/v1beta1",
+			},
+		},
+		{
+			version: "v1.30.0",
+
+			expected: map[string]string{
+				"AdmissionConfiguration":      "apiserver.config.k8s.io/v1",
+				"AuthenticationConfiguration": "apiserver.config.k8s.io/v1beta1",
+				"AuthorizationConfiguration":  "apiserver.config.k8s.io/v1beta1",
+				"EgressSelectorConfiguration": "apiserver.k8s.io/v1beta1",
+				"TracingConfiguration":        "apiserver.config.k8s.io/v1beta1",
+			},
+		},
+		{
+			version: "v1.33.0-beta.0",
+
+			expected: map[string]string{
+				"AdmissionConfiguration":      "apiserver.config.k8s.io/v1",
+				"AuthenticationConfiguration": "apiserver.config.k8s.io/v1beta1",
+				"AuthorizationConfiguration":  "apiserver.config.k8s.io/v1beta1",
+				"EgressSelectorConfiguration": "apiserver.k8s.io/v1beta1",
+				"TracingConfiguration":        "apiserver.config.k8s.io/v1beta1",
+			},
+		},
+	} {
+		t.Run(test.version, func(t *testing.T) {
+			t.Parallel()
+
+			version := compatibility.VersionFromImageRef("registry.k8s.io/kube-apiserver:" + test.version)
+
+			for kind, expected := range test.expected {
+				apiVersion, err := k8sctrl.ConfigAPIVersion(kind, version)
+				require.NoError(t, err, kind)
+
+				assert.Equal(t, expected, apiVersion, kind)
+			}
+		})
+	}
+}
+
+func TestConfigAPIVersionUnknownKind(t *testing.T) {
+	t.Parallel()
+
+	_, err := k8sctrl.ConfigAPIVersion("StructuredConfiguration", testKubeAPIServerVersion)
+	require.EqualError(t, err, `unknown config kind "StructuredConfiguration"`)
+}
@@ -0,0 +1,94 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+)
+
+// configWatcher watches the config directories to correct the manual edits of the rendered configs.
+//
+// The watch failures never fail the controller: drift correction is paused, and the watcher is recreated
+// by the next render. A nil watcher watches nothing.
+type configWatcher struct {
+	logger  *zap.Logger
+	watcher *fsnotify.Watcher
+	dirs    map[string]struct{}
+}
+
+func newConfigWatcher(logger *zap.Logger) *configWatcher {
+	return &configWatcher{
+		logger: logger,
+		dirs:   map[string]struct{}{},
+	}
+}
+
+// Events returns the config change events, nil if nothing is watched.
+func (w *configWatcher) Events() <-chan fsnotify.Event {
+	if w == nil || w.watcher == nil {
+		return nil
+	}
+
+	return w.watcher.Events
+}
+
+// Errors returns the watch errors, nil if nothing is watched.
+func (w *configWatcher) Errors() <-chan error {
+	if w == nil || w.watcher == nil {
+		return nil
+	}
+
+	return w.watcher.Errors
+}
+
+// Watch starts watching the directory, creating the watcher if needed.
+func (w *configWatcher) Watch(dir string) {
+	if w == nil {
+		return
+	}
+
+	if _, watched := w.dirs[dir]; watched {
+		return
+	}
+
+	if w.watcher == nil {
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			w.logger.Warn("error creating config watcher, config drift is not corrected", zap.Error(err))
+
+			return
+		}
+
+		w.watcher = watcher
+	}
+
+	if err := w.watcher.Add(dir); err != nil {
+		w.logger.Warn("error watching config directory, config drift is not corrected", zap.String("dir", dir), zap.Error(err))
+
+		return
+	}
+
+	w.dirs[dir] = struct{}{}
+}
+
+// Fail stops watching on the watch error, until the directories are watched again.
+func (w *configWatcher) Fail(err error) {
+	w.logger.Warn("error watching configs, config drift is not corrected until the next render", zap.Error(err))
+
+	w.Close()
+}
+
+// Close stops watching all directories.
+func (w *configWatcher) Close() {
+	if w == nil || w.watcher == nil {
+		return
+	}
+
+	w.watcher.Close() //nolint:errcheck
+
+	w.watcher = nil
+	clear(w.dirs)
+}
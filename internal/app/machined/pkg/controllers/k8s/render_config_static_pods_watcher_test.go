@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+)
+
+func assertConfigWatched(t *testing.T, watcher *k8sctrl.ConfigWatcher, dir string) {
+	t.Helper()
+
+	path := filepath.Join(dir, "auditpolicy.yaml")
+
+	require.NoError(t, os.WriteFile(path, []byte("rules: []\n"), 0o600))
+
+	for {
+		select {
+		case event := <-watcher.Events():
+			if event.Name == path {
+				return
+			}
+		case <-time.After(10 * time.Second):
+			require.FailNow(t, "config change is not watched")
+		}
+	}
+}
+
+func TestConfigWatcher(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	watcher := k8sctrl.NewConfigWatcher(zaptest.NewLogger(t))
+	t.Cleanup(watcher.Close)
+
+	require.Nil(t, watcher.Events())
+	require.Nil(t, watcher.Errors())
+
+	// the directory which can't be watched is retried on the next render
+	watcher.Watch(filepath.Join(dir, "missing"))
+	watcher.Watch(dir)
+
+	assertConfigWatched(t, watcher, dir)
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "missing"), 0o700))
+	watcher.Watch(filepath.Join(dir, "missing"))
+
+	assertConfigWatched(t, watcher, filepath.Join(dir, "missing"))
+
+	// the watch error pauses watching until the directories are watched again
+	watcher.Fail(errors.New("queue overflow"))
+
+	require.Nil(t, watcher.Events())
+	require.Nil(t, watcher.Errors())
+
+	watcher.Watch(dir)
+
+	assertConfigWatched(t, watcher, dir)
+}
+
+func TestConfigWatcherDisabled(t *testing.T) {
+	t.Parallel()
+
+	var watcher *k8sctrl.ConfigWatcher
+
+	watcher.Watch(t.TempDir())
+	watcher.Close()
+
+	require.Nil(t, watcher.Events())
+	require.Nil(t, watcher.Errors())
+}
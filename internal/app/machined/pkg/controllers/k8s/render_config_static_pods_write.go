@@ -0,0 +1,461 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/pkg/xattr"
+	"github.com/siderolabs/gen/xslices"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/pkg/selinux"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+// ensureConfigDir creates the config directories of the pod and applies the directory mode.
+func ensureConfigDir(pod staticPodConfigs) error {
+	if err := validateConfigDirMode(pod.directoryMode); err != nil {
+		return err
+	}
+
+	for _, dir := range pod.directories() {
+		if err := os.MkdirAll(dir, pod.directoryMode); err != nil {
+			return err
+		}
+
+		// MkdirAll respects umask, and doesn't change the mode of the existing directory
+		if err := os.Chmod(dir, pod.directoryMode); err != nil {
+			return err
+		}
+
+		if pod.directoryMode&0o001 == 0 {
+			if err := os.Chown(dir, pod.uid, pod.gid); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateConfigDirMode checks that the pod user can traverse the config directory with the mode.
+func validateConfigDirMode(mode os.FileMode) error {
+	if mode&^os.ModePerm != 0 {
+		return fmt.Errorf("directory mode %s should only have permission bits", mode)
+	}
+
+	// the directory is either traversable by others, or it's owned by the pod user
+	if mode&0o001 == 0 && mode&0o100 == 0 {
+		return fmt.Errorf("directory mode %s doesn't allow the static pod to traverse the directory", mode)
+	}
+
+	return nil
+}
+
+// verifyConfigAttributes returns an error if the mode or the ownership of the written config doesn't match the intended one.
+func verifyConfigAttributes(path string, uid, gid int, lstat func(string, *unix.Stat_t) error) error {
+	var st unix.Stat_t
+
+	if err := lstat(path, &st); err != nil {
+		return fmt.Errorf("error checking %q: %w", path, err)
+	}
+
+	if st.Mode&unix.S_IFMT != unix.S_IFREG {
+		return fmt.Errorf("%q is not a regular file", path)
+	}
+
+	if mode := os.FileMode(st.Mode & 0o7777); mode != configFileMode {
+		return fmt.Errorf("mode of %q is %s instead of %s, the filesystem doesn't honor the file mode", path, mode, configFileMode)
+	}
+
+	if int(st.Uid) != uid || int(st.Gid) != gid {
+		return fmt.Errorf("owner of %q is %d:%d instead of %d:%d, the filesystem doesn't honor the file ownership", path, st.Uid, st.Gid, uid, gid)
+	}
+
+	return nil
+}
+
+// checkWritableMount returns an error if the path (or its closest existing parent) is on a read-only filesystem.
+func checkWritableMount(path string, statfs func(string, *unix.Statfs_t) error) error {
+	for {
+		var st unix.Statfs_t
+
+		err := statfs(path, &st)
+		if err == nil {
+			if st.Flags&unix.ST_RDONLY != 0 {
+				return fmt.Errorf("%q is on a read-only filesystem", path)
+			}
+
+			return nil
+		}
+
+		if !errors.Is(err, unix.ENOENT) {
+			return fmt.Errorf("error checking filesystem of %q: %w", path, err)
+		}
+
+		parent := filepath.Dir(path)
+		if parent == path {
+			return nil
+		}
+
+		path = parent
+	}
+}
+
+// checkSameFilesystem returns an error if the staging directory is not on the same filesystem as the config directory.
+func checkSameFilesystem(stagingDir, configDir string, stat func(string, *unix.Stat_t) error) error {
+	var stagingSt, configSt unix.Stat_t
+
+	if err := stat(stagingDir, &stagingSt); err != nil {
+		return fmt.Errorf("error checking filesystem of %q: %w", stagingDir, err)
+	}
+
+	if err := stat(configDir, &configSt); err != nil {
+		return fmt.Errorf("error checking filesystem of %q: %w", configDir, err)
+	}
+
+	if stagingSt.Dev != configSt.Dev {
+		return fmt.Errorf("staging directory %q is not on the same filesystem as %q", stagingDir, configDir)
+	}
+
+	return nil
+}
+
+// configUpdate is a pending update of the rendered config.
+type configUpdate struct {
+	filename     string
+	pod          string
+	path         string
+	stagingPath  string
+	uid          int
+	gid          int
+	selinuxLabel string
+	xattrs       map[string]string
+
+	// contents is nil if the config should be removed
+	contents []byte
+}
+
+// configFileMode is the mode of the rendered configs.
+const configFileMode os.FileMode = 0o400
+
+// applied returns the config as it is applied to disk with the contents.
+func (update configUpdate) applied(contents []byte) k8s.AppliedConfigFileSpec {
+	return k8s.AppliedConfigFileSpec{
+		Path:   update.path,
+		Mode:   configFileMode,
+		UID:    update.uid,
+		GID:    update.gid,
+		SHA256: contentsHash(contents),
+	}
+}
+
+// configSwapOrder returns the order in which the config is swapped in.
+func configSwapOrder(filename string) int {
+	switch filename {
+	case "authentication-config.yaml":
+		return 1
+	case "authorization-config.yaml":
+		return 2
+	default:
+		return 0
+	}
+}
+
+// UnmanagedMarkerSuffix is the suffix of the marker file which excludes the config next to it from being written or removed.
+const UnmanagedMarkerSuffix = ".unmanaged"
+
+// unmanagedConfig returns true if the config at path is marked as managed out-of-band.
+func unmanagedConfig(path string) bool {
+	_, err := os.Lstat(path + UnmanagedMarkerSuffix)
+
+	return err == nil
+}
+
+// sortConfigUpdates sorts config updates in the swap order.
+func sortConfigUpdates(updates []configUpdate) {
+	slices.SortStableFunc(updates, func(a, b configUpdate) int {
+		return cmp.Compare(configSwapOrder(a.filename), configSwapOrder(b.filename))
+	})
+}
+
+// stagingDir returns the directory the pod configs from the config directory are staged in.
+func (ctrl *RenderConfigsStaticPodController) stagingDir(pod staticPodConfigs, configDir string) string {
+	if ctrl.StagingDir == "" {
+		return configDir
+	}
+
+	return filepath.Join(ctrl.StagingDir, pod.name)
+}
+
+// ensureStagingDir creates the staging directory of the pod, if the configs are not staged in the config directories.
+func (ctrl *RenderConfigsStaticPodController) ensureStagingDir(pod staticPodConfigs) error {
+	if ctrl.StagingDir == "" {
+		return nil
+	}
+
+	return os.MkdirAll(ctrl.stagingDir(pod, ""), 0o700)
+}
+
+// stagingConfigPath returns the path of the staging file for the config in the staging directory.
+func stagingConfigPath(stagingDir, filename string) string {
+	return filepath.Join(stagingDir, "."+filename+".staging")
+}
+
+// applyConfigUpdates writes all updated configs to the staging files first, and then swaps them in the swap order.
+//
+//nolint:gocyclo
+func applyConfigUpdates(updates []configUpdate, backup backupOptions) error {
+	sortConfigUpdates(updates)
+
+	for _, update := range updates {
+		if update.contents == nil {
+			continue
+		}
+
+		path := update.stagingPath
+
+		// staging file might be left over from the previous failed attempt
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("error removing staged configuration %q for %q: %w", update.filename, update.pod, err)
+		}
+
+		if err := os.WriteFile(path, update.contents, configFileMode); err != nil {
+			return fmt.Errorf("error writing configuration %q for %q: %w", update.filename, update.pod, err)
+		}
+
+		if err := os.Chown(path, update.uid, update.gid); err != nil {
+			return fmt.Errorf("error chowning %q for %q: %w", update.filename, update.pod, err)
+		}
+
+		if err := selinux.SetLabel(path, update.selinuxLabel); err != nil {
+			return fmt.Errorf("error labeling %q for %q: %w", update.filename, update.pod, err)
+		}
+
+		if err := verifyConfigAttributes(path, update.uid, update.gid, unix.Lstat); err != nil {
+			return fmt.Errorf("error verifying %q for %q: %w", update.filename, update.pod, err)
+		}
+
+		if err := setConfigXattrs(path, update.xattrs); err != nil {
+			return fmt.Errorf("error setting extended attributes of %q for %q: %w", update.filename, update.pod, err)
+		}
+	}
+
+	for _, update := range updates {
+		if backup.enabled {
+			if err := backupConfig(update.path, backup.compressAbove); err != nil {
+				return fmt.Errorf("error backing up configuration %q for %q: %w", update.filename, update.pod, err)
+			}
+		}
+
+		if update.contents == nil {
+			if err := os.Remove(update.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("error removing configuration %q for %q: %w", update.filename, update.pod, err)
+			}
+
+			continue
+		}
+
+		if err := os.Rename(update.stagingPath, update.path); err != nil {
+			return fmt.Errorf("error swapping configuration %q for %q: %w", update.filename, update.pod, err)
+		}
+	}
+
+	return nil
+}
+
+// Extended attributes of the configs written with VersionXattrs enabled.
+const (
+	// ConfigVersionXattr is the combined version of the input resources the config was rendered from, see ComputeConfigVersion.
+	ConfigVersionXattr = "user.talos.config-version"
+	// ConfigRenderTimeXattr is the time the config was rendered at, in RFC 3339 format.
+	ConfigRenderTimeXattr = "user.talos.render-time"
+)
+
+// setConfigXattrs sets the extended attributes of the staged config, they are preserved when the config is swapped in.
+func setConfigXattrs(path string, xattrs map[string]string) error {
+	for _, name := range slices.Sorted(maps.Keys(xattrs)) {
+		if err := xattr.LSet(path, name, []byte(xattrs[name])); err != nil {
+			if errors.Is(err, unix.ENOTSUP) {
+				return nil
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ConfigLockFilename is the name of the lock of the pod config directory.
+const ConfigLockFilename = "talos-config.lock"
+
+// configLockRetryInterval is the interval of the attempts to acquire the config directory lock held by another renderer.
+const configLockRetryInterval = 100 * time.Millisecond
+
+// lockConfigDirs acquires the locks of the pod config directories, including the secret config directories, waiting for other renderers to release them.
+func lockConfigDirs(ctx context.Context, pods []staticPodConfigs) (func(), error) {
+	var locks []*os.File
+
+	unlock := func() {
+		for _, lock := range slices.Backward(locks) {
+			lock.Close() //nolint:errcheck
+		}
+	}
+
+	for _, pod := range pods {
+		for _, dir := range pod.directories() {
+			lock, err := lockConfigDir(ctx, dir)
+			if err != nil {
+				unlock()
+
+				return nil, fmt.Errorf("error locking config directory %q for %q: %w", dir, pod.name, err)
+			}
+
+			locks = append(locks, lock)
+		}
+	}
+
+	return unlock, nil
+}
+
+// lockConfigDir acquires the lock of the config directory, the lock is released when the returned file is closed.
+func lockConfigDir(ctx context.Context, dir string) (*os.File, error) {
+	f, err := os.OpenFile(filepath.Join(dir, ConfigLockFilename), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		err = unix.Flock(int(f.Fd()), unix.LOCK_EX|unix.LOCK_NB)
+		if err == nil {
+			return f, nil
+		}
+
+		if !errors.Is(err, unix.EWOULDBLOCK) && !errors.Is(err, unix.EINTR) {
+			f.Close() //nolint:errcheck
+
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			f.Close() //nolint:errcheck
+
+			return nil, ctx.Err()
+		case <-time.After(configLockRetryInterval):
+		}
+	}
+}
+
+// ConfigIndexFilename is the name of the index of the configs rendered to the pod config directory.
+const ConfigIndexFilename = "talos-config-index.json"
+
+// configIndexMode is the mode of the config index, it's readable by everyone as it only contains hashes.
+const configIndexMode os.FileMode = 0o444
+
+// configIndex lists the configs rendered to the pod config directory, and the versions of the resources they are rendered from.
+type configIndex struct {
+	Version   string                `json:"version"`
+	Files     []configIndexFile     `json:"files"`
+	Resources []configIndexResource `json:"resources"`
+}
+
+type configIndexFile struct {
+	Filename string `json:"filename"`
+	SHA256   string `json:"sha256"`
+}
+
+type configIndexResource struct {
+	Type    string `json:"type"`
+	ID      string `json:"id"`
+	Version string `json:"version"`
+}
+
+// writeConfigIndex writes the index of the configs applied to the pod config directory, if it has changed.
+func writeConfigIndex(pod staticPodConfigs, dir string, applied map[string]k8s.AppliedConfigFileSpec, resources []resource.Resource) error {
+	index := configIndex{
+		Version: ComputeConfigVersion(resources...),
+		Files:   []configIndexFile{},
+		Resources: xslices.Map(resources, func(res resource.Resource) configIndexResource {
+			return configIndexResource{
+				Type:    res.Metadata().Type(),
+				ID:      res.Metadata().ID(),
+				Version: res.Metadata().Version().String(),
+			}
+		}),
+	}
+
+	for _, configFile := range pod.configs {
+		if spec, ok := applied[configFile.filename]; ok && filepath.Dir(spec.Path) == dir {
+			index.Files = append(index.Files, configIndexFile{Filename: filepath.Base(spec.Path), SHA256: spec.SHA256})
+		}
+	}
+
+	slices.SortFunc(index.Files, func(a, b configIndexFile) int { return cmp.Compare(a.Filename, b.Filename) })
+	slices.SortFunc(index.Resources, func(a, b configIndexResource) int {
+		return cmp.Or(cmp.Compare(a.Type, b.Type), cmp.Compare(a.ID, b.ID))
+	})
+
+	contents, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, ConfigIndexFilename)
+
+	existing, err := os.ReadFile(path)
+	if err == nil && bytes.Equal(existing, contents) {
+		return nil
+	}
+
+	tmpPath := stagingConfigPath(dir, ConfigIndexFilename)
+
+	if err = os.Remove(tmpPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	f, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, configIndexMode)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	if _, err = f.Write(contents); err != nil {
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	if err = selinux.SetLabel(tmpPath, pod.fileSELinuxLabel); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// contentsHash returns the hex-encoded SHA256 of the config contents.
+func contentsHash(contents []byte) string {
+	hash := sha256.Sum256(contents)
+
+	return hex.EncodeToString(hash[:])
+}
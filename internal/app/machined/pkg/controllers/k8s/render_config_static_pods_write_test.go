@@ -0,0 +1,234 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sys/unix"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+func TestValidateConfigDirMode(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, k8sctrl.ValidateConfigDirMode(0o755))
+	require.NoError(t, k8sctrl.ValidateConfigDirMode(0o700))
+	require.NoError(t, k8sctrl.ValidateConfigDirMode(0o711))
+
+	require.EqualError(t, k8sctrl.ValidateConfigDirMode(0o600), "directory mode -rw------- doesn't allow the static pod to traverse the directory")
+	require.EqualError(t, k8sctrl.ValidateConfigDirMode(os.ModeSticky|0o755), "directory mode trwxr-xr-x should only have permission bits")
+}
+
+func TestSortConfigUpdates(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		[]string{
+			"admission-control-config.yaml",
+			"auditpolicy.yaml",
+			"egress-selector-config.yaml",
+			"scheduler-config.yaml",
+			"authentication-config.yaml",
+			"authorization-config.yaml",
+		},
+		k8sctrl.SortConfigUpdates([]string{
+			"admission-control-config.yaml",
+			"auditpolicy.yaml",
+			"authorization-config.yaml",
+			"authentication-config.yaml",
+			"egress-selector-config.yaml",
+			"scheduler-config.yaml",
+		}),
+	)
+}
+
+func TestLockConfigDirs(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	filenames := []string{"admission-control-config.yaml", "auditpolicy.yaml", "authorization-config.yaml"}
+
+	var eg errgroup.Group
+
+	// each renderer writes all configs and reads them back, they should never see the configs of another renderer
+	for renderer := range 4 {
+		eg.Go(func() error {
+			contents := []byte(fmt.Sprintf("renderer %d\n", renderer))
+
+			for range 20 {
+				if err := func() error {
+					unlock, err := k8sctrl.LockConfigDirs(t.Context(), dir)
+					if err != nil {
+						return err
+					}
+
+					defer unlock()
+
+					for _, filename := range filenames {
+						if err = os.WriteFile(filepath.Join(dir, filename), contents, 0o600); err != nil {
+							return err
+						}
+
+						time.Sleep(time.Millisecond)
+					}
+
+					for _, filename := range filenames {
+						written, err := os.ReadFile(filepath.Join(dir, filename))
+						if err != nil {
+							return err
+						}
+
+						if !bytes.Equal(written, contents) {
+							return fmt.Errorf("configuration %q is interleaved: expected %q, got %q", filename, contents, written)
+						}
+					}
+
+					return nil
+				}(); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	require.NoError(t, eg.Wait())
+
+	// the lock held by another renderer is waited for until the context is canceled
+	unlock, err := k8sctrl.LockConfigDirs(t.Context(), dir)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 300*time.Millisecond)
+	defer cancel()
+
+	_, err = k8sctrl.LockConfigDirs(ctx, dir)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	unlock()
+
+	unlock, err = k8sctrl.LockConfigDirs(t.Context(), dir)
+	require.NoError(t, err)
+
+	unlock()
+}
+
+func TestCheckWritableMount(t *testing.T) {
+	t.Parallel()
+
+	// statfs simulates /etc/kubernetes being on a read-only filesystem, with /var writable
+	statfs := func(path string, st *unix.Statfs_t) error {
+		switch path {
+		case "/etc/kubernetes":
+			st.Flags = unix.ST_RDONLY
+
+			return nil
+		case "/var":
+			return nil
+		case "/etc/kubernetes/broken":
+			return unix.EIO
+		default:
+			return unix.ENOENT
+		}
+	}
+
+	require.NoError(t, k8sctrl.CheckWritableMount("/var/kube-apiserver", statfs))
+	require.NoError(t, k8sctrl.CheckWritableMount("/missing/kube-apiserver", statfs))
+
+	require.EqualError(t, k8sctrl.CheckWritableMount("/etc/kubernetes/kube-apiserver", statfs), `"/etc/kubernetes" is on a read-only filesystem`)
+	require.ErrorIs(t, k8sctrl.CheckWritableMount("/etc/kubernetes/broken", statfs), unix.EIO)
+}
+
+func TestVerifyConfigAttributes(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "auditpolicy.yaml")
+	require.NoError(t, os.WriteFile(path, nil, 0o400))
+	require.NoError(t, os.Chmod(path, 0o400))
+
+	require.NoError(t, k8sctrl.VerifyConfigAttributes(path, os.Getuid(), os.Getgid(), unix.Lstat))
+
+	// lstat simulates the filesystems altering the mode and the ownership of the written configs
+	lstat := func(path string, st *unix.Stat_t) error {
+		st.Mode = unix.S_IFREG | 0o400
+		st.Uid, st.Gid = constants.KubernetesAPIServerRunUser, constants.KubernetesAPIServerRunGroup
+
+		switch path {
+		case "/etc/kubernetes/acl/auditpolicy.yaml":
+			st.Mode = unix.S_IFREG | 0o444
+		case "/etc/kubernetes/chown/auditpolicy.yaml":
+			st.Uid, st.Gid = 0, 0
+		case "/etc/kubernetes/symlink/auditpolicy.yaml":
+			st.Mode = unix.S_IFLNK | 0o777
+		case "/etc/kubernetes/missing/auditpolicy.yaml":
+			return unix.ENOENT
+		}
+
+		return nil
+	}
+
+	require.NoError(t, k8sctrl.VerifyConfigAttributes("/etc/kubernetes/kube-apiserver/auditpolicy.yaml",
+		constants.KubernetesAPIServerRunUser, constants.KubernetesAPIServerRunGroup, lstat))
+
+	require.EqualError(t, k8sctrl.VerifyConfigAttributes("/etc/kubernetes/acl/auditpolicy.yaml",
+		constants.KubernetesAPIServerRunUser, constants.KubernetesAPIServerRunGroup, lstat),
+		`mode of "/etc/kubernetes/acl/auditpolicy.yaml" is -r--r--r-- instead of -r--------, the filesystem doesn't honor the file mode`)
+	require.EqualError(t, k8sctrl.VerifyConfigAttributes("/etc/kubernetes/chown/auditpolicy.yaml",
+		constants.KubernetesAPIServerRunUser, constants.KubernetesAPIServerRunGroup, lstat),
+		fmt.Sprintf(`owner of "/etc/kubernetes/chown/auditpolicy.yaml" is 0:0 instead of %d:%d, the filesystem doesn't honor the file ownership`,
+			constants.KubernetesAPIServerRunUser, constants.KubernetesAPIServerRunGroup))
+	require.EqualError(t, k8sctrl.VerifyConfigAttributes("/etc/kubernetes/symlink/auditpolicy.yaml",
+		constants.KubernetesAPIServerRunUser, constants.KubernetesAPIServerRunGroup, lstat),
+		`"/etc/kubernetes/symlink/auditpolicy.yaml" is not a regular file`)
+	require.ErrorIs(t, k8sctrl.VerifyConfigAttributes("/etc/kubernetes/missing/auditpolicy.yaml",
+		constants.KubernetesAPIServerRunUser, constants.KubernetesAPIServerRunGroup, lstat), unix.ENOENT)
+}
+
+func TestCheckSameFilesystem(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	stagingDir := filepath.Join(dir, "staging")
+	configDir := filepath.Join(dir, "kube-apiserver")
+
+	require.NoError(t, os.Mkdir(stagingDir, 0o700))
+	require.NoError(t, os.Mkdir(configDir, 0o755))
+
+	require.NoError(t, k8sctrl.CheckSameFilesystem(stagingDir, configDir, unix.Stat))
+
+	// stat simulates /var/staging being on a different filesystem than /etc/kubernetes
+	stat := func(path string, st *unix.Stat_t) error {
+		switch path {
+		case "/etc/kubernetes/kube-apiserver", "/etc/kubernetes/staging":
+			st.Dev = 1
+
+			return nil
+		case "/var/staging":
+			st.Dev = 2
+
+			return nil
+		default:
+			return unix.ENOENT
+		}
+	}
+
+	require.NoError(t, k8sctrl.CheckSameFilesystem("/etc/kubernetes/staging", "/etc/kubernetes/kube-apiserver", stat))
+
+	require.EqualError(t, k8sctrl.CheckSameFilesystem("/var/staging", "/etc/kubernetes/kube-apiserver", stat),
+		`staging directory "/var/staging" is not on the same filesystem as "/etc/kubernetes/kube-apiserver"`)
+	require.ErrorIs(t, k8sctrl.CheckSameFilesystem("/missing", "/etc/kubernetes/kube-apiserver", stat), unix.ENOENT)
+}
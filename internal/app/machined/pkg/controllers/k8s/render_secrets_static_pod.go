@@ -7,9 +7,13 @@ package k8s
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	stdlibtemplate "text/template"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -20,6 +24,9 @@ import (
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xslices"
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"sigs.k8s.io/yaml"
 
 	"github.com/siderolabs/talos/internal/pkg/selinux"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -160,6 +167,8 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 		type template struct {
 			filename string
 			template string
+			// validate is an optional check of the rendered template
+			validate func([]byte) error
 		}
 
 		for _, pod := range []struct {
@@ -229,6 +238,7 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 					{
 						filename: "encryptionconfig.yaml",
 						template: kubeSystemEncryptionConfigTemplate,
+						validate: validateEncryptionConfig,
 					},
 				},
 			},
@@ -331,6 +341,12 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 					return fmt.Errorf("error executing template %q: %w", templ.filename, err)
 				}
 
+				if templ.validate != nil {
+					if err = templ.validate(buf.Bytes()); err != nil {
+						return fmt.Errorf("error validating template %q for %q: %w", templ.filename, pod.name, err)
+					}
+				}
+
 				if err = os.WriteFile(filepath.Join(pod.directory, templ.filename), buf.Bytes(), 0o400); err != nil {
 					return fmt.Errorf("error writing template %q for %q: %w", templ.filename, pod.name, err)
 				}
@@ -353,3 +369,118 @@ func (ctrl *RenderSecretsStaticPodController) Run(ctx context.Context, r control
 		r.ResetRestartBackoff()
 	}
 }
+
+// validateEncryptionConfig checks the order of the encryption providers and their keys.
+//
+// The first provider and its first key are used for writes, while all of them are used for reads,
+// so an identity provider ahead of the encrypting providers silently disables encryption.
+func validateEncryptionConfig(contents []byte) error {
+	var cfg apiserverv1.EncryptionConfiguration
+
+	if err := yaml.Unmarshal(contents, &cfg); err != nil {
+		return fmt.Errorf("error unmarshaling encryption config: %w", err)
+	}
+
+	for i, resourceCfg := range cfg.Resources {
+		resourcePath := field.NewPath("resources").Index(i)
+
+		if len(resourceCfg.Providers) == 0 {
+			return &fieldPathError{path: resourcePath.Child("providers"), err: errors.New("at least one provider should be set")}
+		}
+
+		if resourceCfg.Providers[0].Identity != nil && len(resourceCfg.Providers) > 1 {
+			return &fieldPathError{
+				path: resourcePath.Child("providers").Index(0),
+				err: fmt.Errorf("identity provider is first, so %s are written unencrypted, encrypting providers should come before identity",
+					strings.Join(resourceCfg.Resources, ", ")),
+			}
+		}
+
+		for j, provider := range resourceCfg.Providers {
+			providerPath := resourcePath.Child("providers").Index(j)
+
+			var (
+				keys     []apiserverv1.Key
+				keysPath *field.Path
+			)
+
+			switch {
+			case provider.KMS != nil:
+				if err := validateKMSProvider(provider.KMS, providerPath.Child("kms")); err != nil {
+					return err
+				}
+
+				continue
+			case provider.AESGCM != nil:
+				keys, keysPath = provider.AESGCM.Keys, providerPath.Child("aesgcm", "keys")
+			case provider.AESCBC != nil:
+				keys, keysPath = provider.AESCBC.Keys, providerPath.Child("aescbc", "keys")
+			case provider.Secretbox != nil:
+				keys, keysPath = provider.Secretbox.Keys, providerPath.Child("secretbox", "keys")
+			default:
+				continue
+			}
+
+			if len(keys) == 0 {
+				return &fieldPathError{path: keysPath, err: errors.New("at least one key should be set")}
+			}
+
+			for k, key := range keys {
+				switch {
+				case key.Name == "":
+					return &fieldPathError{path: keysPath.Index(k).Child("name"), err: errors.New("key name should be set")}
+				case key.Secret == "":
+					return &fieldPathError{path: keysPath.Index(k).Child("secret"), err: fmt.Errorf("key %q secret should be set", key.Name)}
+				case slices.ContainsFunc(keys[:k], func(other apiserverv1.Key) bool { return other.Name == key.Name }):
+					// kube-apiserver tells the keys apart by the name, so a rotated key should have a new name
+					return &fieldPathError{path: keysPath.Index(k).Child("name"), err: fmt.Errorf("duplicate key name %q", key.Name)}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateKMSProvider checks the KMS v2 provider configuration.
+//
+// A misconfigured KMS provider makes kube-apiserver fail to decrypt the secrets, so the errors are caught before the config is written.
+func validateKMSProvider(kms *apiserverv1.KMSConfiguration, fldPath *field.Path) error {
+	if kms.APIVersion != "v2" {
+		return &fieldPathError{path: fldPath.Child("apiVersion"), err: fmt.Errorf("unsupported KMS API version %q, only v2 is supported", kms.APIVersion)}
+	}
+
+	if kms.Name == "" {
+		return &fieldPathError{path: fldPath.Child("name"), err: errors.New("KMS provider name should be set")}
+	}
+
+	if kms.Endpoint == "" {
+		return &fieldPathError{path: fldPath.Child("endpoint"), err: fmt.Errorf("KMS provider %q endpoint should be set", kms.Name)}
+	}
+
+	u, err := url.Parse(kms.Endpoint)
+	if err != nil {
+		return &fieldPathError{path: fldPath.Child("endpoint"), err: fmt.Errorf("KMS provider %q endpoint is malformed: %w", kms.Name, err)}
+	}
+
+	if u.Scheme != "unix" || u.Host != "" || !filepath.IsAbs(u.Path) {
+		return &fieldPathError{
+			path: fldPath.Child("endpoint"),
+			err:  fmt.Errorf("KMS provider %q endpoint should be a unix socket path (unix:///path/to/socket), got %q", kms.Name, kms.Endpoint),
+		}
+	}
+
+	switch {
+	case kms.Timeout == nil:
+		return &fieldPathError{path: fldPath.Child("timeout"), err: fmt.Errorf("KMS provider %q timeout should be set", kms.Name)}
+	case kms.Timeout.Duration <= 0:
+		return &fieldPathError{path: fldPath.Child("timeout"), err: fmt.Errorf("KMS provider %q timeout should be positive, got %s", kms.Name, kms.Timeout.Duration)}
+	}
+
+	if kms.CacheSize != nil {
+		// KMS v2 caches the data encryption keys by itself, and kube-apiserver rejects the cachesize
+		return &fieldPathError{path: fldPath.Child("cachesize"), err: fmt.Errorf("KMS provider %q cachesize is not supported with KMS v2", kms.Name)}
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+)
+
+func TestValidateEncryptionConfig(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		config string
+
+		expectedError string
+	}{
+		{
+			name: "rotation",
+			config: `apiVersion: v1
+kind: EncryptionConfig
+resources:
+- resources:
+  - secrets
+  providers:
+  - secretbox:
+      keys:
+      - name: key3
+        secret: c2VjcmV0IGlzIHNlY3VyZQ==
+      - name: key2
+        secret: c2VjcmV0IGlzIHNlY3VyZSwgSSB0aGluaw==
+  - aescbc:
+      keys:
+      - name: key1
+        secret: c2VjcmV0IGlzIHNlY3VyZSwgb3IgaXMgaXQ/Cg==
+  - identity: {}
+`,
+		},
+		{
+			name: "identity only",
+			config: `apiVersion: v1
+kind: EncryptionConfig
+resources:
+- resources:
+  - secrets
+  providers:
+  - identity: {}
+`,
+		},
+		{
+			name: "identity first",
+			config: `apiVersion: v1
+kind: EncryptionConfig
+resources:
+- resources:
+  - secrets
+  providers:
+  - identity: {}
+  - aescbc:
+      keys:
+      - name: key1
+        secret: c2VjcmV0IGlzIHNlY3VyZSwgb3IgaXMgaXQ/Cg==
+`,
+
+			expectedError: "resources[0].providers[0]: identity provider is first, so secrets are written unencrypted, encrypting providers should come before identity",
+		},
+		{
+			name: "duplicate key name",
+			config: `apiVersion: v1
+kind: EncryptionConfig
+resources:
+- resources:
+  - secrets
+  providers:
+  - aescbc:
+      keys:
+      - name: key1
+        secret: c2VjcmV0IGlzIHNlY3VyZQ==
+      - name: key1
+        secret: c2VjcmV0IGlzIHNlY3VyZSwgb3IgaXMgaXQ/Cg==
+  - identity: {}
+`,
+
+			expectedError: `resources[0].providers[0].aescbc.keys[1].name: duplicate key name "key1"`,
+		},
+		{
+			name: "no keys",
+			config: `apiVersion: v1
+kind: EncryptionConfig
+resources:
+- resources:
+  - secrets
+  providers:
+  - secretbox:
+      keys: []
+  - identity: {}
+`,
+
+			expectedError: "resources[0].providers[0].secretbox.keys: at least one key should be set",
+		},
+		{
+			name: "kms v2",
+			config: `apiVersion: v1
+kind: EncryptionConfig
+resources:
+- resources:
+  - secrets
+  providers:
+  - kms:
+      apiVersion: v2
+      name: vault
+      endpoint: unix:///var/run/kms/vault.sock
+      timeout: 3s
+  - aescbc:
+      keys:
+      - name: key1
+        secret: c2VjcmV0IGlzIHNlY3VyZSwgb3IgaXMgaXQ/Cg==
+  - identity: {}
+`,
+		},
+		{
+			name: "kms v2 no endpoint",
+			config: `apiVersion: v1
+kind: EncryptionConfig
+resources:
+- resources:
+  - secrets
+  providers:
+  - kms:
+      apiVersion: v2
+      name: vault
+      timeout: 3s
+  - identity: {}
+`,
+
+			expectedError: `resources[0].providers[0].kms.endpoint: KMS provider "vault" endpoint should be set`,
+		},
+		{
+			name: "kms v2 tcp endpoint",
+			config: `apiVersion: v1
+kind: EncryptionConfig
+resources:
+- resources:
+  - secrets
+  providers:
+  - kms:
+      apiVersion: v2
+      name: vault
+      endpoint: tcp://127.0.0.1:8200
+      timeout: 3s
+  - identity: {}
+`,
+
+			expectedError: `resources[0].providers[0].kms.endpoint: KMS provider "vault" endpoint should be a unix socket path (unix:///path/to/socket), got "tcp://127.0.0.1:8200"`,
+		},
+		{
+			name: "kms v2 no name",
+			config: `apiVersion: v1
+kind: EncryptionConfig
+resources:
+- resources:
+  - secrets
+  providers:
+  - kms:
+      apiVersion: v2
+      endpoint: unix:///var/run/kms/vault.sock
+      timeout: 3s
+  - identity: {}
+`,
+
+			expectedError: `resources[0].providers[0].kms.name: KMS provider name should be set`,
+		},
+		{
+			name: "kms v2 zero timeout",
+			config: `apiVersion: v1
+kind: EncryptionConfig
+resources:
+- resources:
+  - secrets
+  providers:
+  - kms:
+      apiVersion: v2
+      name: vault
+      endpoint: unix:///var/run/kms/vault.sock
+      timeout: 0s
+  - identity: {}
+`,
+
+			expectedError: `resources[0].providers[0].kms.timeout: KMS provider "vault" timeout should be positive, got 0s`,
+		},
+		{
+			name: "kms v1",
+			config: `apiVersion: v1
+kind: EncryptionConfig
+resources:
+- resources:
+  - secrets
+  providers:
+  - kms:
+      name: vault
+      endpoint: unix:///var/run/kms/vault.sock
+      cachesize: 100
+  - identity: {}
+`,
+
+			expectedError: `resources[0].providers[0].kms.apiVersion: unsupported KMS API version "", only v2 is supported`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := k8sctrl.ValidateEncryptionConfig([]byte(test.config))
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
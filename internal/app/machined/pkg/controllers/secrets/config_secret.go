@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// ConfigSecretController manages secrets.ConfigSecret based on configuration.
+type ConfigSecretController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *ConfigSecretController) Name() string {
+	return "secrets.ConfigSecretController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ConfigSecretController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.ActiveID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ConfigSecretController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: secrets.ConfigSecretType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ConfigSecretController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.ActiveID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting config: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		// the secrets are only referenced from the control plane static pod configs
+		if cfg != nil && cfg.Config().Cluster() != nil && cfg.Config().Machine() != nil && cfg.Config().Machine().Type().IsControlPlane() {
+			for _, secret := range cfg.Config().Cluster().StaticPodConfigs().Secrets() {
+				if err = safe.WriterModify(ctx, r, secrets.NewConfigSecret(secret.Name()), func(res *secrets.ConfigSecret) error {
+					res.TypedSpec().Data = maps.Clone(secret.Data())
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating config secret: %w", err)
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*secrets.ConfigSecret](ctx, r); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	secretsctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+func TestConfigSecretSuite(t *testing.T) {
+	suite.Run(t, &ConfigSecretSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&secretsctrl.ConfigSecretController{}))
+			},
+		},
+	})
+}
+
+type ConfigSecretSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *ConfigSecretSuite) TestReconcile() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+					StaticPodConfigsConfig: &v1alpha1.StaticPodConfigsConfig{
+						ConfigsSecrets: []v1alpha1.StaticPodConfigSecret{
+							{
+								SecretName: "oidc",
+								SecretData: map[string]string{"clientSecret": "foo"},
+							},
+							{
+								SecretName: "webhook",
+								SecretData: map[string]string{"token": "bar"},
+							},
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.Create(cfg)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"oidc", "webhook"},
+		func(secret *secrets.ConfigSecret, asrt *assert.Assertions) {
+			switch secret.Metadata().ID() {
+			case "oidc":
+				asrt.Equal(map[string]string{"clientSecret": "foo"}, secret.TypedSpec().Data)
+			case "webhook":
+				asrt.Equal(map[string]string{"token": "bar"}, secret.TypedSpec().Data)
+			}
+		})
+
+	cfg.Container().RawV1Alpha1().ClusterConfig.StaticPodConfigsConfig.ConfigsSecrets = cfg.Container().RawV1Alpha1().ClusterConfig.StaticPodConfigsConfig.ConfigsSecrets[:1]
+	suite.Update(cfg)
+
+	rtestutils.AssertNoResource[*secrets.ConfigSecret](suite.Ctx(), suite.T(), suite.State(), "webhook")
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{"oidc"},
+		func(*secrets.ConfigSecret, *assert.Assertions) {})
+
+	// the secrets are only referenced from the control plane configs
+	cfg.Container().RawV1Alpha1().MachineConfig.MachineType = "worker"
+	suite.Update(cfg)
+
+	rtestutils.AssertNoResource[*secrets.ConfigSecret](suite.Ctx(), suite.T(), suite.State(), "oidc")
+}
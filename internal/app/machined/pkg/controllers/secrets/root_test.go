@@ -19,6 +19,8 @@ import (
 	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/generate"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 )
@@ -119,3 +121,26 @@ func (suite *RootSuite) TestReconcileWorker() {
 	rtestutils.AssertNoResource[*secrets.Etcd](suite.Ctx(), suite.T(), suite.State(), secrets.EtcdRootID)
 	rtestutils.AssertNoResource[*secrets.Kubernetes](suite.Ctx(), suite.T(), suite.State(), secrets.KubernetesRootID)
 }
+
+func (suite *RootSuite) TestReconcileKMSEncryption() {
+	input, err := generate.NewInput("test-cluster", "http://localhost:6443", "")
+	suite.Require().NoError(err)
+
+	cfg, err := input.Config(machine.TypeControlPlane)
+	suite.Require().NoError(err)
+
+	cfg.RawV1Alpha1().ClusterConfig.APIServerConfig.KMSEncryptionConfig = &v1alpha1.KMSEncryptionConfig{
+		KMSName:     "vault",
+		KMSEndpoint: "unix:///var/run/kms/kms.sock",
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{secrets.KubernetesRootID},
+		func(res *secrets.KubernetesRoot, asrt *assert.Assertions) {
+			asrt.Equal("vault", res.TypedSpec().KMSEncryptionName)
+			asrt.Equal("unix:///var/run/kms/kms.sock", res.TypedSpec().KMSEncryptionEndpoint)
+			asrt.Equal(constants.KubernetesKMSEncryptionDefaultTimeout, res.TypedSpec().KMSEncryptionTimeout)
+		},
+	)
+}
@@ -6,6 +6,7 @@ package runtime
 
 import (
 	"context"
+	"io"
 	"log"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -63,4 +64,7 @@ type V1Alpha2Controller interface {
 	Run(context.Context, *Drainer) error
 	DependencyGraph() (*controller.DependencyGraph, error)
 	MakeLogger(serviceName string) (*zap.Logger, error)
+	SnapshotStaticPodConfigs(ctx context.Context, w io.Writer) error
+	RestoreStaticPodConfigs(ctx context.Context, archive io.Reader) error
+	RollbackStaticPodConfigs(ctx context.Context, generation uint64) error
 }
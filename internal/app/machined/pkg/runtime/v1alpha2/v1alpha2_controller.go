@@ -7,6 +7,7 @@ package v1alpha2
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"sync"
 	"time"
@@ -55,6 +56,8 @@ type Controller struct {
 	logger          *zap.Logger
 
 	v1alpha1Runtime runtime.Runtime
+
+	staticPodConfigs *k8s.RenderConfigsStaticPodController
 }
 
 // NewController creates Controller.
@@ -72,6 +75,27 @@ func NewController(v1alpha1Runtime runtime.Runtime) (*Controller, error) {
 		return nil, err
 	}
 
+	// the config snapshots of the machine API are taken by the same controller which renders the configs
+	ctrl.staticPodConfigs = &k8s.RenderConfigsStaticPodController{
+		APIServerConfigDir:       constants.KubernetesAPIServerConfigDir,
+		APIServerSecretConfigDir: constants.KubernetesAPIServerSecretConfigDir,
+		SchedulerConfigDir:       constants.KubernetesSchedulerConfigDir,
+		SchedulerSecretConfigDir: constants.KubernetesSchedulerSecretConfigDir,
+		APIServerConfigDirMode:   0o700,
+		SchedulerConfigDirMode:   0o700,
+		GeneratedHeader:          true,
+		CanonicalOutput:          true,
+		VersionXattrs:            true,
+		BackupPreviousConfigs:    true,
+		CompressBackupsAbove:     64 * 1024,
+		StagingDir:               constants.KubernetesStaticConfigStagingDir,
+		GenerationsDir:           constants.KubernetesStaticConfigGenerationsDir,
+		CorrectDrift:             true,
+		MinRenderInterval:        5 * time.Second,
+		UnhealthyRenderFailures:  3,
+		V1Alpha1Events:           ctrl.v1alpha1Runtime.Events(),
+	}
+
 	ctrl.controllerRuntime, err = osruntime.NewRuntime(v1alpha1Runtime.State().V1Alpha2().Resources(), ctrl.logger)
 
 	return ctrl, err
@@ -172,14 +196,27 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		},
 		&k8s.AddressFilterController{},
 		k8s.NewControlPlaneAPIServerController(),
+		k8s.NewControlPlaneAPIServerClientCAController(),
+		k8s.NewControlPlaneAPIServerTracingController(),
 		k8s.NewControlPlaneAdmissionControlController(),
 		k8s.NewControlPlaneAuditPolicyController(),
+		k8s.NewControlPlaneAuthenticationController(),
+		k8s.NewControlPlaneKonnectivityServerController(),
+		k8s.NewControlPlaneServiceAccountIssuerDiscoveryController(),
+		k8s.NewControlPlaneAirGappedController(),
 		k8s.NewControlPlaneAuthorizationController(),
 		k8s.NewControlPlaneBootstrapManifestsController(),
+		k8s.NewControlPlaneConfigRenderPolicyController(),
+		k8s.NewControlPlaneConfigNamingPolicyController(),
 		k8s.NewControlPlaneControllerManagerController(),
 		k8s.NewControlPlaneExtraManifestsController(),
 		k8s.NewControlPlaneSchedulerController(),
-		&k8s.ControlPlaneStaticPodController{},
+		k8s.NewControlPlaneServiceAccountSignerController(),
+		k8s.NewControlPlaneAPIServerShutdownController(),
+		&k8s.ControlPlaneStaticPodController{
+			APIServerSecretConfigDir: constants.KubernetesAPIServerSecretConfigDir,
+			SchedulerSecretConfigDir: constants.KubernetesSchedulerSecretConfigDir,
+		},
 		&k8s.EndpointController{},
 		&k8s.ExtraManifestController{},
 		k8s.NewKubeletConfigController(),
@@ -200,12 +237,13 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&k8s.NodeIPController{},
 		&k8s.NodeAnnotationSpecController{},
 		&k8s.NodeApplyController{},
+		&k8s.NodeConfigOverrideController{},
 		&k8s.NodeCordonedSpecController{},
 		&k8s.NodeLabelSpecController{},
 		&k8s.NodeStatusController{},
 		&k8s.NodeTaintSpecController{},
 		&k8s.NodenameController{},
-		&k8s.RenderConfigsStaticPodController{},
+		ctrl.staticPodConfigs,
 		&k8s.RenderSecretsStaticPodController{},
 		&k8s.StaticEndpointController{},
 		&k8s.StaticPodConfigController{},
@@ -357,6 +395,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&runtimecontrollers.WatchdogTimerController{},
 		&secrets.APICertSANsController{},
 		&secrets.APIController{},
+		&secrets.ConfigSecretController{},
 		&secrets.EtcdController{},
 		secrets.NewKubeletController(),
 		&secrets.KubernetesCertSANsController{},
@@ -402,6 +441,21 @@ func (ctrl *Controller) DependencyGraph() (*controller.DependencyGraph, error) {
 	return ctrl.controllerRuntime.GetDependencyGraph()
 }
 
+// SnapshotStaticPodConfigs writes the archive of the control plane static pod configs as they are applied.
+func (ctrl *Controller) SnapshotStaticPodConfigs(ctx context.Context, w io.Writer) error {
+	return ctrl.staticPodConfigs.SnapshotConfigs(ctx, ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(), w)
+}
+
+// RestoreStaticPodConfigs reapplies the control plane static pod configs from the archive written by SnapshotStaticPodConfigs.
+func (ctrl *Controller) RestoreStaticPodConfigs(ctx context.Context, archive io.Reader) error {
+	return ctrl.staticPodConfigs.RestoreConfigs(ctx, archive)
+}
+
+// RollbackStaticPodConfigs reapplies the retained generation of the control plane static pod configs.
+func (ctrl *Controller) RollbackStaticPodConfigs(ctx context.Context, generation uint64) error {
+	return ctrl.staticPodConfigs.RollbackConfigs(ctx, generation)
+}
+
 type loggingDestination struct {
 	Format    string
 	Endpoint  *url.URL
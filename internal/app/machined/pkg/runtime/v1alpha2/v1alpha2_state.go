@@ -133,14 +133,25 @@ func NewState() (*State, error) {
 		&hardware.Processor{},
 		&hardware.SystemInformation{},
 		&k8s.AdmissionControlConfig{},
+		&k8s.AirGappedConfig{},
 		&k8s.AuditPolicyConfig{},
+		&k8s.AuthenticationConfig{},
 		&k8s.AuthorizationConfig{},
+		&k8s.APIServerClientCAConfig{},
 		&k8s.APIServerConfig{},
+		&k8s.APIServerShutdownConfig{},
+		&k8s.APIServerTracingConfig{},
+		&k8s.AppliedConfigFile{},
+		&k8s.ConfigRenderPolicy{},
+		&k8s.ConfigNamingPolicy{},
 		&k8s.KubePrismEndpoints{},
 		&k8s.ConfigStatus{},
+		&k8s.ConfigValidationWarnings{},
 		&k8s.ControllerManagerConfig{},
+		&k8s.EffectiveAdmissionPlugins{},
 		&k8s.Endpoint{},
 		&k8s.ExtraManifestsConfig{},
+		&k8s.KonnectivityServerConfig{},
 		&k8s.KubeletConfig{},
 		&k8s.KubeletLifecycle{},
 		&k8s.KubeletSpec{},
@@ -150,6 +161,7 @@ func NewState() (*State, error) {
 		&k8s.ManifestStatus{},
 		&k8s.BootstrapManifestsConfig{},
 		&k8s.NodeAnnotationSpec{},
+		&k8s.NodeConfigOverride{},
 		&k8s.NodeCordonedSpec{},
 		&k8s.NodeIP{},
 		&k8s.NodeIPConfig{},
@@ -157,11 +169,14 @@ func NewState() (*State, error) {
 		&k8s.Nodename{},
 		&k8s.NodeStatus{},
 		&k8s.NodeTaintSpec{},
+		&k8s.RequiredFeatureGates{},
 		&k8s.SchedulerConfig{},
 		&k8s.StaticPod{},
 		&k8s.StaticPodServerStatus{},
 		&k8s.StaticPodStatus{},
 		&k8s.SecretsStatus{},
+		&k8s.ServiceAccountIssuerDiscovery{},
+		&k8s.ServiceAccountSignerConfig{},
 		&kubeaccess.Config{},
 		&kubespan.Config{},
 		&kubespan.Endpoint{},
@@ -224,6 +239,7 @@ func NewState() (*State, error) {
 		&runtime.WatchdogTimerStatus{},
 		&secrets.API{},
 		&secrets.CertSAN{},
+		&secrets.ConfigSecret{},
 		&secrets.Etcd{},
 		&secrets.EtcdRoot{},
 		&secrets.Kubelet{},
@@ -86,6 +86,9 @@ var rules = map[string]role.Set{
 	"/machine.MachineService/ServiceStart":                role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/ServiceStop":                 role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Shutdown":                    role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/StaticPodConfigsRestore":     role.MakeSet(role.Admin),
+	"/machine.MachineService/StaticPodConfigsRollback":    role.MakeSet(role.Admin),
+	"/machine.MachineService/StaticPodConfigsSnapshot":    role.MakeSet(role.Admin),
 	"/machine.MachineService/Stats":                       role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/SystemStat":                  role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Upgrade":                     role.MakeSet(role.Admin),
@@ -116,9 +116,11 @@ func (suite *SELinuxSuite) TestFileMountLabels() {
 		constants.EtcdPKIPath:                           constants.EtcdPKISELinuxLabel,
 		constants.EtcdDataPath:                          constants.EtcdDataSELinuxLabel,
 		constants.KubernetesAPIServerConfigDir:          constants.KubernetesAPIServerConfigDirSELinuxLabel,
+		constants.KubernetesAPIServerSecretConfigDir:    constants.KubernetesAPIServerConfigDirSELinuxLabel,
 		constants.KubernetesAPIServerSecretsDir:         constants.KubernetesAPIServerSecretsDirSELinuxLabel,
 		constants.KubernetesControllerManagerSecretsDir: constants.KubernetesControllerManagerSecretsDirSELinuxLabel,
 		constants.KubernetesSchedulerConfigDir:          constants.KubernetesSchedulerConfigDirSELinuxLabel,
+		constants.KubernetesSchedulerSecretConfigDir:    constants.KubernetesSchedulerConfigDirSELinuxLabel,
 		constants.KubernetesSchedulerSecretsDir:         constants.KubernetesSchedulerSecretsDirSELinuxLabel,
 		constants.TrustdRuntimeSocketPath:               constants.TrustdRuntimeSocketLabel,
 	}
@@ -75,6 +75,9 @@ const (
 	MachineService_MetaDelete_FullMethodName                  = "/machine.MachineService/MetaDelete"
 	MachineService_ImageList_FullMethodName                   = "/machine.MachineService/ImageList"
 	MachineService_ImagePull_FullMethodName                   = "/machine.MachineService/ImagePull"
+	MachineService_StaticPodConfigsSnapshot_FullMethodName    = "/machine.MachineService/StaticPodConfigsSnapshot"
+	MachineService_StaticPodConfigsRestore_FullMethodName     = "/machine.MachineService/StaticPodConfigsRestore"
+	MachineService_StaticPodConfigsRollback_FullMethodName    = "/machine.MachineService/StaticPodConfigsRollback"
 )
 
 // MachineServiceClient is the client API for MachineService service.
@@ -165,6 +168,17 @@ type MachineServiceClient interface {
 	ImageList(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ImageListResponse], error)
 	// ImagePull pulls an image into the CRI.
 	ImagePull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (*ImagePullResponse, error)
+	// StaticPodConfigsSnapshot streams back the tar archive of the control plane static pod configs as they are applied.
+	// The archive is not encrypted, and it might contain secrets.
+	// This method is available only on control plane nodes.
+	StaticPodConfigsSnapshot(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[common.Data], error)
+	// StaticPodConfigsRestore uploads the archive created with StaticPodConfigsSnapshot to the node,
+	// and reapplies the control plane static pod configs from it.
+	// This method is available only on control plane nodes.
+	StaticPodConfigsRestore(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[common.Data, common.EmptyResponse], error)
+	// StaticPodConfigsRollback reapplies the retained generation of the control plane static pod configs.
+	// This method is available only on control plane nodes.
+	StaticPodConfigsRollback(ctx context.Context, in *StaticPodConfigsRollbackRequest, opts ...grpc.CallOption) (*common.EmptyResponse, error)
 }
 
 type machineServiceClient struct {
@@ -797,6 +811,48 @@ func (c *machineServiceClient) ImagePull(ctx context.Context, in *ImagePullReque
 	return out, nil
 }
 
+func (c *machineServiceClient) StaticPodConfigsSnapshot(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[common.Data], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[12], MachineService_StaticPodConfigsSnapshot_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, common.Data]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MachineService_StaticPodConfigsSnapshotClient = grpc.ServerStreamingClient[common.Data]
+
+func (c *machineServiceClient) StaticPodConfigsRestore(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[common.Data, common.EmptyResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[13], MachineService_StaticPodConfigsRestore_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[common.Data, common.EmptyResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MachineService_StaticPodConfigsRestoreClient = grpc.ClientStreamingClient[common.Data, common.EmptyResponse]
+
+func (c *machineServiceClient) StaticPodConfigsRollback(ctx context.Context, in *StaticPodConfigsRollbackRequest, opts ...grpc.CallOption) (*common.EmptyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.EmptyResponse)
+	err := c.cc.Invoke(ctx, MachineService_StaticPodConfigsRollback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MachineServiceServer is the server API for MachineService service.
 // All implementations must embed UnimplementedMachineServiceServer
 // for forward compatibility.
@@ -885,6 +941,17 @@ type MachineServiceServer interface {
 	ImageList(*ImageListRequest, grpc.ServerStreamingServer[ImageListResponse]) error
 	// ImagePull pulls an image into the CRI.
 	ImagePull(context.Context, *ImagePullRequest) (*ImagePullResponse, error)
+	// StaticPodConfigsSnapshot streams back the tar archive of the control plane static pod configs as they are applied.
+	// The archive is not encrypted, and it might contain secrets.
+	// This method is available only on control plane nodes.
+	StaticPodConfigsSnapshot(*emptypb.Empty, grpc.ServerStreamingServer[common.Data]) error
+	// StaticPodConfigsRestore uploads the archive created with StaticPodConfigsSnapshot to the node,
+	// and reapplies the control plane static pod configs from it.
+	// This method is available only on control plane nodes.
+	StaticPodConfigsRestore(grpc.ClientStreamingServer[common.Data, common.EmptyResponse]) error
+	// StaticPodConfigsRollback reapplies the retained generation of the control plane static pod configs.
+	// This method is available only on control plane nodes.
+	StaticPodConfigsRollback(context.Context, *StaticPodConfigsRollbackRequest) (*common.EmptyResponse, error)
 	mustEmbedUnimplementedMachineServiceServer()
 }
 
@@ -1051,6 +1118,15 @@ func (UnimplementedMachineServiceServer) ImageList(*ImageListRequest, grpc.Serve
 func (UnimplementedMachineServiceServer) ImagePull(context.Context, *ImagePullRequest) (*ImagePullResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImagePull not implemented")
 }
+func (UnimplementedMachineServiceServer) StaticPodConfigsSnapshot(*emptypb.Empty, grpc.ServerStreamingServer[common.Data]) error {
+	return status.Errorf(codes.Unimplemented, "method StaticPodConfigsSnapshot not implemented")
+}
+func (UnimplementedMachineServiceServer) StaticPodConfigsRestore(grpc.ClientStreamingServer[common.Data, common.EmptyResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StaticPodConfigsRestore not implemented")
+}
+func (UnimplementedMachineServiceServer) StaticPodConfigsRollback(context.Context, *StaticPodConfigsRollbackRequest) (*common.EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StaticPodConfigsRollback not implemented")
+}
 func (UnimplementedMachineServiceServer) mustEmbedUnimplementedMachineServiceServer() {}
 func (UnimplementedMachineServiceServer) testEmbeddedByValue()                        {}
 
@@ -1920,6 +1996,42 @@ func _MachineService_ImagePull_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_StaticPodConfigsSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).StaticPodConfigsSnapshot(m, &grpc.GenericServerStream[emptypb.Empty, common.Data]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MachineService_StaticPodConfigsSnapshotServer = grpc.ServerStreamingServer[common.Data]
+
+func _MachineService_StaticPodConfigsRestore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MachineServiceServer).StaticPodConfigsRestore(&grpc.GenericServerStream[common.Data, common.EmptyResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MachineService_StaticPodConfigsRestoreServer = grpc.ClientStreamingServer[common.Data, common.EmptyResponse]
+
+func _MachineService_StaticPodConfigsRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StaticPodConfigsRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).StaticPodConfigsRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_StaticPodConfigsRollback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).StaticPodConfigsRollback(ctx, req.(*StaticPodConfigsRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MachineService_ServiceDesc is the grpc.ServiceDesc for MachineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2087,6 +2199,10 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImagePull",
 			Handler:    _MachineService_ImagePull_Handler,
 		},
+		{
+			MethodName: "StaticPodConfigsRollback",
+			Handler:    _MachineService_StaticPodConfigsRollback_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2149,6 +2265,16 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MachineService_ImageList_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StaticPodConfigsSnapshot",
+			Handler:       _MachineService_StaticPodConfigsSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StaticPodConfigsRestore",
+			Handler:       _MachineService_StaticPodConfigsRestore_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "machine/machine.proto",
 }
@@ -820,6 +820,75 @@ func (c *Client) EtcdRecover(ctx context.Context, snapshot io.Reader, callOption
 	return FilterMessages(resp, err)
 }
 
+// StaticPodConfigsSnapshot receives a snapshot of the control plane static pod configs from the node.
+//
+// This method doesn't support multiplexing of the result:
+// * either client.WithNodes is not used, or it contains a single node in the list.
+func (c *Client) StaticPodConfigsSnapshot(ctx context.Context, callOptions ...grpc.CallOption) (io.ReadCloser, error) {
+	stream, err := c.MachineClient.StaticPodConfigsSnapshot(ctx, &emptypb.Empty{}, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ReadStream(stream)
+}
+
+// StaticPodConfigsRestore uploads the snapshot created with StaticPodConfigsSnapshot to the node.
+func (c *Client) StaticPodConfigsRestore(ctx context.Context, snapshot io.Reader, callOptions ...grpc.CallOption) error {
+	cli, err := c.MachineClient.StaticPodConfigsRestore(ctx, callOptions...)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, 4096)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		var n int
+
+		n, err = snapshot.Read(buf)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return fmt.Errorf("error reading snapshot: %w", err)
+		}
+
+		if err = cli.Send(&common.Data{
+			Bytes: buf[:n],
+		}); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return err
+		}
+	}
+
+	resp, err := cli.CloseAndRecv()
+
+	_, err = FilterMessages(resp, err)
+
+	return err
+}
+
+// StaticPodConfigsRollback reapplies the retained generation of the control plane static pod configs on the node.
+func (c *Client) StaticPodConfigsRollback(ctx context.Context, generation uint64, callOptions ...grpc.CallOption) error {
+	resp, err := c.MachineClient.StaticPodConfigsRollback(ctx, &machineapi.StaticPodConfigsRollbackRequest{
+		Generation: generation,
+	}, callOptions...)
+
+	_, err = FilterMessages(resp, err)
+
+	return err
+}
+
 // EtcdAlarmList lists etcd alarms for the current node.
 //
 // This method is available only on control plane nodes (which run etcd).
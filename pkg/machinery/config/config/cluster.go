@@ -24,6 +24,7 @@ type ClusterConfig interface {
 	ControllerManager() ControllerManager
 	Proxy() Proxy
 	Scheduler() Scheduler
+	StaticPodConfigs() StaticPodConfigs
 	Endpoint() *url.URL
 	Token() Token
 	CertSANs() []string
@@ -85,12 +86,23 @@ type APIServer interface {
 	AuditPolicy() map[string]any
 	Resources() Resources
 	AuthorizationConfig() []AuthorizationConfigAuthorizer
+	AuthenticationConfig() map[string]any
+	KonnectivityServer() KonnectivityServer
+	ServiceAccountIssuerDiscovery() ServiceAccountIssuerDiscovery
+	AirGapped() AirGapped
+	KMSEncryption() KMSEncryption
+	ClientCAs() []*x509.PEMEncodedCertificate
+	RequestHeaderClientCAs() []*x509.PEMEncodedCertificate
+	Tracing() APIServerTracing
+	ServiceAccountSigningEndpoint() string
+	Shutdown() APIServerShutdown
 }
 
 // AdmissionPlugin defines the API server Admission Plugin configuration.
 type AdmissionPlugin interface {
 	Name() string
 	Configuration() map[string]any
+	ConfigurationYAML() string
 }
 
 // AuthorizationConfigAuthorizer defines the API server Authorization Authorizer configuration.
@@ -100,6 +112,54 @@ type AuthorizationConfigAuthorizer interface {
 	Webhook() map[string]any
 }
 
+// KonnectivityServer defines the konnectivity server the API server sends the cluster egress traffic through.
+type KonnectivityServer interface {
+	ListenAddress() string
+	AgentNamespace() string
+	AgentServiceAccount() string
+	EgressSelections() []KonnectivityEgressSelection
+}
+
+// KonnectivityEgressSelection defines the connection the API server uses for the egress traffic of the selection.
+type KonnectivityEgressSelection interface {
+	Name() string
+	ProxyProtocol() string
+	Transport() string
+	Address() string
+}
+
+// ServiceAccountIssuerDiscovery defines the cached discovery documents of the service account issuer.
+type ServiceAccountIssuerDiscovery interface {
+	DiscoveryDocument() string
+	JWKS() string
+}
+
+// AirGapped defines the hosts reachable from the air-gapped control plane.
+type AirGapped interface {
+	AllowedHosts() []string
+}
+
+// KMSEncryption defines the KMS v2 provider for the encryption of secret data at rest.
+type KMSEncryption interface {
+	Name() string
+	Endpoint() string
+	Timeout() time.Duration
+	SocketDir() string
+}
+
+// APIServerTracing defines the OpenTelemetry tracing of the API server.
+type APIServerTracing interface {
+	Endpoint() string
+	SamplingRatePerMillion() int32
+}
+
+// APIServerShutdown defines the graceful shutdown of the API server.
+type APIServerShutdown interface {
+	ShutdownDelay() time.Duration
+	WatchTerminationGracePeriod() time.Duration
+	TerminationGracePeriod() time.Duration
+}
+
 // ControllerManager defines the requirements for a config that pertains to controller manager related
 // options.
 type ControllerManager interface {
@@ -135,6 +195,29 @@ type Scheduler interface {
 	Config() map[string]any
 }
 
+// StaticPodConfigs defines the requirements for a config that pertains to rendering
+// the control plane static pod configs.
+type StaticPodConfigs interface {
+	ValidateOnly() bool
+	Secrets() []StaticPodConfigSecret
+	Overrides() []StaticPodConfigOverride
+	StrictAdmissionPlugins() bool
+	StrictStructuredAuth() bool
+	Filenames() map[string]string
+}
+
+// StaticPodConfigSecret defines the secret values referenced from the control plane static pod configs.
+type StaticPodConfigSecret interface {
+	Name() string
+	Data() map[string]string
+}
+
+// StaticPodConfigOverride defines the node-specific override of the control plane static pod config.
+type StaticPodConfigOverride interface {
+	Filename() string
+	Config() map[string]any
+}
+
 // Etcd defines the requirements for a config that pertains to etcd related
 // options.
 type Etcd interface {
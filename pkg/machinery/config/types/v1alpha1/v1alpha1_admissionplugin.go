@@ -4,6 +4,13 @@
 
 package v1alpha1
 
+import (
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
 // Name implements the config.AdmissionPlugin interface.
 func (a *AdmissionPluginConfig) Name() string {
 	return a.PluginName
@@ -13,3 +20,27 @@ func (a *AdmissionPluginConfig) Name() string {
 func (a *AdmissionPluginConfig) Configuration() map[string]any {
 	return a.PluginConfiguration.Object
 }
+
+// ConfigurationYAML implements the config.AdmissionPlugin interface.
+func (a *AdmissionPluginConfig) ConfigurationYAML() string {
+	return a.PluginConfigurationYAML
+}
+
+// Validate validates the AdmissionPluginConfig.
+func (a *AdmissionPluginConfig) Validate() error {
+	if a.PluginConfigurationYAML == "" {
+		return nil
+	}
+
+	if len(a.PluginConfiguration.Object) > 0 {
+		return errors.New("configuration and configurationYAML can't be used together")
+	}
+
+	var configuration map[string]any
+
+	if err := yaml.Unmarshal([]byte(a.PluginConfigurationYAML), &configuration); err != nil {
+		return fmt.Errorf("error decoding configurationYAML: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// AllowedHosts implements the config.AirGapped interface.
+func (a *AirGappedConfig) AllowedHosts() []string {
+	return a.AirGappedAllowedHosts
+}
+
+// Validate validates the AirGappedConfig.
+func (a *AirGappedConfig) Validate() error {
+	if len(a.AirGappedAllowedHosts) == 0 {
+		return errors.New("at least one allowed host should be set")
+	}
+
+	for _, host := range a.AirGappedAllowedHosts {
+		if _, err := netip.ParseAddr(host); err == nil {
+			continue
+		}
+
+		// hosts are matched against the hostname of the URLs, so they can't have a scheme or a port
+		if name := strings.TrimPrefix(host, "."); name == "" || strings.ContainsAny(name, "/:@") {
+			return fmt.Errorf("allowed host %q should be a hostname or an IP address", host)
+		}
+	}
+
+	return nil
+}
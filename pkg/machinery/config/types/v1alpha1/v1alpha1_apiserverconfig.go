@@ -6,8 +6,10 @@ package v1alpha1
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-pointer"
 
@@ -96,6 +98,80 @@ func (a *APIServerConfig) AuthorizationConfig() []config.AuthorizationConfigAuth
 	return xslices.Map(a.AuthorizationConfigConfig, func(c *AuthorizationConfigAuthorizerConfig) config.AuthorizationConfigAuthorizer { return c })
 }
 
+// AuthenticationConfig implements the config.APIServer interface.
+func (a *APIServerConfig) AuthenticationConfig() map[string]any {
+	return a.AuthenticationConfigConfig.Object
+}
+
+// KonnectivityServer implements the config.APIServer interface.
+func (a *APIServerConfig) KonnectivityServer() config.KonnectivityServer {
+	if a.KonnectivityServerConfig == nil {
+		return nil
+	}
+
+	return a.KonnectivityServerConfig
+}
+
+// ServiceAccountIssuerDiscovery implements the config.APIServer interface.
+func (a *APIServerConfig) ServiceAccountIssuerDiscovery() config.ServiceAccountIssuerDiscovery {
+	if a.ServiceAccountIssuerDiscoveryConfig == nil {
+		return nil
+	}
+
+	return a.ServiceAccountIssuerDiscoveryConfig
+}
+
+// AirGapped implements the config.APIServer interface.
+func (a *APIServerConfig) AirGapped() config.AirGapped {
+	if a.AirGappedConfig == nil {
+		return nil
+	}
+
+	return a.AirGappedConfig
+}
+
+// KMSEncryption implements the config.APIServer interface.
+func (a *APIServerConfig) KMSEncryption() config.KMSEncryption {
+	if a.KMSEncryptionConfig == nil {
+		return nil
+	}
+
+	return a.KMSEncryptionConfig
+}
+
+// ClientCAs implements the config.APIServer interface.
+func (a *APIServerConfig) ClientCAs() []*x509.PEMEncodedCertificate {
+	return a.APIServerClientCAs
+}
+
+// RequestHeaderClientCAs implements the config.APIServer interface.
+func (a *APIServerConfig) RequestHeaderClientCAs() []*x509.PEMEncodedCertificate {
+	return a.APIServerRequestHeaderClientCAs
+}
+
+// Tracing implements the config.APIServer interface.
+func (a *APIServerConfig) Tracing() config.APIServerTracing {
+	if a.TracingConfig == nil {
+		return nil
+	}
+
+	return a.TracingConfig
+}
+
+// ServiceAccountSigningEndpoint implements the config.APIServer interface.
+func (a *APIServerConfig) ServiceAccountSigningEndpoint() string {
+	return a.APIServerServiceAccountSigningEndpoint
+}
+
+// Shutdown implements the config.APIServer interface.
+func (a *APIServerConfig) Shutdown() config.APIServerShutdown {
+	if a.ShutdownConfig == nil {
+		return nil
+	}
+
+	return a.ShutdownConfig
+}
+
 // Validate performs config validation.
 func (a *APIServerConfig) Validate() error {
 	if a == nil {
@@ -114,6 +190,72 @@ func (a *APIServerConfig) Validate() error {
 		}
 	}
 
+	for _, admissionPlugin := range a.AdmissionControlConfig {
+		if err := admissionPlugin.Validate(); err != nil {
+			return fmt.Errorf("apiserver admission plugin %q config validation failed: %w", admissionPlugin.PluginName, err)
+		}
+	}
+
+	if a.KonnectivityServerConfig != nil {
+		if err := a.KonnectivityServerConfig.Validate(); err != nil {
+			return fmt.Errorf("apiserver konnectivity server config validation failed: %w", err)
+		}
+	}
+
+	if len(a.AuthenticationConfigConfig.Object) > 0 {
+		for k := range a.ExtraArgs() {
+			if strings.HasPrefix(k, "oidc-") {
+				return fmt.Errorf("oidc-* flags cannot be used in conjunction with AuthenticationConfig, use either AuthenticationConfig or oidc-* flags")
+			}
+		}
+	}
+
+	if a.ServiceAccountIssuerDiscoveryConfig != nil {
+		if err := a.ServiceAccountIssuerDiscoveryConfig.Validate(); err != nil {
+			return fmt.Errorf("apiserver service account issuer discovery config validation failed: %w", err)
+		}
+	}
+
+	if a.AirGappedConfig != nil {
+		if err := a.AirGappedConfig.Validate(); err != nil {
+			return fmt.Errorf("apiserver air-gapped config validation failed: %w", err)
+		}
+	}
+
+	if a.KMSEncryptionConfig != nil {
+		if err := a.KMSEncryptionConfig.Validate(); err != nil {
+			return fmt.Errorf("apiserver KMS encryption config validation failed: %w", err)
+		}
+	}
+
+	if a.TracingConfig != nil {
+		if err := a.TracingConfig.Validate(); err != nil {
+			return fmt.Errorf("apiserver tracing config validation failed: %w", err)
+		}
+	}
+
+	if a.ShutdownConfig != nil {
+		if err := a.ShutdownConfig.Validate(); err != nil {
+			return fmt.Errorf("apiserver shutdown config validation failed: %w", err)
+		}
+
+		for _, flag := range []string{"shutdown-delay-duration", "shutdown-watch-termination-grace-period"} {
+			if _, ok := a.ExtraArgs()[flag]; ok {
+				return fmt.Errorf("%s flag cannot be used in conjunction with the shutdown config", flag)
+			}
+		}
+	}
+
+	if a.APIServerServiceAccountSigningEndpoint != "" {
+		if !filepath.IsAbs(a.APIServerServiceAccountSigningEndpoint) || filepath.Clean(a.APIServerServiceAccountSigningEndpoint) != a.APIServerServiceAccountSigningEndpoint {
+			return fmt.Errorf("service account signing endpoint %q should be an absolute unix socket path", a.APIServerServiceAccountSigningEndpoint)
+		}
+
+		if _, ok := a.ExtraArgs()["service-account-signing-endpoint"]; ok {
+			return fmt.Errorf("service-account-signing-endpoint flag cannot be used in conjunction with serviceAccountSigningEndpoint")
+		}
+	}
+
 	for _, authorizationConfig := range a.AuthorizationConfigConfig {
 		if err := authorizationConfig.Validate(); err != nil {
 			return fmt.Errorf("apiserver authorization config validation failed: %w", err)
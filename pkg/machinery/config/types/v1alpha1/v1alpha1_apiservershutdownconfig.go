@@ -0,0 +1,50 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+)
+
+// ShutdownDelay implements the config.APIServerShutdown interface.
+func (s *APIServerShutdownConfig) ShutdownDelay() time.Duration {
+	return s.APIServerShutdownDelay
+}
+
+// WatchTerminationGracePeriod implements the config.APIServerShutdown interface.
+func (s *APIServerShutdownConfig) WatchTerminationGracePeriod() time.Duration {
+	return s.APIServerWatchTerminationGracePeriod
+}
+
+// TerminationGracePeriod implements the config.APIServerShutdown interface.
+func (s *APIServerShutdownConfig) TerminationGracePeriod() time.Duration {
+	return s.APIServerTerminationGracePeriod
+}
+
+// Validate validates the APIServerShutdownConfig.
+//
+// Whether the shutdown fits into the termination grace period of the pod is validated when the config is rendered,
+// as the default termination grace period is set by the static pod controller.
+func (s *APIServerShutdownConfig) Validate() error {
+	for _, duration := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"shutdown delay", s.APIServerShutdownDelay},
+		{"watch termination grace period", s.APIServerWatchTerminationGracePeriod},
+		{"termination grace period", s.APIServerTerminationGracePeriod},
+	} {
+		if duration.value < 0 {
+			return fmt.Errorf("%s should not be negative, got %s", duration.name, duration.value)
+		}
+	}
+
+	if s.APIServerTerminationGracePeriod%time.Second != 0 {
+		return fmt.Errorf("termination grace period should be whole seconds, got %s", s.APIServerTerminationGracePeriod)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// maxTracingSamplingRatePerMillion is the sampling rate of every span.
+const maxTracingSamplingRatePerMillion = 1_000_000
+
+// Endpoint implements the config.APIServerTracing interface.
+func (t *APIServerTracingConfig) Endpoint() string {
+	return t.TracingEndpoint
+}
+
+// SamplingRatePerMillion implements the config.APIServerTracing interface.
+func (t *APIServerTracingConfig) SamplingRatePerMillion() int32 {
+	return t.TracingSamplingRatePerMillion
+}
+
+// Validate validates the APIServerTracingConfig.
+//
+// The endpoint is validated against the kube-apiserver rules when the tracing config is rendered.
+func (t *APIServerTracingConfig) Validate() error {
+	if t.TracingEndpoint != "" {
+		// the OTLP exporter of kube-apiserver is always insecure, so an https:// endpoint would silently send the traces in plain text
+		if strings.Contains(t.TracingEndpoint, "://") {
+			return fmt.Errorf("tracing endpoint %q should be host:port, the API server doesn't support TLS for the tracing connection", t.TracingEndpoint)
+		}
+
+		if _, _, err := net.SplitHostPort(t.TracingEndpoint); err != nil {
+			return fmt.Errorf("tracing endpoint %q should be host:port: %w", t.TracingEndpoint, err)
+		}
+	}
+
+	if t.TracingSamplingRatePerMillion < 0 || t.TracingSamplingRatePerMillion > maxTracingSamplingRatePerMillion {
+		return fmt.Errorf("tracing sampling rate per million should be between 0 and %d, got %d", maxTracingSamplingRatePerMillion, t.TracingSamplingRatePerMillion)
+	}
+
+	return nil
+}
@@ -177,6 +177,15 @@ func (c *ClusterConfig) AdminKubeconfig() config.AdminKubeconfig {
 	return c.AdminKubeconfigConfig
 }
 
+// StaticPodConfigs implements the config.ClusterConfig interface.
+func (c *ClusterConfig) StaticPodConfigs() config.StaticPodConfigs {
+	if c.StaticPodConfigsConfig == nil {
+		return &StaticPodConfigsConfig{}
+	}
+
+	return c.StaticPodConfigsConfig
+}
+
 // ScheduleOnControlPlanes implements the config.ClusterConfig interface.
 func (c *ClusterConfig) ScheduleOnControlPlanes() bool {
 	if c.AllowSchedulingOnControlPlanes != nil {
@@ -402,6 +402,42 @@ func clusterSchedulerExample() *SchedulerConfig {
 	}
 }
 
+func clusterStaticPodConfigsExample() *StaticPodConfigsConfig {
+	return &StaticPodConfigsConfig{
+		ConfigsValidateOnly: pointer.To(true),
+	}
+}
+
+func staticPodConfigSecretsExample() []StaticPodConfigSecret {
+	return []StaticPodConfigSecret{
+		{
+			SecretName: "oidc",
+			SecretData: map[string]string{
+				"clientSecret": "c2VjcmV0",
+			},
+		},
+	}
+}
+
+func staticPodConfigOverridesExample() []StaticPodConfigOverride {
+	return []StaticPodConfigOverride{
+		{
+			OverrideFilename: "scheduler-config.yaml",
+			OverrideConfig: Unstructured{
+				Object: map[string]any{
+					"parallelism": 32,
+				},
+			},
+		},
+	}
+}
+
+func staticPodConfigFilenamesExample() map[string]string {
+	return map[string]string{
+		"auditpolicy.yaml": "audit-policy-v2.yaml",
+	}
+}
+
 func clusterSchedulerImageExample() string {
 	return (&SchedulerConfig{}).Image()
 }
@@ -749,6 +785,88 @@ func admissionControlConfigExample() []*AdmissionPluginConfig {
 	}
 }
 
+func konnectivityServerConfigExample() *KonnectivityServerConfig {
+	return &KonnectivityServerConfig{
+		ServerListenAddress:       "/etc/kubernetes/konnectivity-server/konnectivity-server.socket",
+		ServerAgentNamespace:      "kube-system",
+		ServerAgentServiceAccount: "konnectivity-agent",
+	}
+}
+
+func serviceAccountIssuerDiscoveryConfigExample() *ServiceAccountIssuerDiscoveryConfig {
+	return &ServiceAccountIssuerDiscoveryConfig{
+		IssuerDiscoveryDocument: `{"issuer":"https://kubernetes.example.com:6443","jwks_uri":"https://kubernetes.example.com:6443/openid/v1/jwks","response_types_supported":["id_token"],"subject_types_supported":["public"],"id_token_signing_alg_values_supported":["RS256"]}`, //nolint:lll
+		IssuerJWKS:              `{"keys":[{"use":"sig","kty":"RSA","kid":"...","alg":"RS256","n":"...","e":"AQAB"}]}`,
+	}
+}
+
+func airGappedConfigExample() *AirGappedConfig {
+	return &AirGappedConfig{
+		AirGappedAllowedHosts: []string{"dex.corp.internal", ".idp.corp.internal"},
+	}
+}
+
+func apiServerTracingConfigExample() *APIServerTracingConfig {
+	return &APIServerTracingConfig{
+		TracingEndpoint:               "10.5.0.1:4317",
+		TracingSamplingRatePerMillion: 100,
+	}
+}
+
+func apiServerShutdownConfigExample() *APIServerShutdownConfig {
+	return &APIServerShutdownConfig{
+		APIServerShutdownDelay:               10 * time.Second,
+		APIServerWatchTerminationGracePeriod: 5 * time.Second,
+		APIServerTerminationGracePeriod:      30 * time.Second,
+	}
+}
+
+func kmsEncryptionConfigExample() *KMSEncryptionConfig {
+	return &KMSEncryptionConfig{
+		KMSName:     "vault",
+		KMSEndpoint: "unix:///var/run/kms/kms.sock",
+		KMSTimeout:  3 * time.Second,
+	}
+}
+
+func konnectivityEgressSelectionsExample() []KonnectivityEgressSelectionConfig {
+	return []KonnectivityEgressSelectionConfig{
+		{
+			SelectionName:          "controlplane",
+			SelectionProxyProtocol: "Direct",
+		},
+		{
+			SelectionName:          "etcd",
+			SelectionProxyProtocol: "HTTPConnect",
+			SelectionTransport:     "tcp",
+			SelectionAddress:       "127.0.0.1:8132",
+		},
+	}
+}
+
+func authenticationConfigExample() Unstructured {
+	return Unstructured{
+		Object: map[string]any{
+			"apiVersion": "apiserver.config.k8s.io/v1beta1",
+			"kind":       "AuthenticationConfiguration",
+			"jwt": []any{
+				map[string]any{
+					"issuer": map[string]any{
+						"url":       "https://issuer.example.com",
+						"audiences": []any{"kubernetes"},
+					},
+					"claimMappings": map[string]any{
+						"username": map[string]any{
+							"claim":  "email",
+							"prefix": "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func authorizationConfigExample() []*AuthorizationConfigAuthorizerConfig {
 	return []*AuthorizationConfigAuthorizerConfig{
 		{
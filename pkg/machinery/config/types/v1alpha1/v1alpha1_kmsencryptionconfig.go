@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// Name implements the config.KMSEncryption interface.
+func (k *KMSEncryptionConfig) Name() string {
+	return k.KMSName
+}
+
+// Endpoint implements the config.KMSEncryption interface.
+func (k *KMSEncryptionConfig) Endpoint() string {
+	return k.KMSEndpoint
+}
+
+// Timeout implements the config.KMSEncryption interface.
+func (k *KMSEncryptionConfig) Timeout() time.Duration {
+	if k.KMSTimeout == 0 {
+		return constants.KubernetesKMSEncryptionDefaultTimeout
+	}
+
+	return k.KMSTimeout
+}
+
+// SocketDir implements the config.KMSEncryption interface.
+func (k *KMSEncryptionConfig) SocketDir() string {
+	u, err := url.Parse(k.KMSEndpoint)
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Dir(u.Path)
+}
+
+// Validate validates the KMSEncryptionConfig.
+func (k *KMSEncryptionConfig) Validate() error {
+	// kube-apiserver uses the name in the prefix of the encrypted data, separated by a colon
+	if k.KMSName == "" || strings.Contains(k.KMSName, ":") {
+		return fmt.Errorf("KMS plugin name %q should be set and shouldn't contain colons", k.KMSName)
+	}
+
+	u, err := url.Parse(k.KMSEndpoint)
+	if err != nil || u.Scheme != "unix" || u.Host != "" || !filepath.IsAbs(u.Path) || filepath.Dir(u.Path) == "/" {
+		return fmt.Errorf("KMS plugin endpoint %q should be a unix socket in a directory (unix:///path/to/socket)", k.KMSEndpoint)
+	}
+
+	if k.KMSTimeout < 0 {
+		return errors.New("KMS plugin timeout should be positive")
+	}
+
+	return nil
+}
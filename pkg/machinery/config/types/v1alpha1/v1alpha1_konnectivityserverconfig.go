@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"cmp"
+	"errors"
+
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+const (
+	defaultKonnectivityAgentNamespace      = "kube-system"
+	defaultKonnectivityAgentServiceAccount = "konnectivity-agent"
+)
+
+// ListenAddress implements the config.KonnectivityServer interface.
+func (k *KonnectivityServerConfig) ListenAddress() string {
+	return k.ServerListenAddress
+}
+
+// AgentNamespace implements the config.KonnectivityServer interface.
+func (k *KonnectivityServerConfig) AgentNamespace() string {
+	return cmp.Or(k.ServerAgentNamespace, defaultKonnectivityAgentNamespace)
+}
+
+// AgentServiceAccount implements the config.KonnectivityServer interface.
+func (k *KonnectivityServerConfig) AgentServiceAccount() string {
+	return cmp.Or(k.ServerAgentServiceAccount, defaultKonnectivityAgentServiceAccount)
+}
+
+// EgressSelections implements the config.KonnectivityServer interface.
+func (k *KonnectivityServerConfig) EgressSelections() []config.KonnectivityEgressSelection {
+	return xslices.Map(k.ServerEgressSelections, func(s KonnectivityEgressSelectionConfig) config.KonnectivityEgressSelection { return s })
+}
+
+// Name implements the config.KonnectivityEgressSelection interface.
+func (s KonnectivityEgressSelectionConfig) Name() string {
+	return s.SelectionName
+}
+
+// ProxyProtocol implements the config.KonnectivityEgressSelection interface.
+func (s KonnectivityEgressSelectionConfig) ProxyProtocol() string {
+	return s.SelectionProxyProtocol
+}
+
+// Transport implements the config.KonnectivityEgressSelection interface.
+func (s KonnectivityEgressSelectionConfig) Transport() string {
+	return s.SelectionTransport
+}
+
+// Address implements the config.KonnectivityEgressSelection interface.
+func (s KonnectivityEgressSelectionConfig) Address() string {
+	return s.SelectionAddress
+}
+
+// Validate validates the KonnectivityServerConfig.
+//
+// The listen address and the egress selections are validated when the egress selector config is rendered.
+func (k *KonnectivityServerConfig) Validate() error {
+	if k.ServerListenAddress == "" {
+		return errors.New("konnectivity server listen address must be set")
+	}
+
+	return nil
+}
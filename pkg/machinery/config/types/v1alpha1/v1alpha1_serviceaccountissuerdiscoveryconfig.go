@@ -0,0 +1,35 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// DiscoveryDocument implements the config.ServiceAccountIssuerDiscovery interface.
+func (s *ServiceAccountIssuerDiscoveryConfig) DiscoveryDocument() string {
+	return s.IssuerDiscoveryDocument
+}
+
+// JWKS implements the config.ServiceAccountIssuerDiscovery interface.
+func (s *ServiceAccountIssuerDiscoveryConfig) JWKS() string {
+	return s.IssuerJWKS
+}
+
+// Validate validates the ServiceAccountIssuerDiscoveryConfig.
+//
+// The issuer and the keys are validated when the documents are rendered.
+func (s *ServiceAccountIssuerDiscoveryConfig) Validate() error {
+	if !json.Valid([]byte(s.IssuerDiscoveryDocument)) {
+		return errors.New("discovery document should be a valid JSON document")
+	}
+
+	if !json.Valid([]byte(s.IssuerJWKS)) {
+		return errors.New("JWKS should be a valid JSON document")
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// ValidateOnly implements the config.StaticPodConfigs interface.
+func (s *StaticPodConfigsConfig) ValidateOnly() bool {
+	return pointer.SafeDeref(s.ConfigsValidateOnly)
+}
+
+// Secrets implements the config.StaticPodConfigs interface.
+func (s *StaticPodConfigsConfig) Secrets() []config.StaticPodConfigSecret {
+	return xslices.Map(s.ConfigsSecrets, func(secret StaticPodConfigSecret) config.StaticPodConfigSecret { return secret })
+}
+
+// Name implements the config.StaticPodConfigSecret interface.
+func (s StaticPodConfigSecret) Name() string {
+	return s.SecretName
+}
+
+// Data implements the config.StaticPodConfigSecret interface.
+func (s StaticPodConfigSecret) Data() map[string]string {
+	return s.SecretData
+}
+
+// Overrides implements the config.StaticPodConfigs interface.
+func (s *StaticPodConfigsConfig) Overrides() []config.StaticPodConfigOverride {
+	return xslices.Map(s.ConfigsOverrides, func(override StaticPodConfigOverride) config.StaticPodConfigOverride { return override })
+}
+
+// Filename implements the config.StaticPodConfigOverride interface.
+func (o StaticPodConfigOverride) Filename() string {
+	return o.OverrideFilename
+}
+
+// Config implements the config.StaticPodConfigOverride interface.
+func (o StaticPodConfigOverride) Config() map[string]any {
+	return o.OverrideConfig.Object
+}
+
+// StrictAdmissionPlugins implements the config.StaticPodConfigs interface.
+func (s *StaticPodConfigsConfig) StrictAdmissionPlugins() bool {
+	return pointer.SafeDeref(s.ConfigsStrictAdmissionPlugins)
+}
+
+// StrictStructuredAuth implements the config.StaticPodConfigs interface.
+func (s *StaticPodConfigsConfig) StrictStructuredAuth() bool {
+	return pointer.SafeDeref(s.ConfigsStrictStructuredAuth)
+}
+
+// Filenames implements the config.StaticPodConfigs interface.
+func (s *StaticPodConfigsConfig) Filenames() map[string]string {
+	return s.ConfigsFilenames
+}
+
+// Validate performs config validation.
+func (s *StaticPodConfigsConfig) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	names := map[string]struct{}{}
+
+	for _, secret := range s.ConfigsSecrets {
+		// the name and the keys should be matched by the secret references
+		if secret.SecretName == "" || strings.ContainsAny(secret.SecretName, "/}") {
+			return fmt.Errorf("invalid static pod config secret name %q", secret.SecretName)
+		}
+
+		if _, ok := names[secret.SecretName]; ok {
+			return fmt.Errorf("duplicate static pod config secret %q", secret.SecretName)
+		}
+
+		names[secret.SecretName] = struct{}{}
+
+		for key := range secret.SecretData {
+			if key == "" || strings.Contains(key, "}") {
+				return fmt.Errorf("invalid key %q in static pod config secret %q", key, secret.SecretName)
+			}
+		}
+	}
+
+	filenames := map[string]struct{}{}
+
+	for _, override := range s.ConfigsOverrides {
+		if override.OverrideFilename == "" || strings.ContainsRune(override.OverrideFilename, '/') {
+			return fmt.Errorf("invalid static pod config override filename %q", override.OverrideFilename)
+		}
+
+		if _, ok := filenames[override.OverrideFilename]; ok {
+			return fmt.Errorf("duplicate static pod config override for %q", override.OverrideFilename)
+		}
+
+		filenames[override.OverrideFilename] = struct{}{}
+	}
+
+	for filename, diskFilename := range s.ConfigsFilenames {
+		// the configs are rendered to the pod config directory, the names reserved within the directory are checked on render
+		if diskFilename == "" || strings.ContainsRune(diskFilename, '/') || strings.HasPrefix(diskFilename, ".") {
+			return fmt.Errorf("invalid static pod config filename %q for %q", diskFilename, filename)
+		}
+	}
+
+	return nil
+}
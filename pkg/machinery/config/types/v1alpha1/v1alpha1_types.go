@@ -466,6 +466,11 @@ type ClusterConfig struct {
 	//     - value: clusterSchedulerExample()
 	SchedulerConfig *SchedulerConfig `yaml:"scheduler,omitempty"`
 	//   description: |
+	//     Settings for rendering the configs of the control plane static pods.
+	//   examples:
+	//     - value: clusterStaticPodConfigsExample()
+	StaticPodConfigsConfig *StaticPodConfigsConfig `yaml:"staticPodConfigs,omitempty"`
+	//   description: |
 	//     Configures cluster member discovery.
 	//   examples:
 	//     - value: clusterDiscoveryExample()
@@ -1150,6 +1155,81 @@ type APIServerConfig struct {
 	//   examples:
 	//     - value: authorizationConfigExample()
 	AuthorizationConfigConfig AuthorizationConfigAuthorizerConfigList `yaml:"authorizationConfig,omitempty"`
+	//   description: |
+	//     Configure the API server structured authentication config.
+	//     The config is passed to the API server with the `--authentication-config` flag, and requires Kubernetes 1.30 or later.
+	//   examples:
+	//     - value: authenticationConfigExample()
+	//   schema:
+	//     type: object
+	AuthenticationConfigConfig Unstructured `yaml:"authenticationConfig,omitempty" merge:"replace"`
+	//   description: |
+	//     Configure the konnectivity server the API server sends the cluster egress traffic through.
+	//     The egress selector config is passed to the API server with the `--egress-selector-config-file` flag.
+	//   examples:
+	//     - value: konnectivityServerConfigExample()
+	KonnectivityServerConfig *KonnectivityServerConfig `yaml:"konnectivityServer,omitempty"`
+	//   description: |
+	//     The cached OpenID discovery document and JWKS of the service account issuer.
+	//     They are written to the API server config directory as `openid-configuration.json` and `jwks.json`.
+	//   examples:
+	//     - value: serviceAccountIssuerDiscoveryConfigExample()
+	ServiceAccountIssuerDiscoveryConfig *ServiceAccountIssuerDiscoveryConfig `yaml:"serviceAccountIssuerDiscovery,omitempty"`
+	//   description: |
+	//     Mark the cluster as air-gapped, so that the API server configs only reference the allowed hosts.
+	//     The JWT issuers of the authentication config are rejected unless they point to the allowed hosts.
+	//   examples:
+	//     - value: airGappedConfigExample()
+	AirGappedConfig *AirGappedConfig `yaml:"airGapped,omitempty"`
+	//   description: |
+	//     Configure the KMS v2 provider for the [encryption of secret data at rest](https://kubernetes.io/docs/tasks/administer-cluster/kms-provider/).
+	//     KMS has precedence over secretbox and AESCBC, the directory of the plugin socket is mounted into the API server pod.
+	//   examples:
+	//     - value: kmsEncryptionConfigExample()
+	KMSEncryptionConfig *KMSEncryptionConfig `yaml:"kmsEncryption,omitempty"`
+	//   description: |
+	//     The additional certificate authorities the API server trusts to verify the client certificates.
+	//     The cluster CAs are always trusted, the bundle is written to the API server config directory as `client-ca.crt`.
+	//   schema:
+	//     type: array
+	//     items:
+	//       type: object
+	//       additionalProperties: false
+	//       properties:
+	//         crt:
+	//           type: string
+	APIServerClientCAs []*x509.PEMEncodedCertificate `yaml:"clientCAs,omitempty"`
+	//   description: |
+	//     The additional certificate authorities the API server trusts to verify the front-proxy client certificates.
+	//     The aggregator CA is always trusted, the bundle is written to the API server config directory as `requestheader-client-ca.crt`.
+	//   schema:
+	//     type: array
+	//     items:
+	//       type: object
+	//       additionalProperties: false
+	//       properties:
+	//         crt:
+	//           type: string
+	APIServerRequestHeaderClientCAs []*x509.PEMEncodedCertificate `yaml:"requestHeaderClientCAs,omitempty"`
+	//   description: |
+	//     Configure the OpenTelemetry tracing of the API server.
+	//     The tracing config is passed to the API server with the `--tracing-config-file` flag.
+	//   examples:
+	//     - value: apiServerTracingConfigExample()
+	TracingConfig *APIServerTracingConfig `yaml:"tracing,omitempty"`
+	//   description: |
+	//     The unix socket of the external signer of the service account tokens, e.g. provided by a system extension.
+	//     The signer provides the public keys as well, so the service account key is not passed to the API server.
+	//     Requires Kubernetes 1.32 or later.
+	//   examples:
+	//     - value: '"/var/run/kube-apiserver-signer/signer.sock"'
+	APIServerServiceAccountSigningEndpoint string `yaml:"serviceAccountSigningEndpoint,omitempty"`
+	//   description: |
+	//     Configure the graceful shutdown of the API server.
+	//     The shutdown delay and the watch termination grace period should fit into the termination grace period of the pod.
+	//   examples:
+	//     - value: apiServerShutdownConfigExample()
+	ShutdownConfig *APIServerShutdownConfig `yaml:"shutdown,omitempty"`
 }
 
 // AdmissionPluginConfigList represents the admission plugin configuration list.
@@ -1205,6 +1285,12 @@ type AdmissionPluginConfig struct {
 	//   schema:
 	//     type: object
 	PluginConfiguration Unstructured `yaml:"configuration"`
+	//   description: |
+	//     ConfigurationYAML is the plugin's configuration as a YAML document.
+	//     It is written as is to a separate file referenced by the admission control configuration,
+	//     so that the comments in it are preserved.
+	//     It can't be used together with `configuration`.
+	PluginConfigurationYAML string `yaml:"configurationYAML,omitempty"`
 }
 
 // AuthorizationConfigAuthorizerConfigList represents the authorization config authorizer configuration list.
@@ -1227,6 +1313,123 @@ type AuthorizationConfigAuthorizerConfig struct {
 	AuthorizerWebhook Unstructured `yaml:"webhook,omitempty"`
 }
 
+// KonnectivityServerConfig represents the konnectivity server configuration.
+type KonnectivityServerConfig struct {
+	//   description: |
+	//     The address the konnectivity server listens on, either an absolute path to the unix socket, or an IP:port pair.
+	ServerListenAddress string `yaml:"listenAddress"`
+	//   description: |
+	//     The namespace of the konnectivity agents.
+	ServerAgentNamespace string `yaml:"agentNamespace,omitempty"`
+	//   description: |
+	//     The service account of the konnectivity agents.
+	ServerAgentServiceAccount string `yaml:"agentServiceAccount,omitempty"`
+	//   description: |
+	//     The egress selections in addition to the cluster one, which always goes through the konnectivity server.
+	//   examples:
+	//     - value: konnectivityEgressSelectionsExample()
+	ServerEgressSelections []KonnectivityEgressSelectionConfig `yaml:"egressSelections,omitempty"`
+}
+
+// KonnectivityEgressSelectionConfig represents the connection the API server uses for the egress traffic of the selection.
+type KonnectivityEgressSelectionConfig struct {
+	//   description: |
+	//     The name of the egress selection.
+	//   values:
+	//     - controlplane
+	//     - etcd
+	SelectionName string `yaml:"name"`
+	//   description: |
+	//     The proxy protocol of the connection.
+	//   values:
+	//     - Direct
+	//     - HTTPConnect
+	//     - GRPC
+	SelectionProxyProtocol string `yaml:"proxyProtocol"`
+	//   description: |
+	//     The transport of the connection, it's not set for the `Direct` proxy protocol.
+	//   values:
+	//     - tcp
+	//     - uds
+	SelectionTransport string `yaml:"transport,omitempty"`
+	//   description: |
+	//     An IP:port pair for the `tcp` transport, or an absolute path to the unix socket for the `uds` transport.
+	SelectionAddress string `yaml:"address,omitempty"`
+}
+
+// ServiceAccountIssuerDiscoveryConfig represents the cached discovery documents of the service account issuer.
+type ServiceAccountIssuerDiscoveryConfig struct {
+	//   description: |
+	//     The JSON-encoded OpenID discovery document, its issuer should match the service account issuer of the API server.
+	IssuerDiscoveryDocument string `yaml:"discoveryDocument"`
+	//   description: |
+	//     The JSON-encoded key set of the service account issuer.
+	IssuerJWKS string `yaml:"jwks"`
+}
+
+// AirGappedConfig represents the hosts reachable from the air-gapped control plane.
+type AirGappedConfig struct {
+	//   description: |
+	//     The allowed hostnames or IP addresses, an entry with a leading dot (e.g. `.corp.internal`) allows all subdomains.
+	AirGappedAllowedHosts []string `yaml:"allowedHosts"`
+}
+
+// KMSEncryptionConfig represents the KMS v2 provider of the API server.
+type KMSEncryptionConfig struct {
+	//   description: |
+	//     The name of the KMS plugin.
+	KMSName string `yaml:"name"`
+	//   description: |
+	//     The unix socket the KMS plugin listens on.
+	//   examples:
+	//     - value: '"unix:///var/run/kms/kms.sock"'
+	KMSEndpoint string `yaml:"endpoint"`
+	//   description: |
+	//     The timeout of the calls to the KMS plugin.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes), defaults to 3 seconds.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	KMSTimeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// APIServerTracingConfig represents the API server tracing configuration.
+type APIServerTracingConfig struct {
+	//   description: |
+	//     The OTLP gRPC collector the traces are reported to, as host:port.
+	//     The connection is insecure, as the API server doesn't support TLS for it, defaults to `localhost:4317`.
+	TracingEndpoint string `yaml:"endpoint,omitempty"`
+	//   description: |
+	//     The number of samples to collect per million spans.
+	//     If unset, the API server only samples the spans which parent span is sampled.
+	TracingSamplingRatePerMillion int32 `yaml:"samplingRatePerMillion,omitempty"`
+}
+
+// APIServerShutdownConfig represents the API server graceful shutdown configuration.
+type APIServerShutdownConfig struct {
+	//   description: |
+	//     The time the API server keeps serving after it's asked to shut down, so that the load balancers stop sending requests to it.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	APIServerShutdownDelay time.Duration `yaml:"shutdownDelay,omitempty"`
+	//   description: |
+	//     The time the active watch requests are given to drain after the shutdown delay.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	APIServerWatchTerminationGracePeriod time.Duration `yaml:"watchTerminationGracePeriod,omitempty"`
+	//   description: |
+	//     The time the API server pod is given to shut down before it's killed, in whole seconds.
+	//     Field format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	APIServerTerminationGracePeriod time.Duration `yaml:"terminationGracePeriod,omitempty"`
+}
+
 var _ config.ControllerManager = (*ControllerManagerConfig)(nil)
 
 // ControllerManagerConfig represents the kube controller manager configuration options.
@@ -1313,6 +1516,62 @@ type SchedulerConfig struct {
 	SchedulerConfig Unstructured `yaml:"config,omitempty"`
 }
 
+var _ config.StaticPodConfigs = (*StaticPodConfigsConfig)(nil)
+
+// StaticPodConfigsConfig represents the settings for rendering the control plane static pod configs.
+type StaticPodConfigsConfig struct {
+	//   description: |
+	//     Only render and validate the configs without writing them, e.g. when the configs are managed externally.
+	//     The result is reported via the ConfigStatus resource.
+	ConfigsValidateOnly *bool `yaml:"validateOnly,omitempty"`
+	//   description: |
+	//     The secret values referenced from the configs as `${secret:<name>/<key>}`.
+	//     The values are only substituted into the rendered configs.
+	//   examples:
+	//     - value: staticPodConfigSecretsExample()
+	ConfigsSecrets []StaticPodConfigSecret `yaml:"secrets,omitempty"`
+	//   description: |
+	//     The node-specific overrides merged into the rendered configs by the config filename.
+	//     Maps are merged recursively, and any other values are replaced.
+	//   examples:
+	//     - value: staticPodConfigOverridesExample()
+	ConfigsOverrides []StaticPodConfigOverride `yaml:"overrides,omitempty"`
+	//   description: |
+	//     Fail the render if an admission plugin is unknown to the kube-apiserver version, instead of logging a warning.
+	ConfigsStrictAdmissionPlugins *bool `yaml:"strictAdmissionPlugins,omitempty"`
+	//   description: |
+	//     Fail the render if a structured auth config can't be honored by the kube-apiserver version, instead of skipping it with a warning.
+	ConfigsStrictStructuredAuth *bool `yaml:"strictStructuredAuth,omitempty"`
+	//   description: |
+	//     The filenames the configs are rendered to, by the default config filename.
+	//     The static pod flags referencing the configs use the new filenames.
+	//   examples:
+	//     - value: staticPodConfigFilenamesExample()
+	ConfigsFilenames map[string]string `yaml:"filenames,omitempty"`
+}
+
+// StaticPodConfigSecret represents the secret values referenced from the control plane static pod configs.
+type StaticPodConfigSecret struct {
+	//   description: |
+	//     The name of the secret.
+	SecretName string `yaml:"name"`
+	//   description: |
+	//     The secret values by key.
+	SecretData map[string]string `yaml:"data"`
+}
+
+// StaticPodConfigOverride represents the node-specific override of the control plane static pod config.
+type StaticPodConfigOverride struct {
+	//   description: |
+	//     The filename of the rendered config.
+	OverrideFilename string `yaml:"filename"`
+	//   description: |
+	//     The override merged into the rendered config.
+	//   schema:
+	//     type: object
+	OverrideConfig Unstructured `yaml:"config" merge:"replace"`
+}
+
 var _ config.Etcd = (*EtcdConfig)(nil)
 
 // EtcdConfig represents the etcd configuration options.
@@ -471,6 +471,13 @@ func (ClusterConfig) Doc() *encoder.Doc {
 				Description: "Scheduler server specific configuration options.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Scheduler server specific configuration options." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "staticPodConfigs",
+				Type:        "StaticPodConfigsConfig",
+				Note:        "",
+				Description: "Settings for rendering the configs of the control plane static pods.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Settings for rendering the configs of the control plane static pods." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 			{
 				Name:        "discovery",
 				Type:        "ClusterDiscoveryConfig",
@@ -558,21 +565,22 @@ func (ClusterConfig) Doc() *encoder.Doc {
 	doc.Fields[13].AddExample("", clusterControllerManagerExample())
 	doc.Fields[14].AddExample("", clusterProxyExample())
 	doc.Fields[15].AddExample("", clusterSchedulerExample())
-	doc.Fields[16].AddExample("", clusterDiscoveryExample())
-	doc.Fields[17].AddExample("", clusterEtcdExample())
-	doc.Fields[18].AddExample("", clusterCoreDNSExample())
-	doc.Fields[19].AddExample("", clusterExternalCloudProviderConfigExample())
-	doc.Fields[20].AddExample("", []string{
+	doc.Fields[16].AddExample("", clusterStaticPodConfigsExample())
+	doc.Fields[17].AddExample("", clusterDiscoveryExample())
+	doc.Fields[18].AddExample("", clusterEtcdExample())
+	doc.Fields[19].AddExample("", clusterCoreDNSExample())
+	doc.Fields[20].AddExample("", clusterExternalCloudProviderConfigExample())
+	doc.Fields[21].AddExample("", []string{
 		"https://www.example.com/manifest1.yaml",
 		"https://www.example.com/manifest2.yaml",
 	})
-	doc.Fields[21].AddExample("", map[string]string{
+	doc.Fields[22].AddExample("", map[string]string{
 		"Token":       "1234567",
 		"X-ExtraInfo": "info",
 	})
-	doc.Fields[22].AddExample("", clusterInlineManifestsExample())
-	doc.Fields[23].AddExample("", clusterAdminKubeconfigExample())
-	doc.Fields[25].AddExample("", true)
+	doc.Fields[23].AddExample("", clusterInlineManifestsExample())
+	doc.Fields[24].AddExample("", clusterAdminKubeconfigExample())
+	doc.Fields[26].AddExample("", true)
 
 	return doc
 }
@@ -1438,6 +1446,76 @@ func (APIServerConfig) Doc() *encoder.Doc {
 				Description: "Configure the API server authorization config. Node and RBAC authorizers are always added irrespective of the configuration.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Configure the API server authorization config. Node and RBAC authorizers are always added irrespective of the configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "authenticationConfig",
+				Type:        "Unstructured",
+				Note:        "",
+				Description: "Configure the API server structured authentication config.\nThe config is passed to the API server with the `--authentication-config` flag, and requires Kubernetes 1.30 or later.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configure the API server structured authentication config." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "konnectivityServer",
+				Type:        "KonnectivityServerConfig",
+				Note:        "",
+				Description: "Configure the konnectivity server the API server sends the cluster egress traffic through.\nThe egress selector config is passed to the API server with the `--egress-selector-config-file` flag.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configure the konnectivity server the API server sends the cluster egress traffic through." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "serviceAccountIssuerDiscovery",
+				Type:        "ServiceAccountIssuerDiscoveryConfig",
+				Note:        "",
+				Description: "The cached OpenID discovery document and JWKS of the service account issuer.\nThey are written to the API server config directory as `openid-configuration.json` and `jwks.json`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The cached OpenID discovery document and JWKS of the service account issuer." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "airGapped",
+				Type:        "AirGappedConfig",
+				Note:        "",
+				Description: "Mark the cluster as air-gapped, so that the API server configs only reference the allowed hosts.\nThe JWT issuers of the authentication config are rejected unless they point to the allowed hosts.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mark the cluster as air-gapped, so that the API server configs only reference the allowed hosts." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "kmsEncryption",
+				Type:        "KMSEncryptionConfig",
+				Note:        "",
+				Description: "Configure the KMS v2 provider for the [encryption of secret data at rest](https://kubernetes.io/docs/tasks/administer-cluster/kms-provider/).\nKMS has precedence over secretbox and AESCBC, the directory of the plugin socket is mounted into the API server pod.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configure the KMS v2 provider for the [encryption of secret data at rest](https://kubernetes.io/docs/tasks/administer-cluster/kms-provider/)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "clientCAs",
+				Type:        "[]PEMEncodedCertificate",
+				Note:        "",
+				Description: "The additional certificate authorities the API server trusts to verify the client certificates.\nThe cluster CAs are always trusted, the bundle is written to the API server config directory as `client-ca.crt`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The additional certificate authorities the API server trusts to verify the client certificates." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "requestHeaderClientCAs",
+				Type:        "[]PEMEncodedCertificate",
+				Note:        "",
+				Description: "The additional certificate authorities the API server trusts to verify the front-proxy client certificates.\nThe aggregator CA is always trusted, the bundle is written to the API server config directory as `requestheader-client-ca.crt`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The additional certificate authorities the API server trusts to verify the front-proxy client certificates." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "tracing",
+				Type:        "APIServerTracingConfig",
+				Note:        "",
+				Description: "Configure the OpenTelemetry tracing of the API server.\nThe tracing config is passed to the API server with the `--tracing-config-file` flag.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configure the OpenTelemetry tracing of the API server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "serviceAccountSigningEndpoint",
+				Type:        "string",
+				Note:        "",
+				Description: "The unix socket of the external signer of the service account tokens, e.g. provided by a system extension.\nThe signer provides the public keys as well, so the service account key is not passed to the API server.\nRequires Kubernetes 1.32 or later.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The unix socket of the external signer of the service account tokens, e.g. provided by a system extension." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "shutdown",
+				Type:        "APIServerShutdownConfig",
+				Note:        "",
+				Description: "Configure the graceful shutdown of the API server.\nThe shutdown delay and the watch termination grace period should fit into the termination grace period of the pod.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configure the graceful shutdown of the API server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -1447,6 +1525,14 @@ func (APIServerConfig) Doc() *encoder.Doc {
 	doc.Fields[6].AddExample("", admissionControlConfigExample())
 	doc.Fields[7].AddExample("", APIServerDefaultAuditPolicy)
 	doc.Fields[9].AddExample("", authorizationConfigExample())
+	doc.Fields[10].AddExample("", authenticationConfigExample())
+	doc.Fields[11].AddExample("", konnectivityServerConfigExample())
+	doc.Fields[12].AddExample("", serviceAccountIssuerDiscoveryConfigExample())
+	doc.Fields[13].AddExample("", airGappedConfigExample())
+	doc.Fields[14].AddExample("", kmsEncryptionConfigExample())
+	doc.Fields[17].AddExample("", apiServerTracingConfigExample())
+	doc.Fields[18].AddExample("", "/var/run/kube-apiserver-signer/signer.sock")
+	doc.Fields[19].AddExample("", apiServerShutdownConfigExample())
 
 	return doc
 }
@@ -1477,6 +1563,13 @@ func (AdmissionPluginConfig) Doc() *encoder.Doc {
 				Description: "Configuration is an embedded configuration object to be used as the plugin's\nconfiguration.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Configuration is an embedded configuration object to be used as the plugin's" /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "configurationYAML",
+				Type:        "string",
+				Note:        "",
+				Description: "ConfigurationYAML is the plugin's configuration as a YAML document.\nIt is written as is to a separate file referenced by the admission control configuration,\nso that the comments in it are preserved.\nIt can't be used together with `configuration`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "ConfigurationYAML is the plugin's configuration as a YAML document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -1526,6 +1619,296 @@ func (AuthorizationConfigAuthorizerConfig) Doc() *encoder.Doc {
 	return doc
 }
 
+func (KonnectivityServerConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "KonnectivityServerConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "KonnectivityServerConfig represents the konnectivity server configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "KonnectivityServerConfig represents the konnectivity server configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "APIServerConfig",
+				FieldName: "konnectivityServer",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "listenAddress",
+				Type:        "string",
+				Note:        "",
+				Description: "The address the konnectivity server listens on, either an absolute path to the unix socket, or an IP:port pair.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The address the konnectivity server listens on, either an absolute path to the unix socket, or an IP:port pair." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "agentNamespace",
+				Type:        "string",
+				Note:        "",
+				Description: "The namespace of the konnectivity agents.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The namespace of the konnectivity agents." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "agentServiceAccount",
+				Type:        "string",
+				Note:        "",
+				Description: "The service account of the konnectivity agents.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The service account of the konnectivity agents." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "egressSelections",
+				Type:        "[]KonnectivityEgressSelectionConfig",
+				Note:        "",
+				Description: "The egress selections in addition to the cluster one, which always goes through the konnectivity server.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The egress selections in addition to the cluster one, which always goes through the konnectivity server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", konnectivityServerConfigExample())
+
+	doc.Fields[3].AddExample("", konnectivityEgressSelectionsExample())
+
+	return doc
+}
+
+func (KonnectivityEgressSelectionConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "KonnectivityEgressSelectionConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "KonnectivityEgressSelectionConfig represents the connection the API server uses for the egress traffic of the selection." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "KonnectivityEgressSelectionConfig represents the connection the API server uses for the egress traffic of the selection.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "KonnectivityServerConfig",
+				FieldName: "egressSelections",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "The name of the egress selection.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The name of the egress selection." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"controlplane",
+					"etcd",
+				},
+			},
+			{
+				Name:        "proxyProtocol",
+				Type:        "string",
+				Note:        "",
+				Description: "The proxy protocol of the connection.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The proxy protocol of the connection." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"Direct",
+					"HTTPConnect",
+					"GRPC",
+				},
+			},
+			{
+				Name:        "transport",
+				Type:        "string",
+				Note:        "",
+				Description: "The transport of the connection, it's not set for the `Direct` proxy protocol.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The transport of the connection, it's not set for the `Direct` proxy protocol." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"tcp",
+					"uds",
+				},
+			},
+			{
+				Name:        "address",
+				Type:        "string",
+				Note:        "",
+				Description: "An IP:port pair for the `tcp` transport, or an absolute path to the unix socket for the `uds` transport.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "An IP:port pair for the `tcp` transport, or an absolute path to the unix socket for the `uds` transport." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", konnectivityEgressSelectionsExample())
+
+	return doc
+}
+
+func (ServiceAccountIssuerDiscoveryConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ServiceAccountIssuerDiscoveryConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ServiceAccountIssuerDiscoveryConfig represents the cached discovery documents of the service account issuer." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ServiceAccountIssuerDiscoveryConfig represents the cached discovery documents of the service account issuer.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "APIServerConfig",
+				FieldName: "serviceAccountIssuerDiscovery",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "discoveryDocument",
+				Type:        "string",
+				Note:        "",
+				Description: "The JSON-encoded OpenID discovery document, its issuer should match the service account issuer of the API server.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The JSON-encoded OpenID discovery document, its issuer should match the service account issuer of the API server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "jwks",
+				Type:        "string",
+				Note:        "",
+				Description: "The JSON-encoded key set of the service account issuer.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The JSON-encoded key set of the service account issuer." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", serviceAccountIssuerDiscoveryConfigExample())
+
+	return doc
+}
+
+func (AirGappedConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "AirGappedConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "AirGappedConfig represents the hosts reachable from the air-gapped control plane." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "AirGappedConfig represents the hosts reachable from the air-gapped control plane.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "APIServerConfig",
+				FieldName: "airGapped",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "allowedHosts",
+				Type:        "[]string",
+				Note:        "",
+				Description: "The allowed hostnames or IP addresses, an entry with a leading dot (e.g. `.corp.internal`) allows all subdomains.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The allowed hostnames or IP addresses, an entry with a leading dot (e.g. `.corp.internal`) allows all subdomains." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", airGappedConfigExample())
+
+	return doc
+}
+
+func (KMSEncryptionConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "KMSEncryptionConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "KMSEncryptionConfig represents the KMS v2 provider of the API server." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "KMSEncryptionConfig represents the KMS v2 provider of the API server.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "APIServerConfig",
+				FieldName: "kmsEncryption",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "The name of the KMS plugin.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The name of the KMS plugin." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "endpoint",
+				Type:        "string",
+				Note:        "",
+				Description: "The unix socket the KMS plugin listens on.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The unix socket the KMS plugin listens on." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "timeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "The timeout of the calls to the KMS plugin.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes), defaults to 3 seconds.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The timeout of the calls to the KMS plugin." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", kmsEncryptionConfigExample())
+
+	doc.Fields[1].AddExample("", "unix:///var/run/kms/kms.sock")
+
+	return doc
+}
+
+func (APIServerTracingConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "APIServerTracingConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "APIServerTracingConfig represents the API server tracing configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "APIServerTracingConfig represents the API server tracing configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "APIServerConfig",
+				FieldName: "tracing",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "endpoint",
+				Type:        "string",
+				Note:        "",
+				Description: "The OTLP gRPC collector the traces are reported to, as host:port.\nThe connection is insecure, as the API server doesn't support TLS for it, defaults to `localhost:4317`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The OTLP gRPC collector the traces are reported to, as host:port." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "samplingRatePerMillion",
+				Type:        "int32",
+				Note:        "",
+				Description: "The number of samples to collect per million spans.\nIf unset, the API server only samples the spans which parent span is sampled.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The number of samples to collect per million spans." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", apiServerTracingConfigExample())
+
+	return doc
+}
+
+func (APIServerShutdownConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "APIServerShutdownConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "APIServerShutdownConfig represents the API server graceful shutdown configuration." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "APIServerShutdownConfig represents the API server graceful shutdown configuration.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "APIServerConfig",
+				FieldName: "shutdown",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "shutdownDelay",
+				Type:        "Duration",
+				Note:        "",
+				Description: "The time the API server keeps serving after it's asked to shut down, so that the load balancers stop sending requests to it.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The time the API server keeps serving after it's asked to shut down, so that the load balancers stop sending requests to it." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "watchTerminationGracePeriod",
+				Type:        "Duration",
+				Note:        "",
+				Description: "The time the active watch requests are given to drain after the shutdown delay.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The time the active watch requests are given to drain after the shutdown delay." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "terminationGracePeriod",
+				Type:        "Duration",
+				Note:        "",
+				Description: "The time the API server pod is given to shut down before it's killed, in whole seconds.\nField format accepts any Go time.Duration format ('1h' for one hour, '10m' for ten minutes).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The time the API server pod is given to shut down before it's killed, in whole seconds." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", apiServerShutdownConfigExample())
+
+	return doc
+}
+
 func (ControllerManagerConfig) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "ControllerManagerConfig",
@@ -1698,6 +2081,140 @@ func (SchedulerConfig) Doc() *encoder.Doc {
 	return doc
 }
 
+func (StaticPodConfigsConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "StaticPodConfigsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "StaticPodConfigsConfig represents the settings for rendering the control plane static pod configs." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "StaticPodConfigsConfig represents the settings for rendering the control plane static pod configs.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ClusterConfig",
+				FieldName: "staticPodConfigs",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "validateOnly",
+				Type:        "bool",
+				Note:        "",
+				Description: "Only render and validate the configs without writing them, e.g. when the configs are managed externally.\nThe result is reported via the ConfigStatus resource.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Only render and validate the configs without writing them, e.g. when the configs are managed externally." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "secrets",
+				Type:        "[]StaticPodConfigSecret",
+				Note:        "",
+				Description: "The secret values referenced from the configs as `${secret:<name>/<key>}`.\nThe values are only substituted into the rendered configs.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The secret values referenced from the configs as `${secret:<name>/<key>}`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "overrides",
+				Type:        "[]StaticPodConfigOverride",
+				Note:        "",
+				Description: "The node-specific overrides merged into the rendered configs by the config filename.\nMaps are merged recursively, and any other values are replaced.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The node-specific overrides merged into the rendered configs by the config filename." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "strictAdmissionPlugins",
+				Type:        "bool",
+				Note:        "",
+				Description: "Fail the render if an admission plugin is unknown to the kube-apiserver version, instead of logging a warning.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Fail the render if an admission plugin is unknown to the kube-apiserver version, instead of logging a warning." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "strictStructuredAuth",
+				Type:        "bool",
+				Note:        "",
+				Description: "Fail the render if a structured auth config can't be honored by the kube-apiserver version, instead of skipping it with a warning.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Fail the render if a structured auth config can't be honored by the kube-apiserver version, instead of skipping it with a warning." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "filenames",
+				Type:        "map[string]string",
+				Note:        "",
+				Description: "The filenames the configs are rendered to, by the default config filename.\nThe static pod flags referencing the configs use the new filenames.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The filenames the configs are rendered to, by the default config filename." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", clusterStaticPodConfigsExample())
+
+	doc.Fields[1].AddExample("", staticPodConfigSecretsExample())
+	doc.Fields[2].AddExample("", staticPodConfigOverridesExample())
+	doc.Fields[5].AddExample("", staticPodConfigFilenamesExample())
+
+	return doc
+}
+
+func (StaticPodConfigSecret) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "StaticPodConfigSecret",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "StaticPodConfigSecret represents the secret values referenced from the control plane static pod configs." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "StaticPodConfigSecret represents the secret values referenced from the control plane static pod configs.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "StaticPodConfigsConfig",
+				FieldName: "secrets",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "The name of the secret.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The name of the secret." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "data",
+				Type:        "map[string]string",
+				Note:        "",
+				Description: "The secret values by key.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The secret values by key." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", staticPodConfigSecretsExample())
+
+	return doc
+}
+
+func (StaticPodConfigOverride) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "StaticPodConfigOverride",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "StaticPodConfigOverride represents the node-specific override of the control plane static pod config." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "StaticPodConfigOverride represents the node-specific override of the control plane static pod config.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "StaticPodConfigsConfig",
+				FieldName: "overrides",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "filename",
+				Type:        "string",
+				Note:        "",
+				Description: "The filename of the rendered config.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The filename of the rendered config." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "config",
+				Type:        "Unstructured",
+				Note:        "",
+				Description: "The override merged into the rendered config.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The override merged into the rendered config." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", staticPodConfigOverridesExample())
+
+	return doc
+}
+
 func (EtcdConfig) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "EtcdConfig",
@@ -4215,9 +4732,19 @@ func GetFileDoc() *encoder.FileDoc {
 			APIServerConfig{}.Doc(),
 			AdmissionPluginConfig{}.Doc(),
 			AuthorizationConfigAuthorizerConfig{}.Doc(),
+			KonnectivityServerConfig{}.Doc(),
+			KonnectivityEgressSelectionConfig{}.Doc(),
+			ServiceAccountIssuerDiscoveryConfig{}.Doc(),
+			AirGappedConfig{}.Doc(),
+			KMSEncryptionConfig{}.Doc(),
+			APIServerTracingConfig{}.Doc(),
+			APIServerShutdownConfig{}.Doc(),
 			ControllerManagerConfig{}.Doc(),
 			ProxyConfig{}.Doc(),
 			SchedulerConfig{}.Doc(),
+			StaticPodConfigsConfig{}.Doc(),
+			StaticPodConfigSecret{}.Doc(),
+			StaticPodConfigOverride{}.Doc(),
 			EtcdConfig{}.Doc(),
 			ClusterNetworkConfig{}.Doc(),
 			CNIConfig{}.Doc(),
@@ -433,6 +433,7 @@ func (c *ClusterConfig) Validate(isControlPlane bool) error {
 		c.APIServerConfig.Validate(),
 		c.ControllerManagerConfig.Validate(),
 		c.SchedulerConfig.Validate(),
+		c.StaticPodConfigsConfig.Validate(),
 	)
 
 	return result.ErrorOrNil()
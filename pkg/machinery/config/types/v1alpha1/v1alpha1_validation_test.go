@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/go-pointer"
@@ -1874,6 +1875,507 @@ func TestValidate(t *testing.T) {
 			},
 			expectedError: "1 error occurred:\n\t* authorization-webhook-* flags cannot be used in conjunction with AuthorizationConfig, use either AuthorizationConfig or authorization-webhook-* flags\n\n",
 		},
+		{
+			name: "ControlPlaneAuthenticationConfigWithOIDCFlags",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						AuthenticationConfigConfig: v1alpha1.Unstructured{
+							Object: map[string]any{
+								"apiVersion": "apiserver.config.k8s.io/v1beta1",
+								"kind":       "AuthenticationConfiguration",
+							},
+						},
+						ExtraArgsConfig: map[string]string{
+							"oidc-issuer-url": "https://issuer.example.com",
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* oidc-* flags cannot be used in conjunction with AuthenticationConfig, use either AuthenticationConfig or oidc-* flags\n\n",
+		},
+		{
+			name: "ControlPlaneKonnectivityServerNoListenAddress",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						KonnectivityServerConfig: &v1alpha1.KonnectivityServerConfig{
+							ServerAgentNamespace: "kube-system",
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* apiserver konnectivity server config validation failed: konnectivity server listen address must be set\n\n",
+		},
+		{
+			name: "ControlPlaneStaticPodConfigSecretDuplicate",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					StaticPodConfigsConfig: &v1alpha1.StaticPodConfigsConfig{
+						ConfigsSecrets: []v1alpha1.StaticPodConfigSecret{
+							{
+								SecretName: "oidc",
+							},
+							{
+								SecretName: "oidc",
+							},
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* duplicate static pod config secret \"oidc\"\n\n",
+		},
+		{
+			name: "ControlPlaneStaticPodConfigSecretInvalidName",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					StaticPodConfigsConfig: &v1alpha1.StaticPodConfigsConfig{
+						ConfigsSecrets: []v1alpha1.StaticPodConfigSecret{
+							{
+								SecretName: "oidc/client",
+							},
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* invalid static pod config secret name \"oidc/client\"\n\n",
+		},
+		{
+			name: "ControlPlaneStaticPodConfigOverrideDuplicate",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					StaticPodConfigsConfig: &v1alpha1.StaticPodConfigsConfig{
+						ConfigsOverrides: []v1alpha1.StaticPodConfigOverride{
+							{
+								OverrideFilename: "scheduler-config.yaml",
+							},
+							{
+								OverrideFilename: "scheduler-config.yaml",
+							},
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* duplicate static pod config override for \"scheduler-config.yaml\"\n\n",
+		},
+		{
+			name: "ControlPlaneServiceAccountIssuerDiscoveryInvalidJWKS",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						ServiceAccountIssuerDiscoveryConfig: &v1alpha1.ServiceAccountIssuerDiscoveryConfig{
+							IssuerDiscoveryDocument: `{"issuer":"https://localhost:6443","jwks_uri":"https://localhost:6443/openid/v1/jwks"}`,
+							IssuerJWKS:              `{"keys":`,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* apiserver service account issuer discovery config validation failed: JWKS should be a valid JSON document\n\n",
+		},
+		{
+			name: "ControlPlaneAirGappedAllowedHostURL",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						AirGappedConfig: &v1alpha1.AirGappedConfig{
+							AirGappedAllowedHosts: []string{"fd00::1", ".corp.internal", "https://dex.corp.internal:5556"},
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* apiserver air-gapped config validation failed: allowed host \"https://dex.corp.internal:5556\" should be a hostname or an IP address\n\n",
+		},
+		{
+			name: "ControlPlaneKMSEncryptionEndpointTCP",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						KMSEncryptionConfig: &v1alpha1.KMSEncryptionConfig{
+							KMSName:     "vault",
+							KMSEndpoint: "tcp://127.0.0.1:8200",
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* apiserver KMS encryption config validation failed: KMS plugin endpoint \"tcp://127.0.0.1:8200\" should be a unix socket in a directory (unix:///path/to/socket)\n\n",
+		},
+		{
+			name: "ControlPlaneStaticPodConfigFilenamePath",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					StaticPodConfigsConfig: &v1alpha1.StaticPodConfigsConfig{
+						ConfigsFilenames: map[string]string{
+							"auditpolicy.yaml": "../auditpolicy.yaml",
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* invalid static pod config filename \"../auditpolicy.yaml\" for \"auditpolicy.yaml\"\n\n",
+		},
+		{
+			name: "ControlPlaneTracingEndpointHTTPS",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						TracingConfig: &v1alpha1.APIServerTracingConfig{
+							TracingEndpoint: "https://otel.corp.internal:4317",
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* apiserver tracing config validation failed: tracing endpoint \"https://otel.corp.internal:4317\" should be host:port, the API server doesn't support TLS for the tracing connection\n\n",
+		},
+		{
+			name: "ControlPlaneTracingSamplingRate",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						TracingConfig: &v1alpha1.APIServerTracingConfig{
+							TracingSamplingRatePerMillion: 2_000_000,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* apiserver tracing config validation failed: tracing sampling rate per million should be between 0 and 1000000, got 2000000\n\n",
+		},
+		{
+			name: "ControlPlaneServiceAccountSigningEndpointRelative",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						APIServerServiceAccountSigningEndpoint: "signer.sock",
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* service account signing endpoint \"signer.sock\" should be an absolute unix socket path\n\n",
+		},
+		{
+			name: "ControlPlaneServiceAccountSigningEndpointExtraArg",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						ExtraArgsConfig: map[string]string{
+							"service-account-signing-endpoint": "/var/run/signer.sock",
+						},
+						APIServerServiceAccountSigningEndpoint: "/var/run/kube-apiserver-signer/signer.sock",
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* service-account-signing-endpoint flag cannot be used in conjunction with serviceAccountSigningEndpoint\n\n",
+		},
+		{
+			name: "ControlPlaneAPIServerShutdownNegative",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						ShutdownConfig: &v1alpha1.APIServerShutdownConfig{
+							APIServerShutdownDelay: -time.Second,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* apiserver shutdown config validation failed: shutdown delay should not be negative, got -1s\n\n",
+		},
+		{
+			name: "ControlPlaneAPIServerShutdownFractionalTerminationGracePeriod",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						ShutdownConfig: &v1alpha1.APIServerShutdownConfig{
+							APIServerTerminationGracePeriod: 1500 * time.Millisecond,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* apiserver shutdown config validation failed: termination grace period should be whole seconds, got 1.5s\n\n",
+		},
+		{
+			name: "ControlPlaneAPIServerShutdownExtraArg",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						ExtraArgsConfig: map[string]string{
+							"shutdown-delay-duration": "10s",
+						},
+						ShutdownConfig: &v1alpha1.APIServerShutdownConfig{
+							APIServerShutdownDelay: 5 * time.Second,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* shutdown-delay-duration flag cannot be used in conjunction with the shutdown config\n\n",
+		},
+		{
+			name: "ControlPlaneAdmissionPluginConfigurationAndYAML",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						AdmissionControlConfig: v1alpha1.AdmissionPluginConfigList{
+							{
+								PluginName: "PodSecurity",
+								PluginConfiguration: v1alpha1.Unstructured{
+									Object: map[string]any{
+										"kind": "PodSecurityConfiguration",
+									},
+								},
+								PluginConfigurationYAML: "kind: PodSecurityConfiguration\n",
+							},
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* apiserver admission plugin \"PodSecurity\" config validation failed: configuration and configurationYAML can't be used together\n\n",
+		},
+		{
+			name: "ControlPlaneAdmissionPluginInvalidYAML",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					APIServerConfig: &v1alpha1.APIServerConfig{
+						AdmissionControlConfig: v1alpha1.AdmissionPluginConfigList{
+							{
+								PluginName:              "PodSecurity",
+								PluginConfigurationYAML: "# not a map\n- foo\n",
+							},
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* apiserver admission plugin \"PodSecurity\" config validation failed: error decoding configurationYAML: yaml: unmarshal errors:\n  line 2: cannot unmarshal !!seq into map[string]interface {}\n\n",
+		},
 		{
 			name: "MachineBaseRuntimeSpecOverrides",
 			config: &v1alpha1.Config{
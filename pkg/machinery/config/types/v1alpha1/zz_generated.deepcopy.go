@@ -62,6 +62,57 @@ func (in *APIServerConfig) DeepCopyInto(out *APIServerConfig) {
 		*out = new(ResourcesConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	in.AuthenticationConfigConfig.DeepCopyInto(&out.AuthenticationConfigConfig)
+	if in.KonnectivityServerConfig != nil {
+		in, out := &in.KonnectivityServerConfig, &out.KonnectivityServerConfig
+		*out = new(KonnectivityServerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountIssuerDiscoveryConfig != nil {
+		in, out := &in.ServiceAccountIssuerDiscoveryConfig, &out.ServiceAccountIssuerDiscoveryConfig
+		*out = new(ServiceAccountIssuerDiscoveryConfig)
+		**out = **in
+	}
+	if in.AirGappedConfig != nil {
+		in, out := &in.AirGappedConfig, &out.AirGappedConfig
+		*out = new(AirGappedConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KMSEncryptionConfig != nil {
+		in, out := &in.KMSEncryptionConfig, &out.KMSEncryptionConfig
+		*out = new(KMSEncryptionConfig)
+		**out = **in
+	}
+	if in.APIServerClientCAs != nil {
+		in, out := &in.APIServerClientCAs, &out.APIServerClientCAs
+		*out = make([]*x509.PEMEncodedCertificate, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = (*in).DeepCopy()
+			}
+		}
+	}
+	if in.APIServerRequestHeaderClientCAs != nil {
+		in, out := &in.APIServerRequestHeaderClientCAs, &out.APIServerRequestHeaderClientCAs
+		*out = make([]*x509.PEMEncodedCertificate, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = (*in).DeepCopy()
+			}
+		}
+	}
+	if in.TracingConfig != nil {
+		in, out := &in.TracingConfig, &out.TracingConfig
+		*out = new(APIServerTracingConfig)
+		**out = **in
+	}
+	if in.ShutdownConfig != nil {
+		in, out := &in.ShutdownConfig, &out.ShutdownConfig
+		*out = new(APIServerShutdownConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -75,6 +126,38 @@ func (in *APIServerConfig) DeepCopy() *APIServerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerShutdownConfig) DeepCopyInto(out *APIServerShutdownConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerShutdownConfig.
+func (in *APIServerShutdownConfig) DeepCopy() *APIServerShutdownConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerShutdownConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerTracingConfig) DeepCopyInto(out *APIServerTracingConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerTracingConfig.
+func (in *APIServerTracingConfig) DeepCopy() *APIServerTracingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerTracingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdminKubeconfigConfig) DeepCopyInto(out *AdminKubeconfigConfig) {
 	*out = *in
@@ -134,6 +217,27 @@ func (in AdmissionPluginConfigList) DeepCopy() AdmissionPluginConfigList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AirGappedConfig) DeepCopyInto(out *AirGappedConfig) {
+	*out = *in
+	if in.AirGappedAllowedHosts != nil {
+		in, out := &in.AirGappedAllowedHosts, &out.AirGappedAllowedHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AirGappedConfig.
+func (in *AirGappedConfig) DeepCopy() *AirGappedConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AirGappedConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Base64Bytes) DeepCopyInto(out *Base64Bytes) {
 	{
@@ -299,6 +403,11 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(SchedulerConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StaticPodConfigsConfig != nil {
+		in, out := &in.StaticPodConfigsConfig, &out.StaticPodConfigsConfig
+		*out = new(StaticPodConfigsConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ClusterDiscoveryConfig != nil {
 		in, out := &in.ClusterDiscoveryConfig, &out.ClusterDiscoveryConfig
 		*out = new(ClusterDiscoveryConfig)
@@ -1284,6 +1393,22 @@ func (in *InstallExtensionConfig) DeepCopy() *InstallExtensionConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSEncryptionConfig) DeepCopyInto(out *KMSEncryptionConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSEncryptionConfig.
+func (in *KMSEncryptionConfig) DeepCopy() *KMSEncryptionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSEncryptionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KernelConfig) DeepCopyInto(out *KernelConfig) {
 	*out = *in
@@ -1332,6 +1457,43 @@ func (in *KernelModuleConfig) DeepCopy() *KernelModuleConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectivityEgressSelectionConfig) DeepCopyInto(out *KonnectivityEgressSelectionConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectivityEgressSelectionConfig.
+func (in *KonnectivityEgressSelectionConfig) DeepCopy() *KonnectivityEgressSelectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectivityEgressSelectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectivityServerConfig) DeepCopyInto(out *KonnectivityServerConfig) {
+	*out = *in
+	if in.ServerEgressSelections != nil {
+		in, out := &in.ServerEgressSelections, &out.ServerEgressSelections
+		*out = make([]KonnectivityEgressSelectionConfig, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectivityServerConfig.
+func (in *KonnectivityServerConfig) DeepCopy() *KonnectivityServerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectivityServerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubePrism) DeepCopyInto(out *KubePrism) {
 	*out = *in
@@ -2315,6 +2477,114 @@ func (in *SchedulerConfig) DeepCopy() *SchedulerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountIssuerDiscoveryConfig) DeepCopyInto(out *ServiceAccountIssuerDiscoveryConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountIssuerDiscoveryConfig.
+func (in *ServiceAccountIssuerDiscoveryConfig) DeepCopy() *ServiceAccountIssuerDiscoveryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountIssuerDiscoveryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticPodConfigOverride) DeepCopyInto(out *StaticPodConfigOverride) {
+	*out = *in
+	in.OverrideConfig.DeepCopyInto(&out.OverrideConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticPodConfigOverride.
+func (in *StaticPodConfigOverride) DeepCopy() *StaticPodConfigOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticPodConfigOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticPodConfigSecret) DeepCopyInto(out *StaticPodConfigSecret) {
+	*out = *in
+	if in.SecretData != nil {
+		in, out := &in.SecretData, &out.SecretData
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticPodConfigSecret.
+func (in *StaticPodConfigSecret) DeepCopy() *StaticPodConfigSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticPodConfigSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticPodConfigsConfig) DeepCopyInto(out *StaticPodConfigsConfig) {
+	*out = *in
+	if in.ConfigsValidateOnly != nil {
+		in, out := &in.ConfigsValidateOnly, &out.ConfigsValidateOnly
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConfigsSecrets != nil {
+		in, out := &in.ConfigsSecrets, &out.ConfigsSecrets
+		*out = make([]StaticPodConfigSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConfigsOverrides != nil {
+		in, out := &in.ConfigsOverrides, &out.ConfigsOverrides
+		*out = make([]StaticPodConfigOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConfigsStrictAdmissionPlugins != nil {
+		in, out := &in.ConfigsStrictAdmissionPlugins, &out.ConfigsStrictAdmissionPlugins
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConfigsStrictStructuredAuth != nil {
+		in, out := &in.ConfigsStrictStructuredAuth, &out.ConfigsStrictStructuredAuth
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConfigsFilenames != nil {
+		in, out := &in.ConfigsFilenames, &out.ConfigsFilenames
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticPodConfigsConfig.
+func (in *StaticPodConfigsConfig) DeepCopy() *StaticPodConfigsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticPodConfigsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemDiskEncryptionConfig) DeepCopyInto(out *SystemDiskEncryptionConfig) {
 	*out = *in
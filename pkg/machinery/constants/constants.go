@@ -318,6 +318,12 @@ const (
 	// KubernetesAPIServerConfigDirSELinuxLabel defines SELinux label for the directory with kube-apiserver configs.
 	KubernetesAPIServerConfigDirSELinuxLabel = "system_u:object_r:kube_apiserver_config_t:s0"
 
+	// KubernetesAPIServerSecretConfigDir defines directory with kube-apiserver configs which might contain secrets.
+	KubernetesAPIServerSecretConfigDir = KubebernetesStaticSecretsDir + "/" + "kube-apiserver-config"
+
+	// KubernetesSchedulerSecretConfigDir defines directory with kube-scheduler configs which might contain secrets.
+	KubernetesSchedulerSecretConfigDir = KubebernetesStaticSecretsDir + "/" + "kube-scheduler-config"
+
 	// KubernetesControllerManagerSecretsDir defines ephemeral directory with kube-controller-manager secrets.
 	KubernetesControllerManagerSecretsDir = KubebernetesStaticSecretsDir + "/" + "kube-controller-manager"
 
@@ -336,6 +342,17 @@ const (
 	// KubernetesSchedulerConfigDirSELinuxLabel defines SELinux label for the ephemeral directory with kube-scheduler configs.
 	KubernetesSchedulerConfigDirSELinuxLabel = "system_u:object_r:kube_scheduler_config_t:s0"
 
+	// KubernetesStaticConfigStagingDir defines ephemeral directory the controlplane component configs are staged in before they are swapped in.
+	//
+	// It's on the same tmpfs as both the config and the secret config directories, so that the swap is an atomic rename.
+	KubernetesStaticConfigStagingDir = KubebernetesStaticConfigDir + "/" + "staging"
+
+	// KubernetesStaticConfigGenerationsDir defines ephemeral directory the latest generations of the controlplane component configs are retained in.
+	KubernetesStaticConfigGenerationsDir = KubebernetesStaticConfigDir + "/" + "generations"
+
+	// KubernetesKMSEncryptionDefaultTimeout defines the default timeout of the calls to the KMS plugin.
+	KubernetesKMSEncryptionDefaultTimeout = 3 * time.Second
+
 	// KubernetesAPIServerRunUser defines UID to the API Server.
 	KubernetesAPIServerRunUser = 65534
 
@@ -36,6 +36,8 @@ type AdmissionControlConfigSpec struct {
 type AdmissionPluginSpec struct {
 	Name          string         `yaml:"name" protobuf:"1"`
 	Configuration map[string]any `yaml:"configuration" protobuf:"2"`
+	// RawConfiguration is the original YAML document of the configuration with the comments, it's set instead of Configuration.
+	RawConfiguration string `yaml:"rawConfiguration,omitempty" protobuf:"3"`
 }
 
 // NewAdmissionControlConfig returns new AdmissionControlConfig resource.
@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// AirGappedConfigType is type of AirGappedConfig resource.
+const AirGappedConfigType = resource.Type("AirGappedConfigs.kubernetes.talos.dev")
+
+// AirGappedConfigID is a singleton resource ID for AirGappedConfig.
+const AirGappedConfigID = resource.ID("air-gapped")
+
+// AirGappedConfig marks the cluster as air-gapped, so that the control plane should only reach the allowed hosts.
+type AirGappedConfig = typed.Resource[AirGappedConfigSpec, AirGappedConfigExtension]
+
+// AirGappedConfigSpec is the allowlist of the hosts reachable from the air-gapped control plane.
+//
+//gotagsrewrite:gen
+type AirGappedConfigSpec struct {
+	// AllowedHosts are the allowed hostnames or IP addresses, an entry with a leading dot (e.g. ".corp.internal") allows all subdomains.
+	AllowedHosts []string `yaml:"allowedHosts" protobuf:"1"`
+}
+
+// NewAirGappedConfig returns new AirGappedConfig resource.
+func NewAirGappedConfig() *AirGappedConfig {
+	return typed.NewResource[AirGappedConfigSpec, AirGappedConfigExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, AirGappedConfigType, AirGappedConfigID, resource.VersionUndefined),
+		AirGappedConfigSpec{})
+}
+
+// AirGappedConfigExtension defines AirGappedConfig resource definition.
+type AirGappedConfigExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (AirGappedConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             AirGappedConfigType,
+		DefaultNamespace: ControlPlaneNamespaceName,
+	}
+}
+
+func init() {
+	err := protobuf.RegisterDynamic[AirGappedConfigSpec](AirGappedConfigType, &AirGappedConfig{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// APIServerClientCAConfigType is type of APIServerClientCAConfig resource.
+const APIServerClientCAConfigType = resource.Type("APIServerClientCAConfigs.kubernetes.talos.dev")
+
+// APIServerClientCAConfigID is a singleton resource ID for APIServerClientCAConfig.
+const APIServerClientCAConfigID = resource.ID("client-ca")
+
+// APIServerClientCAConfig holds the CAs kube-apiserver uses to verify the client certificates, e.g. for the aggregated API servers.
+type APIServerClientCAConfig = typed.Resource[APIServerClientCAConfigSpec, APIServerClientCAConfigExtension]
+
+// APIServerClientCAConfigSpec is the PEM-encoded CA bundles rendered to the kube-apiserver config directory.
+//
+//gotagsrewrite:gen
+type APIServerClientCAConfigSpec struct {
+	// ClientCA is the bundle for the --client-ca-file flag, not rendered if empty.
+	ClientCA string `yaml:"clientCA" protobuf:"1"`
+	// RequestHeaderClientCA is the front-proxy bundle for the --requestheader-client-ca-file flag, not rendered if empty.
+	RequestHeaderClientCA string `yaml:"requestHeaderClientCA" protobuf:"2"`
+}
+
+// NewAPIServerClientCAConfig returns new APIServerClientCAConfig resource.
+func NewAPIServerClientCAConfig() *APIServerClientCAConfig {
+	return typed.NewResource[APIServerClientCAConfigSpec, APIServerClientCAConfigExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, APIServerClientCAConfigType, APIServerClientCAConfigID, resource.VersionUndefined),
+		APIServerClientCAConfigSpec{})
+}
+
+// APIServerClientCAConfigExtension defines APIServerClientCAConfig resource definition.
+type APIServerClientCAConfigExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (APIServerClientCAConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             APIServerClientCAConfigType,
+		DefaultNamespace: ControlPlaneNamespaceName,
+	}
+}
+
+func init() {
+	err := protobuf.RegisterDynamic[APIServerClientCAConfigSpec](APIServerClientCAConfigType, &APIServerClientCAConfig{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,62 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// APIServerShutdownConfigType is type of APIServerShutdownConfig resource.
+const APIServerShutdownConfigType = resource.Type("APIServerShutdownConfigs.kubernetes.talos.dev")
+
+// APIServerShutdownConfigID is a singleton resource ID for APIServerShutdownConfig.
+const APIServerShutdownConfigID = resource.ID("kube-apiserver-shutdown")
+
+// APIServerShutdownConfig represents configuration for the graceful shutdown of kube-apiserver.
+type APIServerShutdownConfig = typed.Resource[APIServerShutdownConfigSpec, APIServerShutdownConfigExtension]
+
+// APIServerShutdownConfigSpec is configuration for the graceful shutdown of kube-apiserver.
+//
+// Zero values keep the kube-apiserver and the pod defaults.
+//
+//gotagsrewrite:gen
+type APIServerShutdownConfigSpec struct {
+	// ShutdownDelay is the time kube-apiserver keeps serving after it's asked to shut down, so that the load balancers stop sending requests to it.
+	ShutdownDelay time.Duration `yaml:"shutdownDelay" protobuf:"1"`
+	// WatchTerminationGracePeriod is the time the active watch requests are given to drain after the shutdown delay.
+	WatchTerminationGracePeriod time.Duration `yaml:"watchTerminationGracePeriod" protobuf:"2"`
+	// TerminationGracePeriod is the time the kube-apiserver pod is given to shut down before it's killed.
+	TerminationGracePeriod time.Duration `yaml:"terminationGracePeriod" protobuf:"3"`
+}
+
+// NewAPIServerShutdownConfig returns new APIServerShutdownConfig resource.
+func NewAPIServerShutdownConfig() *APIServerShutdownConfig {
+	return typed.NewResource[APIServerShutdownConfigSpec, APIServerShutdownConfigExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, APIServerShutdownConfigType, APIServerShutdownConfigID, resource.VersionUndefined),
+		APIServerShutdownConfigSpec{})
+}
+
+// APIServerShutdownConfigExtension defines APIServerShutdownConfig resource definition.
+type APIServerShutdownConfigExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (APIServerShutdownConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             APIServerShutdownConfigType,
+		DefaultNamespace: ControlPlaneNamespaceName,
+	}
+}
+
+func init() {
+	err := protobuf.RegisterDynamic[APIServerShutdownConfigSpec](APIServerShutdownConfigType, &APIServerShutdownConfig{})
+	if err != nil {
+		panic(err)
+	}
+}
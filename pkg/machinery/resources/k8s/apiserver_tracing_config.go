@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// APIServerTracingConfigType is type of APIServerTracingConfig resource.
+const APIServerTracingConfigType = resource.Type("APIServerTracingConfigs.kubernetes.talos.dev")
+
+// APIServerTracingConfigID is a singleton resource ID for APIServerTracingConfig.
+const APIServerTracingConfigID = resource.ID("kube-apiserver-tracing")
+
+// APIServerTracingConfig represents configuration for the OpenTelemetry tracing of kube-apiserver.
+type APIServerTracingConfig = typed.Resource[APIServerTracingConfigSpec, APIServerTracingConfigExtension]
+
+// APIServerTracingConfigSpec is configuration for the OpenTelemetry tracing of kube-apiserver.
+//
+// Zero values keep the kube-apiserver defaults.
+//
+//gotagsrewrite:gen
+type APIServerTracingConfigSpec struct {
+	// Endpoint is the OTLP gRPC collector the traces are reported to, the connection doesn't support TLS.
+	Endpoint               string `yaml:"endpoint" protobuf:"1"`
+	SamplingRatePerMillion int32  `yaml:"samplingRatePerMillion" protobuf:"2"`
+}
+
+// NewAPIServerTracingConfig returns new APIServerTracingConfig resource.
+func NewAPIServerTracingConfig() *APIServerTracingConfig {
+	return typed.NewResource[APIServerTracingConfigSpec, APIServerTracingConfigExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, APIServerTracingConfigType, APIServerTracingConfigID, resource.VersionUndefined),
+		APIServerTracingConfigSpec{})
+}
+
+// APIServerTracingConfigExtension defines APIServerTracingConfig resource definition.
+type APIServerTracingConfigExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (APIServerTracingConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             APIServerTracingConfigType,
+		DefaultNamespace: ControlPlaneNamespaceName,
+	}
+}
+
+func init() {
+	err := protobuf.RegisterDynamic[APIServerTracingConfigSpec](APIServerTracingConfigType, &APIServerTracingConfig{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"io/fs"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// AppliedConfigFileType is type of AppliedConfigFile resource.
+const AppliedConfigFileType = resource.Type("AppliedConfigFiles.kubernetes.talos.dev")
+
+// AppliedConfigFile records a control plane static pod config as it was last applied to disk.
+//
+// Resource ID is the filename of the config.
+type AppliedConfigFile = typed.Resource[AppliedConfigFileSpec, AppliedConfigFileExtension]
+
+// AppliedConfigFileSpec describes the path, ownership, mode and contents of the applied config.
+//
+//gotagsrewrite:gen
+type AppliedConfigFileSpec struct {
+	Path   string      `yaml:"path" protobuf:"1"`
+	Mode   fs.FileMode `yaml:"mode" protobuf:"2"`
+	UID    int         `yaml:"uid" protobuf:"3"`
+	GID    int         `yaml:"gid" protobuf:"4"`
+	SHA256 string      `yaml:"sha256" protobuf:"5"`
+}
+
+// NewAppliedConfigFile initializes an AppliedConfigFile resource.
+func NewAppliedConfigFile(filename resource.ID) *AppliedConfigFile {
+	return typed.NewResource[AppliedConfigFileSpec, AppliedConfigFileExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, AppliedConfigFileType, filename, resource.VersionUndefined),
+		AppliedConfigFileSpec{},
+	)
+}
+
+// AppliedConfigFileExtension provides auxiliary methods for AppliedConfigFile.
+type AppliedConfigFileExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (AppliedConfigFileExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             AppliedConfigFileType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: ControlPlaneNamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Path",
+				JSONPath: "{.path}",
+			},
+			{
+				Name:     "SHA256",
+				JSONPath: "{.sha256}",
+			},
+		},
+	}
+}
+
+func init() {
+	err := protobuf.RegisterDynamic[AppliedConfigFileSpec](AppliedConfigFileType, &AppliedConfigFile{})
+	if err != nil {
+		panic(err)
+	}
+}
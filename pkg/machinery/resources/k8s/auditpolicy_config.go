@@ -28,6 +28,21 @@ type AuditPolicyConfig = typed.Resource[AuditPolicyConfigSpec, AuditPolicyConfig
 //gotagsrewrite:gen
 type AuditPolicyConfigSpec struct {
 	Config map[string]any `yaml:"config" protobuf:"1"`
+	// StrictDecodingAllowlist is a list of dot-separated field paths in the config which are allowed to be unknown.
+	StrictDecodingAllowlist []string `yaml:"strictDecodingAllowlist,omitempty" protobuf:"2"`
+	// Policies are the named audit policies, ActivePolicy selects the one rendered instead of Config.
+	Policies     []AuditPolicyNamedSpec `yaml:"policies,omitempty" protobuf:"3"`
+	ActivePolicy string                 `yaml:"activePolicy,omitempty" protobuf:"4"`
+	// ValidationOverrides are the field paths of the validation errors which are downgraded to warnings, as reported in the errors.
+	ValidationOverrides []string `yaml:"validationOverrides,omitempty" protobuf:"5"`
+}
+
+// AuditPolicyNamedSpec is a named audit policy, e.g. for a specific audit verbosity.
+//
+//gotagsrewrite:gen
+type AuditPolicyNamedSpec struct {
+	Name   string         `yaml:"name" protobuf:"1"`
+	Config map[string]any `yaml:"config" protobuf:"2"`
 }
 
 // NewAuditPolicyConfig returns new AuditPolicyConfig resource.
@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// AuthenticationConfigType is type of AuthenticationConfig resource.
+const AuthenticationConfigType = resource.Type("AuthenticationConfigs.kubernetes.talos.dev")
+
+// AuthenticationConfigID is the default resource ID for AuthenticationConfig.
+//
+// AuthenticationConfig resources with other IDs are merged with it into a single structured authentication config,
+// e.g. when different teams contribute their JWT authenticators.
+const AuthenticationConfigID = resource.ID("authentication")
+
+// AuthenticationConfig represents configuration for kube-apiserver structured authentication.
+type AuthenticationConfig = typed.Resource[AuthenticationConfigSpec, AuthenticationConfigExtension]
+
+// AuthenticationConfigSpec is structured authentication configuration for kube-apiserver.
+//
+//gotagsrewrite:gen
+type AuthenticationConfigSpec struct {
+	Config                    map[string]any                                `yaml:"config" protobuf:"1"`
+	JWTCertificateAuthorities []AuthenticationJWTCertificateAuthoritiesSpec `yaml:"jwtCertificateAuthorities,omitempty" protobuf:"2"`
+	// StrictDecodingAllowlist is a list of dot-separated field paths in the config which are allowed to be unknown.
+	StrictDecodingAllowlist []string `yaml:"strictDecodingAllowlist,omitempty" protobuf:"3"`
+	// ValidationOverrides are the field paths of the validation errors which are downgraded to warnings, as reported in the errors.
+	ValidationOverrides []string `yaml:"validationOverrides,omitempty" protobuf:"4"`
+}
+
+// AuthenticationJWTCertificateAuthoritiesSpec is a list of PEM-encoded CA bundles trusted for the JWT authenticator issuer.
+//
+// CA bundles are merged into the issuer certificateAuthority, e.g. to trust both old and new CA during IdP CA rotation.
+//
+//gotagsrewrite:gen
+type AuthenticationJWTCertificateAuthoritiesSpec struct {
+	IssuerURL              string   `yaml:"issuerURL" protobuf:"1"`
+	CertificateAuthorities []string `yaml:"certificateAuthorities" protobuf:"2"`
+}
+
+// NewAuthenticationConfig returns new AuthenticationConfig resource.
+func NewAuthenticationConfig(id resource.ID) *AuthenticationConfig {
+	return typed.NewResource[AuthenticationConfigSpec, AuthenticationConfigExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, AuthenticationConfigType, id, resource.VersionUndefined),
+		AuthenticationConfigSpec{})
+}
+
+// AuthenticationConfigExtension defines AuthenticationConfig resource definition.
+type AuthenticationConfigExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (AuthenticationConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             AuthenticationConfigType,
+		DefaultNamespace: ControlPlaneNamespaceName,
+		Sensitivity:      meta.Sensitive,
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[AuthenticationConfigSpec](AuthenticationConfigType, &AuthenticationConfig{})
+	if err != nil {
+		panic(err)
+	}
+}
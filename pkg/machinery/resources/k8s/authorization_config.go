@@ -27,6 +27,8 @@ type AuthorizationConfig = typed.Resource[AuthorizationConfigSpec, Authorization
 type AuthorizationConfigSpec struct {
 	Image  string                         `yaml:"image" protobuf:"1"`
 	Config []AuthorizationAuthorizersSpec `yaml:"config" protobuf:"2"`
+	// ValidationOverrides are the field paths of the validation errors which are downgraded to warnings, as reported in the errors.
+	ValidationOverrides []string `yaml:"validationOverrides,omitempty" protobuf:"3"`
 }
 
 // AuthorizationAuthorizersSpec is a configuration of authorization authorizers.
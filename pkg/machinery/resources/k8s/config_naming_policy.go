@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"cmp"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// ConfigNamingPolicyType is type of ConfigNamingPolicy resource.
+const ConfigNamingPolicyType = resource.Type("ConfigNamingPolicies.kubernetes.talos.dev")
+
+// ConfigNamingPolicyID is a singleton resource ID for ConfigNamingPolicy.
+const ConfigNamingPolicyID = resource.ID("static-pod-configs")
+
+// ConfigNamingPolicy overrides the filenames of the rendered control plane static pod configs.
+type ConfigNamingPolicy = typed.Resource[ConfigNamingPolicySpec, ConfigNamingPolicyExtension]
+
+// ConfigNamingPolicySpec maps the default config filenames to the filenames they are rendered to.
+//
+// Both the rendered configs and the static pod flags referencing them use the overridden filenames.
+//
+//gotagsrewrite:gen
+type ConfigNamingPolicySpec struct {
+	Filenames map[string]string `yaml:"filenames" protobuf:"1"`
+}
+
+// Filename returns the filename the config with the default filename is rendered to.
+func (spec *ConfigNamingPolicySpec) Filename(filename string) string {
+	if spec == nil {
+		return filename
+	}
+
+	return cmp.Or(spec.Filenames[filename], filename)
+}
+
+// NewConfigNamingPolicy returns new ConfigNamingPolicy resource.
+func NewConfigNamingPolicy() *ConfigNamingPolicy {
+	return typed.NewResource[ConfigNamingPolicySpec, ConfigNamingPolicyExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, ConfigNamingPolicyType, ConfigNamingPolicyID, resource.VersionUndefined),
+		ConfigNamingPolicySpec{})
+}
+
+// ConfigNamingPolicyExtension defines ConfigNamingPolicy resource definition.
+type ConfigNamingPolicyExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ConfigNamingPolicyExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ConfigNamingPolicyType,
+		DefaultNamespace: ControlPlaneNamespaceName,
+	}
+}
+
+func init() {
+	err := protobuf.RegisterDynamic[ConfigNamingPolicySpec](ConfigNamingPolicyType, &ConfigNamingPolicy{})
+	if err != nil {
+		panic(err)
+	}
+}
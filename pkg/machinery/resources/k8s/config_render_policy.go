@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// ConfigRenderPolicyType is type of ConfigRenderPolicy resource.
+const ConfigRenderPolicyType = resource.Type("ConfigRenderPolicies.kubernetes.talos.dev")
+
+// ConfigRenderPolicyID is a singleton resource ID for ConfigRenderPolicy.
+const ConfigRenderPolicyID = resource.ID("static-pod-configs")
+
+// ConfigRenderPolicy configures how the control plane static pod configs are rendered.
+type ConfigRenderPolicy = typed.Resource[ConfigRenderPolicySpec, ConfigRenderPolicyExtension]
+
+// ConfigRenderPolicySpec describes how the control plane static pod configs are rendered.
+//
+//gotagsrewrite:gen
+type ConfigRenderPolicySpec struct {
+	// ValidateOnly disables writing the configs, they are only rendered and validated, e.g. when the configs are managed externally.
+	ValidateOnly bool `yaml:"validateOnly" protobuf:"1"`
+	// StrictAdmissionPlugins makes admission plugins unknown to the kube-apiserver version an error instead of a warning.
+	StrictAdmissionPlugins bool `yaml:"strictAdmissionPlugins" protobuf:"3"`
+	// StrictStructuredAuth makes structured auth configs which the kube-apiserver version can't honor an error instead of a warning.
+	StrictStructuredAuth bool `yaml:"strictStructuredAuth" protobuf:"4"`
+}
+
+// NewConfigRenderPolicy returns new ConfigRenderPolicy resource.
+func NewConfigRenderPolicy() *ConfigRenderPolicy {
+	return typed.NewResource[ConfigRenderPolicySpec, ConfigRenderPolicyExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, ConfigRenderPolicyType, ConfigRenderPolicyID, resource.VersionUndefined),
+		ConfigRenderPolicySpec{})
+}
+
+// ConfigRenderPolicyExtension defines ConfigRenderPolicy resource definition.
+type ConfigRenderPolicyExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ConfigRenderPolicyExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ConfigRenderPolicyType,
+		DefaultNamespace: ControlPlaneNamespaceName,
+	}
+}
+
+func init() {
+	err := protobuf.RegisterDynamic[ConfigRenderPolicySpec](ConfigRenderPolicyType, &ConfigRenderPolicy{})
+	if err != nil {
+		panic(err)
+	}
+}
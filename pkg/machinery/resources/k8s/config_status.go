@@ -8,6 +8,8 @@
 package k8s
 
 import (
+	"time"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
@@ -22,6 +24,29 @@ const ConfigStatusType = resource.Type("ConfigStatuses.kubernetes.talos.dev")
 // ConfigStatusStaticPodID is resource ID for ConfigStatus resource for static pods.
 const ConfigStatusStaticPodID = resource.ID("static-pods")
 
+// StaticPodConfigInputTypes returns resource types which are rendered into the control plane static pod configs.
+//
+// ConfigStatus with ConfigStatusStaticPodID ID reflects the versions of these resources.
+func StaticPodConfigInputTypes() []resource.Type {
+	return []resource.Type{
+		AdmissionControlConfigType,
+		AirGappedConfigType,
+		APIServerClientCAConfigType,
+		APIServerShutdownConfigType,
+		APIServerTracingConfigType,
+		AuditPolicyConfigType,
+		AuthenticationConfigType,
+		AuthorizationConfigType,
+		ConfigNamingPolicyType,
+		ConfigRenderPolicyType,
+		KonnectivityServerConfigType,
+		NodeConfigOverrideType,
+		SchedulerConfigType,
+		ServiceAccountIssuerDiscoveryType,
+		ServiceAccountSignerConfigType,
+	}
+}
+
 // ConfigStatus resource holds definition of rendered secrets.
 type ConfigStatus = typed.Resource[ConfigStatusSpec, ConfigStatusExtension]
 
@@ -31,6 +56,26 @@ type ConfigStatus = typed.Resource[ConfigStatusSpec, ConfigStatusExtension]
 type ConfigStatusSpec struct {
 	Ready   bool   `yaml:"ready" protobuf:"1"`
 	Version string `yaml:"version" protobuf:"2"`
+	// ReconcileCount is the number of successful reconciles, it helps to debug flapping inputs.
+	ReconcileCount    uint64    `yaml:"reconcileCount,omitempty" protobuf:"3"`
+	LastReconcileTime time.Time `yaml:"lastReconcileTime,omitempty" protobuf:"4"`
+	// PodVersions are the versions of the configs on disk by the static pod, the pods without any configs rendered are missing.
+	PodVersions map[string]string `yaml:"podVersions,omitempty" protobuf:"5"`
+	// Healthy is false once the renders failed the configured number of times in a row, LastRenderError is the error of the last
+	// render if it failed.
+	//
+	// Ready stays true once the configs are rendered, as the previously rendered configs are kept on failure.
+	Healthy         bool      `yaml:"healthy" protobuf:"6"`
+	LastRenderError string    `yaml:"lastRenderError,omitempty" protobuf:"7"`
+	LastRenderTime  time.Time `yaml:"lastRenderTime,omitempty" protobuf:"8"`
+	// Generation is incremented on each render changing the configs, it's only set if the generations are retained for rollback.
+	Generation uint64 `yaml:"generation,omitempty" protobuf:"9"`
+	// ConsecutiveRenderFailures is the number of renders failed since the last successful one.
+	ConsecutiveRenderFailures uint64 `yaml:"consecutiveRenderFailures,omitempty" protobuf:"10"`
+	// PendingVersion is the version of the inputs rendered, but not written yet, as the writes are rate limited.
+	//
+	// Version stays the version of the configs on disk until the pending configs are written.
+	PendingVersion string `yaml:"pendingVersion,omitempty" protobuf:"11"`
 }
 
 // NewConfigStatus initializes a ConfigStatus resource.
@@ -0,0 +1,69 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// ConfigValidationWarningsType is type of ConfigValidationWarnings resource.
+const ConfigValidationWarningsType = resource.Type("ConfigValidationWarnings.kubernetes.talos.dev")
+
+// ConfigValidationWarnings lists the non-fatal validation warnings of a control plane static pod config as it was last rendered.
+//
+// Resource ID is the filename of the config, configs rendered without warnings don't have the resource.
+type ConfigValidationWarnings = typed.Resource[ConfigValidationWarningsSpec, ConfigValidationWarningsExtension]
+
+// ConfigValidationWarningsSpec describes the validation warnings of the config.
+//
+//gotagsrewrite:gen
+type ConfigValidationWarningsSpec struct {
+	Warnings []ConfigValidationWarningSpec `yaml:"warnings" protobuf:"1"`
+}
+
+// ConfigValidationWarningSpec is a single validation warning.
+//
+//gotagsrewrite:gen
+type ConfigValidationWarningSpec struct {
+	// Field is the path of the field the warning is about, empty if the warning is not about a single field.
+	Field   string `yaml:"field,omitempty" protobuf:"1"`
+	Message string `yaml:"message" protobuf:"2"`
+}
+
+// NewConfigValidationWarnings initializes a ConfigValidationWarnings resource.
+func NewConfigValidationWarnings(filename resource.ID) *ConfigValidationWarnings {
+	return typed.NewResource[ConfigValidationWarningsSpec, ConfigValidationWarningsExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, ConfigValidationWarningsType, filename, resource.VersionUndefined),
+		ConfigValidationWarningsSpec{},
+	)
+}
+
+// ConfigValidationWarningsExtension provides auxiliary methods for ConfigValidationWarnings.
+type ConfigValidationWarningsExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ConfigValidationWarningsExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ConfigValidationWarningsType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: ControlPlaneNamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Warnings",
+				JSONPath: "{.warnings[*].message}",
+			},
+		},
+	}
+}
+
+func init() {
+	err := protobuf.RegisterDynamic[ConfigValidationWarningsSpec](ConfigValidationWarningsType, &ConfigValidationWarnings{})
+	if err != nil {
+		panic(err)
+	}
+}
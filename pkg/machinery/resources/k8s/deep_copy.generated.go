@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AdmissionControlConfigSpec -type APIServerConfigSpec -type AuditPolicyConfigSpec -type AuthorizationConfigSpec -type BootstrapManifestsConfigSpec -type ConfigStatusSpec -type ControllerManagerConfigSpec -type EndpointSpec -type ExtraManifestsConfigSpec -type KubeletLifecycleSpec -type KubePrismConfigSpec -type KubePrismEndpointsSpec -type KubePrismStatusesSpec -type KubeletSpecSpec -type ManifestSpec -type ManifestStatusSpec -type NodeAnnotationSpecSpec -type NodeCordonedSpecSpec -type NodeLabelSpecSpec -type NodeTaintSpecSpec -type KubeletConfigSpec -type NodeIPSpec -type NodeIPConfigSpec -type NodeStatusSpec -type NodenameSpec -type SchedulerConfigSpec -type SecretsStatusSpec -type StaticPodSpec -type StaticPodStatusSpec -type StaticPodServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AdmissionControlConfigSpec -type AirGappedConfigSpec -type APIServerClientCAConfigSpec -type APIServerConfigSpec -type APIServerShutdownConfigSpec -type APIServerTracingConfigSpec -type AppliedConfigFileSpec -type AuditPolicyConfigSpec -type AuthenticationConfigSpec -type AuthorizationConfigSpec -type BootstrapManifestsConfigSpec -type ConfigNamingPolicySpec -type ConfigRenderPolicySpec -type ConfigStatusSpec -type ConfigValidationWarningsSpec -type ControllerManagerConfigSpec -type EffectiveAdmissionPluginsSpec -type EndpointSpec -type ExtraManifestsConfigSpec -type KubeletLifecycleSpec -type KonnectivityServerConfigSpec -type KubePrismConfigSpec -type KubePrismEndpointsSpec -type KubePrismStatusesSpec -type KubeletSpecSpec -type ManifestSpec -type ManifestStatusSpec -type NodeAnnotationSpecSpec -type NodeConfigOverrideSpec -type NodeCordonedSpecSpec -type NodeLabelSpecSpec -type NodeTaintSpecSpec -type KubeletConfigSpec -type NodeIPSpec -type NodeIPConfigSpec -type NodeStatusSpec -type NodenameSpec -type RequiredFeatureGatesSpec -type SchedulerConfigSpec -type SecretsStatusSpec -type ServiceAccountIssuerDiscoverySpec -type ServiceAccountSignerConfigSpec -type StaticPodSpec -type StaticPodStatusSpec -type StaticPodServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package k8s
 
@@ -30,6 +30,22 @@ func (o AdmissionControlConfigSpec) DeepCopy() AdmissionControlConfigSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of AirGappedConfigSpec.
+func (o AirGappedConfigSpec) DeepCopy() AirGappedConfigSpec {
+	var cp AirGappedConfigSpec = o
+	if o.AllowedHosts != nil {
+		cp.AllowedHosts = make([]string, len(o.AllowedHosts))
+		copy(cp.AllowedHosts, o.AllowedHosts)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of APIServerClientCAConfigSpec.
+func (o APIServerClientCAConfigSpec) DeepCopy() APIServerClientCAConfigSpec {
+	var cp APIServerClientCAConfigSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of APIServerConfigSpec.
 func (o APIServerConfigSpec) DeepCopy() APIServerConfigSpec {
 	var cp APIServerConfigSpec = o
@@ -72,6 +88,24 @@ func (o APIServerConfigSpec) DeepCopy() APIServerConfigSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of APIServerTracingConfigSpec.
+func (o APIServerTracingConfigSpec) DeepCopy() APIServerTracingConfigSpec {
+	var cp APIServerTracingConfigSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of APIServerShutdownConfigSpec.
+func (o APIServerShutdownConfigSpec) DeepCopy() APIServerShutdownConfigSpec {
+	var cp APIServerShutdownConfigSpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of AppliedConfigFileSpec.
+func (o AppliedConfigFileSpec) DeepCopy() AppliedConfigFileSpec {
+	var cp AppliedConfigFileSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of AuditPolicyConfigSpec.
 func (o AuditPolicyConfigSpec) DeepCopy() AuditPolicyConfigSpec {
 	var cp AuditPolicyConfigSpec = o
@@ -81,6 +115,56 @@ func (o AuditPolicyConfigSpec) DeepCopy() AuditPolicyConfigSpec {
 			cp.Config[k2] = v2
 		}
 	}
+	if o.StrictDecodingAllowlist != nil {
+		cp.StrictDecodingAllowlist = make([]string, len(o.StrictDecodingAllowlist))
+		copy(cp.StrictDecodingAllowlist, o.StrictDecodingAllowlist)
+	}
+	if o.Policies != nil {
+		cp.Policies = make([]AuditPolicyNamedSpec, len(o.Policies))
+		copy(cp.Policies, o.Policies)
+		for i2 := range o.Policies {
+			if o.Policies[i2].Config != nil {
+				cp.Policies[i2].Config = make(map[string]any, len(o.Policies[i2].Config))
+				for k4, v4 := range o.Policies[i2].Config {
+					cp.Policies[i2].Config[k4] = v4
+				}
+			}
+		}
+	}
+	if o.ValidationOverrides != nil {
+		cp.ValidationOverrides = make([]string, len(o.ValidationOverrides))
+		copy(cp.ValidationOverrides, o.ValidationOverrides)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of AuthenticationConfigSpec.
+func (o AuthenticationConfigSpec) DeepCopy() AuthenticationConfigSpec {
+	var cp AuthenticationConfigSpec = o
+	if o.Config != nil {
+		cp.Config = make(map[string]any, len(o.Config))
+		for k2, v2 := range o.Config {
+			cp.Config[k2] = v2
+		}
+	}
+	if o.JWTCertificateAuthorities != nil {
+		cp.JWTCertificateAuthorities = make([]AuthenticationJWTCertificateAuthoritiesSpec, len(o.JWTCertificateAuthorities))
+		copy(cp.JWTCertificateAuthorities, o.JWTCertificateAuthorities)
+		for i2 := range o.JWTCertificateAuthorities {
+			if o.JWTCertificateAuthorities[i2].CertificateAuthorities != nil {
+				cp.JWTCertificateAuthorities[i2].CertificateAuthorities = make([]string, len(o.JWTCertificateAuthorities[i2].CertificateAuthorities))
+				copy(cp.JWTCertificateAuthorities[i2].CertificateAuthorities, o.JWTCertificateAuthorities[i2].CertificateAuthorities)
+			}
+		}
+	}
+	if o.StrictDecodingAllowlist != nil {
+		cp.StrictDecodingAllowlist = make([]string, len(o.StrictDecodingAllowlist))
+		copy(cp.StrictDecodingAllowlist, o.StrictDecodingAllowlist)
+	}
+	if o.ValidationOverrides != nil {
+		cp.ValidationOverrides = make([]string, len(o.ValidationOverrides))
+		copy(cp.ValidationOverrides, o.ValidationOverrides)
+	}
 	return cp
 }
 
@@ -99,6 +183,10 @@ func (o AuthorizationConfigSpec) DeepCopy() AuthorizationConfigSpec {
 			}
 		}
 	}
+	if o.ValidationOverrides != nil {
+		cp.ValidationOverrides = make([]string, len(o.ValidationOverrides))
+		copy(cp.ValidationOverrides, o.ValidationOverrides)
+	}
 	return cp
 }
 
@@ -120,9 +208,43 @@ func (o BootstrapManifestsConfigSpec) DeepCopy() BootstrapManifestsConfigSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of ConfigRenderPolicySpec.
+func (o ConfigRenderPolicySpec) DeepCopy() ConfigRenderPolicySpec {
+	var cp ConfigRenderPolicySpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of ConfigNamingPolicySpec.
+func (o ConfigNamingPolicySpec) DeepCopy() ConfigNamingPolicySpec {
+	var cp ConfigNamingPolicySpec = o
+	if o.Filenames != nil {
+		cp.Filenames = make(map[string]string, len(o.Filenames))
+		for k2, v2 := range o.Filenames {
+			cp.Filenames[k2] = v2
+		}
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of ConfigStatusSpec.
 func (o ConfigStatusSpec) DeepCopy() ConfigStatusSpec {
 	var cp ConfigStatusSpec = o
+	if o.PodVersions != nil {
+		cp.PodVersions = make(map[string]string, len(o.PodVersions))
+		for k2, v2 := range o.PodVersions {
+			cp.PodVersions[k2] = v2
+		}
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of ConfigValidationWarningsSpec.
+func (o ConfigValidationWarningsSpec) DeepCopy() ConfigValidationWarningsSpec {
+	var cp ConfigValidationWarningsSpec = o
+	if o.Warnings != nil {
+		cp.Warnings = make([]ConfigValidationWarningSpec, len(o.Warnings))
+		copy(cp.Warnings, o.Warnings)
+	}
 	return cp
 }
 
@@ -168,6 +290,16 @@ func (o ControllerManagerConfigSpec) DeepCopy() ControllerManagerConfigSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of EffectiveAdmissionPluginsSpec.
+func (o EffectiveAdmissionPluginsSpec) DeepCopy() EffectiveAdmissionPluginsSpec {
+	var cp EffectiveAdmissionPluginsSpec = o
+	if o.Plugins != nil {
+		cp.Plugins = make([]EffectiveAdmissionPluginSpec, len(o.Plugins))
+		copy(cp.Plugins, o.Plugins)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of EndpointSpec.
 func (o EndpointSpec) DeepCopy() EndpointSpec {
 	var cp EndpointSpec = o
@@ -202,6 +334,16 @@ func (o KubeletLifecycleSpec) DeepCopy() KubeletLifecycleSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of KonnectivityServerConfigSpec.
+func (o KonnectivityServerConfigSpec) DeepCopy() KonnectivityServerConfigSpec {
+	var cp KonnectivityServerConfigSpec = o
+	if o.EgressSelections != nil {
+		cp.EgressSelections = make([]EgressSelectionSpec, len(o.EgressSelections))
+		copy(cp.EgressSelections, o.EgressSelections)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of KubePrismConfigSpec.
 func (o KubePrismConfigSpec) DeepCopy() KubePrismConfigSpec {
 	var cp KubePrismConfigSpec = o
@@ -302,6 +444,24 @@ func (o NodeAnnotationSpecSpec) DeepCopy() NodeAnnotationSpecSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of NodeConfigOverrideSpec.
+func (o NodeConfigOverrideSpec) DeepCopy() NodeConfigOverrideSpec {
+	var cp NodeConfigOverrideSpec = o
+	if o.Overrides != nil {
+		cp.Overrides = make([]NodeConfigFileOverrideSpec, len(o.Overrides))
+		copy(cp.Overrides, o.Overrides)
+		for i2 := range o.Overrides {
+			if o.Overrides[i2].Config != nil {
+				cp.Overrides[i2].Config = make(map[string]any, len(o.Overrides[i2].Config))
+				for k4, v4 := range o.Overrides[i2].Config {
+					cp.Overrides[i2].Config[k4] = v4
+				}
+			}
+		}
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of NodeCordonedSpecSpec.
 func (o NodeCordonedSpecSpec) DeepCopy() NodeCordonedSpecSpec {
 	var cp NodeCordonedSpecSpec = o
@@ -414,6 +574,16 @@ func (o NodenameSpec) DeepCopy() NodenameSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of RequiredFeatureGatesSpec.
+func (o RequiredFeatureGatesSpec) DeepCopy() RequiredFeatureGatesSpec {
+	var cp RequiredFeatureGatesSpec = o
+	if o.FeatureGates != nil {
+		cp.FeatureGates = make([]string, len(o.FeatureGates))
+		copy(cp.FeatureGates, o.FeatureGates)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of SchedulerConfigSpec.
 func (o SchedulerConfigSpec) DeepCopy() SchedulerConfigSpec {
 	var cp SchedulerConfigSpec = o
@@ -460,6 +630,18 @@ func (o SecretsStatusSpec) DeepCopy() SecretsStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of ServiceAccountIssuerDiscoverySpec.
+func (o ServiceAccountIssuerDiscoverySpec) DeepCopy() ServiceAccountIssuerDiscoverySpec {
+	var cp ServiceAccountIssuerDiscoverySpec = o
+	return cp
+}
+
+// DeepCopy generates a deep copy of ServiceAccountSignerConfigSpec.
+func (o ServiceAccountSignerConfigSpec) DeepCopy() ServiceAccountSignerConfigSpec {
+	var cp ServiceAccountSignerConfigSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of StaticPodSpec.
 func (o StaticPodSpec) DeepCopy() StaticPodSpec {
 	var cp StaticPodSpec = o
@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// EffectiveAdmissionPluginsType is type of EffectiveAdmissionPlugins resource.
+const EffectiveAdmissionPluginsType = resource.Type("EffectiveAdmissionPlugins.kubernetes.talos.dev")
+
+// EffectiveAdmissionPluginsID is a singleton resource ID for EffectiveAdmissionPlugins.
+const EffectiveAdmissionPluginsID = resource.ID("admission")
+
+// EffectiveAdmissionPlugins lists admission plugins as rendered into the kube-apiserver admission configuration.
+type EffectiveAdmissionPlugins = typed.Resource[EffectiveAdmissionPluginsSpec, EffectiveAdmissionPluginsExtension]
+
+// EffectiveAdmissionPluginsSpec describes admission plugins in the rendered order.
+//
+//gotagsrewrite:gen
+type EffectiveAdmissionPluginsSpec struct {
+	Plugins []EffectiveAdmissionPluginSpec `yaml:"plugins" protobuf:"1"`
+}
+
+// EffectiveAdmissionPluginSpec describes a rendered admission plugin.
+//
+//gotagsrewrite:gen
+type EffectiveAdmissionPluginSpec struct {
+	Name       string `yaml:"name" protobuf:"1"`
+	Configured bool   `yaml:"configured" protobuf:"2"`
+}
+
+// NewEffectiveAdmissionPlugins returns new EffectiveAdmissionPlugins resource.
+func NewEffectiveAdmissionPlugins() *EffectiveAdmissionPlugins {
+	return typed.NewResource[EffectiveAdmissionPluginsSpec, EffectiveAdmissionPluginsExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, EffectiveAdmissionPluginsType, EffectiveAdmissionPluginsID, resource.VersionUndefined),
+		EffectiveAdmissionPluginsSpec{})
+}
+
+// EffectiveAdmissionPluginsExtension defines EffectiveAdmissionPlugins resource definition.
+type EffectiveAdmissionPluginsExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (EffectiveAdmissionPluginsExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             EffectiveAdmissionPluginsType,
+		DefaultNamespace: ControlPlaneNamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Plugins",
+				JSONPath: "{.plugins[*].name}",
+			},
+		},
+	}
+}
+
+func init() {
+	err := protobuf.RegisterDynamic[EffectiveAdmissionPluginsSpec](EffectiveAdmissionPluginsType, &EffectiveAdmissionPlugins{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -7,7 +7,7 @@ package k8s
 
 import "github.com/cosi-project/runtime/pkg/resource"
 
-//go:generate deep-copy -type AdmissionControlConfigSpec -type APIServerConfigSpec -type AuditPolicyConfigSpec -type AuthorizationConfigSpec -type BootstrapManifestsConfigSpec -type ConfigStatusSpec -type ControllerManagerConfigSpec -type EndpointSpec -type ExtraManifestsConfigSpec -type KubeletLifecycleSpec -type KubePrismConfigSpec -type KubePrismEndpointsSpec -type KubePrismStatusesSpec -type KubeletSpecSpec -type ManifestSpec -type ManifestStatusSpec -type NodeAnnotationSpecSpec -type NodeCordonedSpecSpec -type NodeLabelSpecSpec -type NodeTaintSpecSpec -type KubeletConfigSpec -type NodeIPSpec -type NodeIPConfigSpec -type NodeStatusSpec -type NodenameSpec -type SchedulerConfigSpec -type SecretsStatusSpec -type StaticPodSpec -type StaticPodStatusSpec -type StaticPodServerStatusSpec  -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate deep-copy -type AdmissionControlConfigSpec -type AirGappedConfigSpec -type APIServerClientCAConfigSpec -type APIServerConfigSpec -type APIServerShutdownConfigSpec -type APIServerTracingConfigSpec -type AppliedConfigFileSpec -type AuditPolicyConfigSpec -type AuthenticationConfigSpec -type AuthorizationConfigSpec -type BootstrapManifestsConfigSpec -type ConfigNamingPolicySpec -type ConfigRenderPolicySpec -type ConfigStatusSpec -type ConfigValidationWarningsSpec -type ControllerManagerConfigSpec -type EffectiveAdmissionPluginsSpec -type EndpointSpec -type ExtraManifestsConfigSpec -type KubeletLifecycleSpec -type KonnectivityServerConfigSpec -type KubePrismConfigSpec -type KubePrismEndpointsSpec -type KubePrismStatusesSpec -type KubeletSpecSpec -type ManifestSpec -type ManifestStatusSpec -type NodeAnnotationSpecSpec -type NodeConfigOverrideSpec -type NodeCordonedSpecSpec -type NodeLabelSpecSpec -type NodeTaintSpecSpec -type KubeletConfigSpec -type NodeIPSpec -type NodeIPConfigSpec -type NodeStatusSpec -type NodenameSpec -type RequiredFeatureGatesSpec -type SchedulerConfigSpec -type SecretsStatusSpec -type ServiceAccountIssuerDiscoverySpec -type ServiceAccountSignerConfigSpec -type StaticPodSpec -type StaticPodStatusSpec -type StaticPodServerStatusSpec  -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
 
 // NamespaceName contains resources supporting Kubernetes components on all node types.
 const NamespaceName resource.Namespace = "k8s"
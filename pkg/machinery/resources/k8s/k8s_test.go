@@ -28,11 +28,21 @@ func TestRegisterResource(t *testing.T) {
 
 	for _, resource := range []meta.ResourceWithRD{
 		&k8s.AdmissionControlConfig{},
+		&k8s.AirGappedConfig{},
+		&k8s.APIServerClientCAConfig{},
 		&k8s.APIServerConfig{},
+		&k8s.APIServerShutdownConfig{},
+		&k8s.APIServerTracingConfig{},
+		&k8s.AppliedConfigFile{},
+		&k8s.ConfigRenderPolicy{},
+		&k8s.ConfigNamingPolicy{},
 		&k8s.KubePrismEndpoints{},
 		&k8s.AuditPolicyConfig{},
+		&k8s.AuthenticationConfig{},
 		&k8s.ConfigStatus{},
+		&k8s.ConfigValidationWarnings{},
 		&k8s.ControllerManagerConfig{},
+		&k8s.EffectiveAdmissionPlugins{},
 		&k8s.Endpoint{},
 		&k8s.ExtraManifestsConfig{},
 		&k8s.KubeletConfig{},
@@ -40,18 +50,23 @@ func TestRegisterResource(t *testing.T) {
 		&k8s.KubeletSpec{},
 		&k8s.KubePrismStatuses{},
 		&k8s.KubePrismConfig{},
+		&k8s.KonnectivityServerConfig{},
 		&k8s.ManifestStatus{},
 		&k8s.Manifest{},
 		&k8s.BootstrapManifestsConfig{},
 		&k8s.NodeAnnotationSpec{},
+		&k8s.NodeConfigOverride{},
 		&k8s.NodeCordonedSpec{},
 		&k8s.NodeLabelSpec{},
 		&k8s.NodeTaintSpec{},
 		&k8s.Nodename{},
 		&k8s.NodeIP{},
 		&k8s.NodeIPConfig{},
+		&k8s.RequiredFeatureGates{},
 		&k8s.SchedulerConfig{},
 		&k8s.SecretsStatus{},
+		&k8s.ServiceAccountIssuerDiscovery{},
+		&k8s.ServiceAccountSignerConfig{},
 		&k8s.StaticPodStatus{},
 		&k8s.StaticPod{},
 	} {
@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// KonnectivityServerConfigType is type of KonnectivityServerConfig resource.
+const KonnectivityServerConfigType = resource.Type("KonnectivityServerConfigs.kubernetes.talos.dev")
+
+// KonnectivityServerConfigID is a singleton resource ID for KonnectivityServerConfig.
+const KonnectivityServerConfigID = resource.ID("konnectivity-server")
+
+// KonnectivityServerConfig represents configuration for the konnectivity server used by kube-apiserver.
+type KonnectivityServerConfig = typed.Resource[KonnectivityServerConfigSpec, KonnectivityServerConfigExtension]
+
+// KonnectivityServerConfigSpec is configuration for the konnectivity server.
+//
+//gotagsrewrite:gen
+type KonnectivityServerConfigSpec struct {
+	// ListenAddress is either an absolute path to the unix socket, or an IP:port pair.
+	ListenAddress       string `yaml:"listenAddress" protobuf:"1"`
+	AgentNamespace      string `yaml:"agentNamespace" protobuf:"2"`
+	AgentServiceAccount string `yaml:"agentServiceAccount" protobuf:"3"`
+	// EgressSelections are the egress selections in addition to the cluster one, which goes through the konnectivity server.
+	EgressSelections []EgressSelectionSpec `yaml:"egressSelections,omitempty" protobuf:"4"`
+}
+
+// EgressSelectionSpec is the connection kube-apiserver uses for the egress traffic of the selection.
+//
+//gotagsrewrite:gen
+type EgressSelectionSpec struct {
+	// Name is one of controlplane or etcd.
+	Name string `yaml:"name" protobuf:"1"`
+	// ProxyProtocol is one of Direct, HTTPConnect or GRPC.
+	ProxyProtocol string `yaml:"proxyProtocol" protobuf:"2"`
+	// Transport is either tcp or uds, it's not set for the Direct proxy protocol.
+	Transport string `yaml:"transport,omitempty" protobuf:"3"`
+	// Address is an IP:port pair for the tcp transport, or an absolute path to the unix socket for the uds transport.
+	Address string `yaml:"address,omitempty" protobuf:"4"`
+}
+
+// NewKonnectivityServerConfig returns new KonnectivityServerConfig resource.
+func NewKonnectivityServerConfig() *KonnectivityServerConfig {
+	return typed.NewResource[KonnectivityServerConfigSpec, KonnectivityServerConfigExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, KonnectivityServerConfigType, KonnectivityServerConfigID, resource.VersionUndefined),
+		KonnectivityServerConfigSpec{})
+}
+
+// KonnectivityServerConfigExtension defines KonnectivityServerConfig resource definition.
+type KonnectivityServerConfigExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (KonnectivityServerConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             KonnectivityServerConfigType,
+		DefaultNamespace: ControlPlaneNamespaceName,
+	}
+}
+
+func init() {
+	err := protobuf.RegisterDynamic[KonnectivityServerConfigSpec](KonnectivityServerConfigType, &KonnectivityServerConfig{})
+	if err != nil {
+		panic(err)
+	}
+}
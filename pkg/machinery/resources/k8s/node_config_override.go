@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// NodeConfigOverrideType is type of NodeConfigOverride resource.
+const NodeConfigOverrideType = resource.Type("NodeConfigOverrides.kubernetes.talos.dev")
+
+// NodeConfigOverride represents node-specific overrides of the control plane static pod configs.
+//
+// Resource ID is the nodename of the control plane node the overrides apply to.
+type NodeConfigOverride = typed.Resource[NodeConfigOverrideSpec, NodeConfigOverrideExtension]
+
+// NodeConfigOverrideSpec is a list of node-specific config overrides.
+//
+//gotagsrewrite:gen
+type NodeConfigOverrideSpec struct {
+	Overrides []NodeConfigFileOverrideSpec `yaml:"overrides" protobuf:"1"`
+}
+
+// NodeConfigFileOverrideSpec is an override merged into the rendered config by its filename.
+//
+//gotagsrewrite:gen
+type NodeConfigFileOverrideSpec struct {
+	Filename string         `yaml:"filename" protobuf:"1"`
+	Config   map[string]any `yaml:"config" protobuf:"2"`
+}
+
+// NewNodeConfigOverride returns new NodeConfigOverride resource.
+func NewNodeConfigOverride(nodename resource.ID) *NodeConfigOverride {
+	return typed.NewResource[NodeConfigOverrideSpec, NodeConfigOverrideExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, NodeConfigOverrideType, nodename, resource.VersionUndefined),
+		NodeConfigOverrideSpec{})
+}
+
+// NodeConfigOverrideExtension defines NodeConfigOverride resource definition.
+type NodeConfigOverrideExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (NodeConfigOverrideExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NodeConfigOverrideType,
+		DefaultNamespace: ControlPlaneNamespaceName,
+		Sensitivity:      meta.Sensitive,
+	}
+}
+
+func init() {
+	err := protobuf.RegisterDynamic[NodeConfigOverrideSpec](NodeConfigOverrideType, &NodeConfigOverride{})
+	if err != nil {
+		panic(err)
+	}
+}
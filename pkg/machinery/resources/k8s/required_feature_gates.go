@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// RequiredFeatureGatesType is type of RequiredFeatureGates resource.
+const RequiredFeatureGatesType = resource.Type("RequiredFeatureGates.kubernetes.talos.dev")
+
+// RequiredFeatureGates lists the feature gates a control plane component needs enabled for the rendered configs.
+//
+// Resource ID is the control plane component ID (e.g. APIServerID).
+type RequiredFeatureGates = typed.Resource[RequiredFeatureGatesSpec, RequiredFeatureGatesExtension]
+
+// RequiredFeatureGatesSpec describes feature gates required by the rendered configs.
+//
+//gotagsrewrite:gen
+type RequiredFeatureGatesSpec struct {
+	FeatureGates []string `yaml:"featureGates" protobuf:"1"`
+}
+
+// NewRequiredFeatureGates returns new RequiredFeatureGates resource.
+func NewRequiredFeatureGates(id resource.ID) *RequiredFeatureGates {
+	return typed.NewResource[RequiredFeatureGatesSpec, RequiredFeatureGatesExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, RequiredFeatureGatesType, id, resource.VersionUndefined),
+		RequiredFeatureGatesSpec{})
+}
+
+// RequiredFeatureGatesExtension defines RequiredFeatureGates resource definition.
+type RequiredFeatureGatesExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (RequiredFeatureGatesExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RequiredFeatureGatesType,
+		DefaultNamespace: ControlPlaneNamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Feature Gates",
+				JSONPath: "{.featureGates}",
+			},
+		},
+	}
+}
+
+func init() {
+	err := protobuf.RegisterDynamic[RequiredFeatureGatesSpec](RequiredFeatureGatesType, &RequiredFeatureGates{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// ServiceAccountIssuerDiscoveryType is type of ServiceAccountIssuerDiscovery resource.
+const ServiceAccountIssuerDiscoveryType = resource.Type("ServiceAccountIssuerDiscoveries.kubernetes.talos.dev")
+
+// ServiceAccountIssuerDiscoveryID is a singleton resource ID for ServiceAccountIssuerDiscovery.
+const ServiceAccountIssuerDiscoveryID = resource.ID("service-account-issuer")
+
+// ServiceAccountIssuerDiscovery represents cached service account issuer discovery documents served by kube-apiserver.
+type ServiceAccountIssuerDiscovery = typed.Resource[ServiceAccountIssuerDiscoverySpec, ServiceAccountIssuerDiscoveryExtension]
+
+// ServiceAccountIssuerDiscoverySpec is the cached OpenID discovery document and JWKS of the service account issuer.
+//
+//gotagsrewrite:gen
+type ServiceAccountIssuerDiscoverySpec struct {
+	// Issuer is the service account issuer kube-apiserver is configured with.
+	Issuer string `yaml:"issuer" protobuf:"1"`
+	// DiscoveryDocument is the JSON-encoded OpenID discovery document.
+	DiscoveryDocument string `yaml:"discoveryDocument" protobuf:"2"`
+	// JWKS is the JSON-encoded key set of the issuer.
+	JWKS string `yaml:"jwks" protobuf:"3"`
+}
+
+// NewServiceAccountIssuerDiscovery returns new ServiceAccountIssuerDiscovery resource.
+func NewServiceAccountIssuerDiscovery() *ServiceAccountIssuerDiscovery {
+	return typed.NewResource[ServiceAccountIssuerDiscoverySpec, ServiceAccountIssuerDiscoveryExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, ServiceAccountIssuerDiscoveryType, ServiceAccountIssuerDiscoveryID, resource.VersionUndefined),
+		ServiceAccountIssuerDiscoverySpec{})
+}
+
+// ServiceAccountIssuerDiscoveryExtension defines ServiceAccountIssuerDiscovery resource definition.
+type ServiceAccountIssuerDiscoveryExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ServiceAccountIssuerDiscoveryExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ServiceAccountIssuerDiscoveryType,
+		DefaultNamespace: ControlPlaneNamespaceName,
+	}
+}
+
+func init() {
+	err := protobuf.RegisterDynamic[ServiceAccountIssuerDiscoverySpec](ServiceAccountIssuerDiscoveryType, &ServiceAccountIssuerDiscovery{})
+	if err != nil {
+		panic(err)
+	}
+}
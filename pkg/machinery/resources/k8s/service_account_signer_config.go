@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// ServiceAccountSignerConfigType is type of ServiceAccountSignerConfig resource.
+const ServiceAccountSignerConfigType = resource.Type("ServiceAccountSignerConfigs.kubernetes.talos.dev")
+
+// ServiceAccountSignerConfigID is a singleton resource ID for ServiceAccountSignerConfig.
+const ServiceAccountSignerConfigID = resource.ID("service-account-signer")
+
+// ServiceAccountSignerConfig represents configuration for the external signer of the service account tokens issued by kube-apiserver.
+type ServiceAccountSignerConfig = typed.Resource[ServiceAccountSignerConfigSpec, ServiceAccountSignerConfigExtension]
+
+// ServiceAccountSignerConfigSpec is configuration for the external service account token signer.
+//
+//gotagsrewrite:gen
+type ServiceAccountSignerConfigSpec struct {
+	// Endpoint is an absolute path to the unix socket the signer listens on.
+	//
+	// The signer provides the public keys as well, so the service account keys aren't passed to kube-apiserver.
+	Endpoint string `yaml:"endpoint" protobuf:"1"`
+}
+
+// NewServiceAccountSignerConfig returns new ServiceAccountSignerConfig resource.
+func NewServiceAccountSignerConfig() *ServiceAccountSignerConfig {
+	return typed.NewResource[ServiceAccountSignerConfigSpec, ServiceAccountSignerConfigExtension](
+		resource.NewMetadata(ControlPlaneNamespaceName, ServiceAccountSignerConfigType, ServiceAccountSignerConfigID, resource.VersionUndefined),
+		ServiceAccountSignerConfigSpec{})
+}
+
+// ServiceAccountSignerConfigExtension defines ServiceAccountSignerConfig resource definition.
+type ServiceAccountSignerConfigExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ServiceAccountSignerConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ServiceAccountSignerConfigType,
+		DefaultNamespace: ControlPlaneNamespaceName,
+	}
+}
+
+func init() {
+	err := protobuf.RegisterDynamic[ServiceAccountSignerConfigSpec](ServiceAccountSignerConfigType, &ServiceAccountSignerConfig{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,52 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// ConfigSecretType is type of ConfigSecret secret resource.
+const ConfigSecretType = resource.Type("ConfigSecrets.secrets.talos.dev")
+
+// ConfigSecret contains secret values referenced from the rendered configs.
+type ConfigSecret = typed.Resource[ConfigSecretSpec, ConfigSecretExtension]
+
+// ConfigSecretSpec describes secret values by key.
+//
+//gotagsrewrite:gen
+type ConfigSecretSpec struct {
+	Data map[string]string `yaml:"data" protobuf:"1"`
+}
+
+// NewConfigSecret initializes a ConfigSecret resource.
+func NewConfigSecret(id resource.ID) *ConfigSecret {
+	return typed.NewResource[ConfigSecretSpec, ConfigSecretExtension](
+		resource.NewMetadata(NamespaceName, ConfigSecretType, id, resource.VersionUndefined),
+		ConfigSecretSpec{},
+	)
+}
+
+// ConfigSecretExtension provides auxiliary methods for ConfigSecret.
+type ConfigSecretExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (ConfigSecretExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ConfigSecretType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		Sensitivity:      meta.Sensitive,
+	}
+}
+
+func init() {
+	if err := protobuf.RegisterDynamic[ConfigSecretSpec](ConfigSecretType, &ConfigSecret{}); err != nil {
+		panic(err)
+	}
+}
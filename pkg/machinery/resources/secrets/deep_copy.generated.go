@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type APICertsSpec -type CertSANSpec -type EtcdCertsSpec -type EtcdRootSpec -type KubeletSpec -type KubernetesCertsSpec -type KubernetesDynamicCertsSpec -type KubernetesRootSpec -type MaintenanceServiceCertsSpec -type MaintenanceRootSpec -type OSRootSpec -type TrustdCertsSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type APICertsSpec -type CertSANSpec -type ConfigSecretSpec -type EtcdCertsSpec -type EtcdRootSpec -type KubeletSpec -type KubernetesCertsSpec -type KubernetesDynamicCertsSpec -type KubernetesRootSpec -type MaintenanceServiceCertsSpec -type MaintenanceRootSpec -type OSRootSpec -type TrustdCertsSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package secrets
 
@@ -48,6 +48,18 @@ func (o CertSANSpec) DeepCopy() CertSANSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of ConfigSecretSpec.
+func (o ConfigSecretSpec) DeepCopy() ConfigSecretSpec {
+	var cp ConfigSecretSpec = o
+	if o.Data != nil {
+		cp.Data = make(map[string]string, len(o.Data))
+		for k2, v2 := range o.Data {
+			cp.Data[k2] = v2
+		}
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of EtcdCertsSpec.
 func (o EtcdCertsSpec) DeepCopy() EtcdCertsSpec {
 	var cp EtcdCertsSpec = o
@@ -7,6 +7,7 @@ package secrets
 import (
 	"net/netip"
 	"net/url"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -48,6 +49,10 @@ type KubernetesRootSpec struct {
 	BootstrapTokenSecret string `yaml:"bootstrapTokenSecret" protobuf:"12"`
 
 	SecretboxEncryptionSecret string `yaml:"secretboxEncryptionSecret" protobuf:"13"`
+
+	KMSEncryptionName     string        `yaml:"kmsEncryptionName" protobuf:"16"`
+	KMSEncryptionEndpoint string        `yaml:"kmsEncryptionEndpoint" protobuf:"17"`
+	KMSEncryptionTimeout  time.Duration `yaml:"kmsEncryptionTimeout" protobuf:"18"`
 }
 
 // NewKubernetesRoot initializes a KubernetesRoot resource.